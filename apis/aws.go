@@ -19,6 +19,7 @@ package apis
 
 import (
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
 
 	acmv1alpha1 "github.com/crossplane/provider-aws/apis/acm/v1alpha1"
 	acmpcav1alpha1 "github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
@@ -54,42 +55,71 @@ import (
 	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
+// schemeBuilders builds the schemes of all API groups and versions defined in
+// the project.
+var schemeBuilders = []*scheme.Builder{
+	cachev1alpha1.SchemeBuilder,
+	cachev1beta1.SchemeBuilder,
+	databasev1beta1.SchemeBuilder,
+	elasticloadbalancingv1alpha1.SchemeBuilder,
+	identityv1alpha1.SchemeBuilder,
+	identityv1beta1.SchemeBuilder,
+	route53v1alpha1.SchemeBuilder,
+	notificationv1alpha3.SchemeBuilder,
+	ec2v1beta1.SchemeBuilder,
+	awsv1alpha3.SchemeBuilder,
+	awsv1beta1.SchemeBuilder,
+	acmv1alpha1.SchemeBuilder,
+	s3v1alpha2.SchemeBuilder,
+	s3v1beta1.SchemeBuilder,
+	secretsmanagerv1alpha1.SchemeBuilder,
+	servicediscoveryv1alpha1.SchemeBuilder,
+	acmpcav1alpha1.SchemeBuilder,
+	eksv1beta1.SchemeBuilder,
+	sqsv1beta1.SchemeBuilder,
+	redshiftv1alpha1.SchemeBuilder,
+	eksv1alpha1.SchemeBuilder,
+	ecrv1alpha1.SchemeBuilder,
+	apigatewayv2.SchemeBuilder,
+	sfnv1alpha1.SchemeBuilder,
+	dynamodbv1alpha1.SchemeBuilder,
+	kmsv1alpha1.SchemeBuilder,
+	efsv1alpha1.SchemeBuilder,
+	rdsv1alpha1.SchemeBuilder,
+	ec2v1alpha1.SchemeBuilder,
+	lambdav1alpha1.SchemeBuilder,
+	cloudfrontv1alpha1.SchemeBuilder,
+	route53resolveralpha1.SchemeBuilder,
+}
+
+// referencedGroups contains the API groups whose managed resources may be
+// referenced by the managed resources of an API group. Their types have to be
+// in the scheme for these references to be resolved.
+var referencedGroups = map[string][]string{
+	acmv1alpha1.Group:                  {acmpcav1alpha1.Group},
+	apigatewayv2.Group:                 {ec2v1beta1.Group},
+	cachev1beta1.Group:                 {ec2v1beta1.Group},
+	databasev1beta1.Group:              {ec2v1beta1.Group, identityv1beta1.Group},
+	ecrv1alpha1.Group:                  {identityv1beta1.Group},
+	efsv1alpha1.Group:                  {kmsv1alpha1.Group},
+	eksv1beta1.Group:                   {ec2v1beta1.Group, identityv1beta1.Group},
+	elasticloadbalancingv1alpha1.Group: {ec2v1beta1.Group},
+	lambdav1alpha1.Group:               {ec2v1beta1.Group, identityv1beta1.Group, kmsv1alpha1.Group, s3v1beta1.Group},
+	rdsv1alpha1.Group:                  {databasev1beta1.Group, ec2v1beta1.Group, identityv1beta1.Group, kmsv1alpha1.Group},
+	redshiftv1alpha1.Group:             {ec2v1beta1.Group, identityv1beta1.Group},
+	route53v1alpha1.Group:              {ec2v1beta1.Group},
+	route53resolveralpha1.Group:        {ec2v1beta1.Group},
+	s3v1beta1.Group:                    {ec2v1beta1.Group, identityv1beta1.Group, notificationv1alpha3.Group},
+	secretsmanagerv1alpha1.Group:       {kmsv1alpha1.Group},
+	servicediscoveryv1alpha1.Group:     {ec2v1beta1.Group},
+	sfnv1alpha1.Group:                  {identityv1beta1.Group},
+}
+
 func init() {
 	// Register the types with the Scheme so the components can map objects to GroupVersionKinds and back
-	AddToSchemes = append(AddToSchemes,
-		cachev1alpha1.SchemeBuilder.AddToScheme,
-		cachev1beta1.SchemeBuilder.AddToScheme,
-		databasev1beta1.SchemeBuilder.AddToScheme,
-		elasticloadbalancingv1alpha1.SchemeBuilder.AddToScheme,
-		identityv1alpha1.SchemeBuilder.AddToScheme,
-		identityv1beta1.SchemeBuilder.AddToScheme,
-		route53v1alpha1.SchemeBuilder.AddToScheme,
-		notificationv1alpha3.SchemeBuilder.AddToScheme,
-		ec2v1beta1.SchemeBuilder.AddToScheme,
-		awsv1alpha3.SchemeBuilder.AddToScheme,
-		awsv1beta1.SchemeBuilder.AddToScheme,
-		acmv1alpha1.SchemeBuilder.AddToScheme,
-		s3v1alpha2.SchemeBuilder.AddToScheme,
-		s3v1beta1.SchemeBuilder.AddToScheme,
-		secretsmanagerv1alpha1.SchemeBuilder.AddToScheme,
-		servicediscoveryv1alpha1.SchemeBuilder.AddToScheme,
-		acmpcav1alpha1.SchemeBuilder.AddToScheme,
-		eksv1beta1.SchemeBuilder.AddToScheme,
-		sqsv1beta1.SchemeBuilder.AddToScheme,
-		redshiftv1alpha1.SchemeBuilder.AddToScheme,
-		eksv1alpha1.SchemeBuilder.AddToScheme,
-		ecrv1alpha1.SchemeBuilder.AddToScheme,
-		apigatewayv2.SchemeBuilder.AddToScheme,
-		sfnv1alpha1.SchemeBuilder.AddToScheme,
-		dynamodbv1alpha1.SchemeBuilder.AddToScheme,
-		kmsv1alpha1.SchemeBuilder.AddToScheme,
-		efsv1alpha1.SchemeBuilder.AddToScheme,
-		rdsv1alpha1.SchemeBuilder.AddToScheme,
-		ec2v1alpha1.SchemeBuilder.AddToScheme,
-		lambdav1alpha1.SchemeBuilder.AddToScheme,
-		cloudfrontv1alpha1.SchemeBuilder.AddToScheme,
-		route53resolveralpha1.SchemeBuilder.AddToScheme,
-	)
+	for _, b := range schemeBuilders {
+		AddToSchemes = append(AddToSchemes, b.AddToScheme)
+	}
 }
 
 // AddToSchemes may be used to add all resources defined in the project to a Scheme
@@ -99,3 +129,29 @@ var AddToSchemes runtime.SchemeBuilder
 func AddToScheme(s *runtime.Scheme) error {
 	return AddToSchemes.AddToScheme(s)
 }
+
+// AddToSchemeForGroups adds the Resources of the supplied API groups, and of
+// the API groups they may reference, to the Scheme. The Resources of the
+// aws.crossplane.io API group are always added.
+func AddToSchemeForGroups(s *runtime.Scheme, groups []string) error {
+	enabled := map[string]bool{}
+	pending := append([]string{awsv1beta1.Group}, groups...)
+	for len(pending) > 0 {
+		g := pending[0]
+		pending = pending[1:]
+		if enabled[g] {
+			continue
+		}
+		enabled[g] = true
+		pending = append(pending, referencedGroups[g]...)
+	}
+	for _, b := range schemeBuilders {
+		if !enabled[b.GroupVersion.Group] {
+			continue
+		}
+		if err := b.AddToScheme(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
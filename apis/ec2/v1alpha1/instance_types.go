@@ -0,0 +1,261 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// Instance states.
+const (
+	InstanceStatePending      = "pending"
+	InstanceStateRunning      = "running"
+	InstanceStateShuttingDown = "shutting-down"
+	InstanceStateTerminated   = "terminated"
+	InstanceStateStopping     = "stopping"
+	InstanceStateStopped      = "stopped"
+)
+
+// InstanceParameters define the desired state of an AWS EC2 Instance.
+type InstanceParameters struct {
+	// Region is the region you'd like your Instance to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// ImageID is the ID of the AMI to launch the instance from.
+	// +immutable
+	ImageID string `json:"imageId"`
+
+	// InstanceType is the type of the instance, such as t3.micro. Changing
+	// the instance type stops a running instance, modifies it and starts it
+	// again. A stopped instance is modified and left stopped.
+	InstanceType string `json:"instanceType"`
+
+	// SubnetID is the ID of the subnet to launch the instance in.
+	// +immutable
+	// +optional
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef references a Subnet to retrieve its subnetId
+	// +immutable
+	// +optional
+	SubnetIDRef *xpv1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a Subnet to retrieve its subnetId
+	// +immutable
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the security groups of the instance.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs are references to SecurityGroups used to set
+	// the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []xpv1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used
+	// to set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// KeyName is the name of the key pair that can be used to log in to
+	// the instance.
+	// +immutable
+	// +optional
+	KeyName *string `json:"keyName,omitempty"`
+
+	// UserDataSecretRef selects a key of a Secret that holds the user data
+	// of the instance. The data is base64 encoded by the controller.
+	// Changing the user data stops a running instance, modifies it and
+	// starts it again. A stopped instance is modified and left stopped.
+	// +optional
+	UserDataSecretRef *xpv1.SecretKeySelector `json:"userDataSecretRef,omitempty"`
+
+	// IAMInstanceProfileARN is the ARN of the IAM instance profile of the
+	// instance.
+	// +immutable
+	// +optional
+	IAMInstanceProfileARN *string `json:"iamInstanceProfileArn,omitempty"`
+
+	// BlockDeviceMappings are the block devices of the instance, including
+	// its root volume.
+	// +immutable
+	// +optional
+	BlockDeviceMappings []BlockDeviceMapping `json:"blockDeviceMappings,omitempty"`
+
+	// MetadataOptions of the instance metadata service.
+	// +optional
+	MetadataOptions *InstanceMetadataOptions `json:"metadataOptions,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// BlockDeviceMapping describes a block device of an instance.
+type BlockDeviceMapping struct {
+	// DeviceName is the device name, such as /dev/sdh or xvdh.
+	DeviceName string `json:"deviceName"`
+
+	// EBS configures the EBS volume that is attached to the device.
+	// +optional
+	EBS *EBSBlockDevice `json:"ebs,omitempty"`
+
+	// NoDevice suppresses the device included in the block device mapping
+	// of the AMI.
+	// +optional
+	NoDevice *string `json:"noDevice,omitempty"`
+
+	// VirtualName is the instance store volume name, such as ephemeral0.
+	// +optional
+	VirtualName *string `json:"virtualName,omitempty"`
+}
+
+// EBSBlockDevice describes an EBS volume of a block device mapping.
+type EBSBlockDevice struct {
+	// DeleteOnTermination indicates whether the volume is deleted when the
+	// instance is terminated.
+	// +optional
+	DeleteOnTermination *bool `json:"deleteOnTermination,omitempty"`
+
+	// Encrypted indicates whether the volume is encrypted.
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+
+	// IOPS is the number of I/O operations per second of io1, io2 and gp3
+	// volumes.
+	// +optional
+	IOPS *int64 `json:"iops,omitempty"`
+
+	// KMSKeyID is the KMS key used to encrypt the volume.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// SnapshotID is the ID of the snapshot the volume is created from.
+	// +optional
+	SnapshotID *string `json:"snapshotId,omitempty"`
+
+	// VolumeSize is the size of the volume in GiB.
+	// +optional
+	VolumeSize *int64 `json:"volumeSize,omitempty"`
+
+	// VolumeType is the type of the volume.
+	// +kubebuilder:validation:Enum=standard;io1;io2;gp2;gp3;sc1;st1
+	// +optional
+	VolumeType *string `json:"volumeType,omitempty"`
+}
+
+// InstanceMetadataOptions configures the instance metadata service.
+type InstanceMetadataOptions struct {
+	// HTTPEndpoint enables or disables the metadata service.
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +optional
+	HTTPEndpoint *string `json:"httpEndpoint,omitempty"`
+
+	// HTTPTokens is required to only allow session token authenticated
+	// requests (IMDSv2) to the metadata service.
+	// +kubebuilder:validation:Enum=optional;required
+	// +optional
+	HTTPTokens *string `json:"httpTokens,omitempty"`
+
+	// HTTPPutResponseHopLimit is the hop limit of the PUT response of
+	// session token requests.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=64
+	// +optional
+	HTTPPutResponseHopLimit *int64 `json:"httpPutResponseHopLimit,omitempty"`
+}
+
+// An InstanceSpec defines the desired state of an Instance.
+type InstanceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceParameters `json:"forProvider"`
+}
+
+// InstanceObservation keeps the state for the external resource
+type InstanceObservation struct {
+	// InstanceID is the ID of the instance.
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// State is the current state of the instance.
+	State string `json:"state,omitempty"`
+
+	// StateReason is the reason for the most recent state transition.
+	StateReason string `json:"stateReason,omitempty"`
+
+	// AvailabilityZone of the instance.
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
+	// VPCID is the ID of the VPC of the instance.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// PrivateIPAddress is the private IPv4 address of the instance.
+	PrivateIPAddress string `json:"privateIpAddress,omitempty"`
+
+	// PrivateDNSName is the private DNS name of the instance.
+	PrivateDNSName string `json:"privateDnsName,omitempty"`
+
+	// PublicIPAddress is the public IPv4 address of the instance, if any.
+	PublicIPAddress string `json:"publicIpAddress,omitempty"`
+
+	// PublicDNSName is the public DNS name of the instance, if any.
+	PublicDNSName string `json:"publicDnsName,omitempty"`
+
+	// LaunchTime is the time the instance was launched.
+	LaunchTime *metav1.Time `json:"launchTime,omitempty"`
+}
+
+// An InstanceStatus represents the observed state of an Instance.
+type InstanceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Instance is a managed resource that represents an AWS EC2 Instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.instanceType"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="PRIVATE-IP",type="string",JSONPath=".status.atProvider.privateIpAddress"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Instance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceSpec   `json:"spec"`
+	Status InstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceList contains a list of Instances
+type InstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Instance `json:"items"`
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// LaunchTemplateParameters define the desired state of an AWS EC2 launch
+// template. The name of the launch template is the external name of the
+// resource.
+type LaunchTemplateParameters struct {
+	// Region is the region you'd like your LaunchTemplate to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// LaunchTemplateData is the configuration of the instances that are
+	// launched from the template. Every change creates a new version of the
+	// launch template and makes it the default version.
+	LaunchTemplateData LaunchTemplateData `json:"launchTemplateData"`
+
+	// VersionRetentionCount is the number of versions of the launch template
+	// that are kept, including the default version. Older versions are
+	// deleted. All versions are kept if it is not set.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	VersionRetentionCount *int64 `json:"versionRetentionCount,omitempty"`
+
+	// Tags of the launch template.
+	// +optional
+	Tags []v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// LaunchTemplateData is the configuration of the instances that are launched
+// from a launch template.
+type LaunchTemplateData struct {
+	// ImageID is the ID of the AMI.
+	// +optional
+	ImageID *string `json:"imageId,omitempty"`
+
+	// InstanceType is the type of the instances, such as t3.micro.
+	// +optional
+	InstanceType *string `json:"instanceType,omitempty"`
+
+	// KeyName is the name of the key pair that can be used to log in to the
+	// instances.
+	// +optional
+	KeyName *string `json:"keyName,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the security groups of the instances.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs are references to SecurityGroups used to set
+	// the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []xpv1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used
+	// to set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// UserData is the base64 encoded user data of the instances.
+	// +optional
+	UserData *string `json:"userData,omitempty"`
+
+	// IAMInstanceProfileARN is the ARN of the IAM instance profile of the
+	// instances.
+	// +optional
+	IAMInstanceProfileARN *string `json:"iamInstanceProfileArn,omitempty"`
+
+	// BlockDeviceMappings are the block devices of the instances.
+	// +optional
+	BlockDeviceMappings []BlockDeviceMapping `json:"blockDeviceMappings,omitempty"`
+
+	// MetadataOptions of the instance metadata service.
+	// +optional
+	MetadataOptions *InstanceMetadataOptions `json:"metadataOptions,omitempty"`
+
+	// EBSOptimized indicates whether the instances are optimized for EBS
+	// I/O.
+	// +optional
+	EBSOptimized *bool `json:"ebsOptimized,omitempty"`
+
+	// InstanceTags are applied to the instances launched from the template.
+	// +optional
+	InstanceTags []v1beta1.Tag `json:"instanceTags,omitempty"`
+}
+
+// A LaunchTemplateSpec defines the desired state of a LaunchTemplate.
+type LaunchTemplateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LaunchTemplateParameters `json:"forProvider"`
+}
+
+// LaunchTemplateObservation keeps the state for the external resource
+type LaunchTemplateObservation struct {
+	// LaunchTemplateID is the ID of the launch template.
+	LaunchTemplateID string `json:"launchTemplateId,omitempty"`
+
+	// LatestVersionNumber is the number of the latest version of the launch
+	// template.
+	LatestVersionNumber int64 `json:"latestVersionNumber,omitempty"`
+
+	// DefaultVersionNumber is the number of the default version of the
+	// launch template.
+	DefaultVersionNumber int64 `json:"defaultVersionNumber,omitempty"`
+
+	// CreateTime is the time the launch template was created.
+	CreateTime *metav1.Time `json:"createTime,omitempty"`
+}
+
+// A LaunchTemplateStatus represents the observed state of a LaunchTemplate.
+type LaunchTemplateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LaunchTemplateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LaunchTemplate is a managed resource that represents an AWS EC2 launch
+// template and its versions.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.launchTemplateId"
+// +kubebuilder:printcolumn:name="VERSION",type="integer",JSONPath=".status.atProvider.latestVersionNumber"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LaunchTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LaunchTemplateSpec   `json:"spec"`
+	Status LaunchTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LaunchTemplateList contains a list of LaunchTemplates
+type LaunchTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LaunchTemplate `json:"items"`
+}
@@ -48,6 +48,33 @@ var (
 	VPCCIDRBlockGroupVersionKind = SchemeGroupVersion.WithKind(VPCCIDRBlockKind)
 )
 
+// Instance type metadata.
+var (
+	InstanceKind             = reflect.TypeOf(Instance{}).Name()
+	InstanceGroupKind        = schema.GroupKind{Group: Group, Kind: InstanceKind}.String()
+	InstanceKindAPIVersion   = InstanceKind + "." + SchemeGroupVersion.String()
+	InstanceGroupVersionKind = SchemeGroupVersion.WithKind(InstanceKind)
+)
+
+// LaunchTemplate type metadata.
+var (
+	LaunchTemplateKind             = reflect.TypeOf(LaunchTemplate{}).Name()
+	LaunchTemplateGroupKind        = schema.GroupKind{Group: Group, Kind: LaunchTemplateKind}.String()
+	LaunchTemplateKindAPIVersion   = LaunchTemplateKind + "." + SchemeGroupVersion.String()
+	LaunchTemplateGroupVersionKind = SchemeGroupVersion.WithKind(LaunchTemplateKind)
+)
+
+// VPCEndpoint type metadata.
+var (
+	VPCEndpointKind             = reflect.TypeOf(VPCEndpoint{}).Name()
+	VPCEndpointGroupKind        = schema.GroupKind{Group: Group, Kind: VPCEndpointKind}.String()
+	VPCEndpointKindAPIVersion   = VPCEndpointKind + "." + SchemeGroupVersion.String()
+	VPCEndpointGroupVersionKind = SchemeGroupVersion.WithKind(VPCEndpointKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&VPCCIDRBlock{}, &VPCCIDRBlockList{})
+	SchemeBuilder.Register(&Instance{}, &InstanceList{})
+	SchemeBuilder.Register(&LaunchTemplate{}, &LaunchTemplateList{})
+	SchemeBuilder.Register(&VPCEndpoint{}, &VPCEndpointList{})
 }
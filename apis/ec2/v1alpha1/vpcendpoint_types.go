@@ -0,0 +1,205 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// Known VPC endpoint states.
+const (
+	VPCEndpointStatePendingAcceptance = "pendingAcceptance"
+	VPCEndpointStatePending           = "pending"
+	VPCEndpointStateAvailable         = "available"
+	VPCEndpointStateDeleting          = "deleting"
+	VPCEndpointStateDeleted           = "deleted"
+	VPCEndpointStateRejected          = "rejected"
+	VPCEndpointStateFailed            = "failed"
+	VPCEndpointStateExpired           = "expired"
+)
+
+// Known VPC endpoint types.
+const (
+	VPCEndpointTypeGateway   = "Gateway"
+	VPCEndpointTypeInterface = "Interface"
+)
+
+// VPCEndpointParameters define the desired state of an AWS VPC endpoint.
+type VPCEndpointParameters struct {
+	// Region is the region you'd like your VPCEndpoint to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// VPCID is the ID of the VPC in which the endpoint is created.
+	// +immutable
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId.
+	// +immutable
+	// +optional
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId.
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// ServiceName is the name of the service the endpoint connects to, such
+	// as com.amazonaws.us-east-1.s3.
+	// +immutable
+	ServiceName string `json:"serviceName"`
+
+	// VPCEndpointType is the type of the endpoint. Defaults to Gateway.
+	// +kubebuilder:validation:Enum=Gateway;Interface
+	// +immutable
+	// +optional
+	VPCEndpointType *string `json:"vpcEndpointType,omitempty"`
+
+	// PolicyDocument is the JSON policy document that controls access to the
+	// service through the endpoint. AWS attaches a policy that allows full
+	// access if it is not set.
+	// +optional
+	PolicyDocument *string `json:"policyDocument,omitempty"`
+
+	// PrivateDNSEnabled indicates whether a private hosted zone is
+	// associated with the VPC of an interface endpoint.
+	// +optional
+	PrivateDNSEnabled *bool `json:"privateDnsEnabled,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets in which the network interfaces of
+	// an interface endpoint are created.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs are references to Subnets used to set the SubnetIDs.
+	// +optional
+	SubnetIDRefs []xpv1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets used to set the
+	// SubnetIDs.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the security groups that are associated
+	// with the network interfaces of an interface endpoint.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs are references to SecurityGroups used to set
+	// the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []xpv1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used
+	// to set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// RouteTableIDs are the IDs of the route tables of a gateway endpoint.
+	// +optional
+	RouteTableIDs []string `json:"routeTableIds,omitempty"`
+
+	// RouteTableIDRefs are references to RouteTables used to set the
+	// RouteTableIDs.
+	// +optional
+	RouteTableIDRefs []xpv1.Reference `json:"routeTableIdRefs,omitempty"`
+
+	// RouteTableIDSelector selects references to RouteTables used to set
+	// the RouteTableIDs.
+	// +optional
+	RouteTableIDSelector *xpv1.Selector `json:"routeTableIdSelector,omitempty"`
+
+	// Tags of the VPC endpoint.
+	// +optional
+	Tags []v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// A VPCEndpointSpec defines the desired state of a VPCEndpoint.
+type VPCEndpointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VPCEndpointParameters `json:"forProvider"`
+}
+
+// DNSEntry is a DNS entry of a VPC endpoint.
+type DNSEntry struct {
+	// DNSName is the DNS name.
+	DNSName string `json:"dnsName,omitempty"`
+
+	// HostedZoneID is the ID of the private hosted zone.
+	HostedZoneID string `json:"hostedZoneId,omitempty"`
+}
+
+// VPCEndpointObservation keeps the state for the external resource
+type VPCEndpointObservation struct {
+	// VPCEndpointID is the ID of the VPC endpoint.
+	VPCEndpointID string `json:"vpcEndpointId,omitempty"`
+
+	// State of the VPC endpoint.
+	State string `json:"state,omitempty"`
+
+	// OwnerID is the ID of the AWS account that owns the VPC endpoint.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// NetworkInterfaceIDs are the IDs of the network interfaces of an
+	// interface endpoint.
+	NetworkInterfaceIDs []string `json:"networkInterfaceIds,omitempty"`
+
+	// DNSEntries are the DNS entries of an interface endpoint.
+	DNSEntries []DNSEntry `json:"dnsEntries,omitempty"`
+
+	// CreationTimestamp is the time the VPC endpoint was created.
+	CreationTimestamp *metav1.Time `json:"creationTimestamp,omitempty"`
+}
+
+// A VPCEndpointStatus represents the observed state of a VPCEndpoint.
+type VPCEndpointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VPCEndpointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPCEndpoint is a managed resource that represents an AWS gateway or
+// interface VPC endpoint.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="SERVICE",type="string",JSONPath=".spec.forProvider.serviceName"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPCEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPCEndpointSpec   `json:"spec"`
+	Status VPCEndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPCEndpointList contains a list of VPCEndpoints
+type VPCEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPCEndpoint `json:"items"`
+}
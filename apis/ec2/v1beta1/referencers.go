@@ -170,6 +170,38 @@ func (mg *RouteTable) ResolveReferences(ctx context.Context, c client.Reader) er
 		mg.Spec.ForProvider.Routes[i].NatGatewayIDRef = rsp.ResolvedReference
 	}
 
+	// Resolve spec.forProvider.routes[].transitGatewayId
+	for i := range mg.Spec.ForProvider.Routes {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: aws.StringValue(mg.Spec.ForProvider.Routes[i].TransitGatewayID),
+			Reference:    mg.Spec.ForProvider.Routes[i].TransitGatewayIDRef,
+			Selector:     mg.Spec.ForProvider.Routes[i].TransitGatewayIDSelector,
+			To:           reference.To{Managed: &TransitGateway{}, List: &TransitGatewayList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.routes[%d].transitGatewayId", i)
+		}
+		mg.Spec.ForProvider.Routes[i].TransitGatewayID = aws.String(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Routes[i].TransitGatewayIDRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.routes[].vpcPeeringConnectionId
+	for i := range mg.Spec.ForProvider.Routes {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: aws.StringValue(mg.Spec.ForProvider.Routes[i].VpcPeeringConnectionID),
+			Reference:    mg.Spec.ForProvider.Routes[i].VpcPeeringConnectionIDRef,
+			Selector:     mg.Spec.ForProvider.Routes[i].VpcPeeringConnectionIDSelector,
+			To:           reference.To{Managed: &VPCPeeringConnection{}, List: &VPCPeeringConnectionList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.routes[%d].vpcPeeringConnectionId", i)
+		}
+		mg.Spec.ForProvider.Routes[i].VpcPeeringConnectionID = aws.String(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Routes[i].VpcPeeringConnectionIDRef = rsp.ResolvedReference
+	}
+
 	// Resolve spec.associations[].subnetId
 	for i := range mg.Spec.ForProvider.Associations {
 		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
@@ -223,3 +255,234 @@ func (mg *NATGateway) ResolveReferences(ctx context.Context, c client.Reader) er
 
 	return nil
 }
+
+// ResolveReferences of this VPCPeeringConnection
+func (mg *VPCPeeringConnection) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.vpcId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: aws.StringValue(mg.Spec.ForProvider.VPCID),
+		Reference:    mg.Spec.ForProvider.VPCIDRef,
+		Selector:     mg.Spec.ForProvider.VPCIDSelector,
+		To:           reference.To{Managed: &VPC{}, List: &VPCList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcId")
+	}
+	mg.Spec.ForProvider.VPCID = aws.String(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VPCIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.peerVpcId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: aws.StringValue(mg.Spec.ForProvider.PeerVPCID),
+		Reference:    mg.Spec.ForProvider.PeerVPCIDRef,
+		Selector:     mg.Spec.ForProvider.PeerVPCIDSelector,
+		To:           reference.To{Managed: &VPC{}, List: &VPCList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.peerVpcId")
+	}
+	mg.Spec.ForProvider.PeerVPCID = aws.String(rsp.ResolvedValue)
+	mg.Spec.ForProvider.PeerVPCIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this VPCPeeringConnectionAccepter
+func (mg *VPCPeeringConnectionAccepter) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.vpcPeeringConnectionId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: aws.StringValue(mg.Spec.ForProvider.VPCPeeringConnectionID),
+		Reference:    mg.Spec.ForProvider.VPCPeeringConnectionIDRef,
+		Selector:     mg.Spec.ForProvider.VPCPeeringConnectionIDSelector,
+		To:           reference.To{Managed: &VPCPeeringConnection{}, List: &VPCPeeringConnectionList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcPeeringConnectionId")
+	}
+	mg.Spec.ForProvider.VPCPeeringConnectionID = aws.String(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VPCPeeringConnectionIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this TransitGatewayVPCAttachment
+func (mg *TransitGatewayVPCAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.transitGatewayId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: aws.StringValue(mg.Spec.ForProvider.TransitGatewayID),
+		Reference:    mg.Spec.ForProvider.TransitGatewayIDRef,
+		Selector:     mg.Spec.ForProvider.TransitGatewayIDSelector,
+		To:           reference.To{Managed: &TransitGateway{}, List: &TransitGatewayList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.transitGatewayId")
+	}
+	mg.Spec.ForProvider.TransitGatewayID = aws.String(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TransitGatewayIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.vpcId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: aws.StringValue(mg.Spec.ForProvider.VPCID),
+		Reference:    mg.Spec.ForProvider.VPCIDRef,
+		Selector:     mg.Spec.ForProvider.VPCIDSelector,
+		To:           reference.To{Managed: &VPC{}, List: &VPCList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcId")
+	}
+	mg.Spec.ForProvider.VPCID = aws.String(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VPCIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.subnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SubnetIDs,
+		References:    mg.Spec.ForProvider.SubnetIDRefs,
+		Selector:      mg.Spec.ForProvider.SubnetIDSelector,
+		To:            reference.To{Managed: &Subnet{}, List: &SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.subnetIds")
+	}
+	mg.Spec.ForProvider.SubnetIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SubnetIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this TransitGatewayRouteTable
+func (mg *TransitGatewayRouteTable) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.transitGatewayId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: aws.StringValue(mg.Spec.ForProvider.TransitGatewayID),
+		Reference:    mg.Spec.ForProvider.TransitGatewayIDRef,
+		Selector:     mg.Spec.ForProvider.TransitGatewayIDSelector,
+		To:           reference.To{Managed: &TransitGateway{}, List: &TransitGatewayList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.transitGatewayId")
+	}
+	mg.Spec.ForProvider.TransitGatewayID = aws.String(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TransitGatewayIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this TransitGatewayRouteTableAssociation
+func (mg *TransitGatewayRouteTableAssociation) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.transitGatewayRouteTableId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: aws.StringValue(mg.Spec.ForProvider.TransitGatewayRouteTableID),
+		Reference:    mg.Spec.ForProvider.TransitGatewayRouteTableIDRef,
+		Selector:     mg.Spec.ForProvider.TransitGatewayRouteTableIDSelector,
+		To:           reference.To{Managed: &TransitGatewayRouteTable{}, List: &TransitGatewayRouteTableList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.transitGatewayRouteTableId")
+	}
+	mg.Spec.ForProvider.TransitGatewayRouteTableID = aws.String(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TransitGatewayRouteTableIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.transitGatewayAttachmentId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: aws.StringValue(mg.Spec.ForProvider.TransitGatewayAttachmentID),
+		Reference:    mg.Spec.ForProvider.TransitGatewayAttachmentIDRef,
+		Selector:     mg.Spec.ForProvider.TransitGatewayAttachmentIDSelector,
+		To:           reference.To{Managed: &TransitGatewayVPCAttachment{}, List: &TransitGatewayVPCAttachmentList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.transitGatewayAttachmentId")
+	}
+	mg.Spec.ForProvider.TransitGatewayAttachmentID = aws.String(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TransitGatewayAttachmentIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this TransitGatewayRouteTablePropagation
+func (mg *TransitGatewayRouteTablePropagation) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.transitGatewayRouteTableId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: aws.StringValue(mg.Spec.ForProvider.TransitGatewayRouteTableID),
+		Reference:    mg.Spec.ForProvider.TransitGatewayRouteTableIDRef,
+		Selector:     mg.Spec.ForProvider.TransitGatewayRouteTableIDSelector,
+		To:           reference.To{Managed: &TransitGatewayRouteTable{}, List: &TransitGatewayRouteTableList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.transitGatewayRouteTableId")
+	}
+	mg.Spec.ForProvider.TransitGatewayRouteTableID = aws.String(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TransitGatewayRouteTableIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.transitGatewayAttachmentId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: aws.StringValue(mg.Spec.ForProvider.TransitGatewayAttachmentID),
+		Reference:    mg.Spec.ForProvider.TransitGatewayAttachmentIDRef,
+		Selector:     mg.Spec.ForProvider.TransitGatewayAttachmentIDSelector,
+		To:           reference.To{Managed: &TransitGatewayVPCAttachment{}, List: &TransitGatewayVPCAttachmentList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.transitGatewayAttachmentId")
+	}
+	mg.Spec.ForProvider.TransitGatewayAttachmentID = aws.String(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TransitGatewayAttachmentIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this TransitGatewayRoute
+func (mg *TransitGatewayRoute) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.transitGatewayRouteTableId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: aws.StringValue(mg.Spec.ForProvider.TransitGatewayRouteTableID),
+		Reference:    mg.Spec.ForProvider.TransitGatewayRouteTableIDRef,
+		Selector:     mg.Spec.ForProvider.TransitGatewayRouteTableIDSelector,
+		To:           reference.To{Managed: &TransitGatewayRouteTable{}, List: &TransitGatewayRouteTableList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.transitGatewayRouteTableId")
+	}
+	mg.Spec.ForProvider.TransitGatewayRouteTableID = aws.String(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TransitGatewayRouteTableIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.transitGatewayAttachmentId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: aws.StringValue(mg.Spec.ForProvider.TransitGatewayAttachmentID),
+		Reference:    mg.Spec.ForProvider.TransitGatewayAttachmentIDRef,
+		Selector:     mg.Spec.ForProvider.TransitGatewayAttachmentIDSelector,
+		To:           reference.To{Managed: &TransitGatewayVPCAttachment{}, List: &TransitGatewayVPCAttachmentList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.transitGatewayAttachmentId")
+	}
+	mg.Spec.ForProvider.TransitGatewayAttachmentID = aws.String(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TransitGatewayAttachmentIDRef = rsp.ResolvedReference
+
+	return nil
+}
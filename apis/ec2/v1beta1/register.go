@@ -96,6 +96,70 @@ var (
 	AddressGroupVersionKind = SchemeGroupVersion.WithKind(AddressKind)
 )
 
+// VPCPeeringConnection type metadata.
+var (
+	VPCPeeringConnectionKind             = reflect.TypeOf(VPCPeeringConnection{}).Name()
+	VPCPeeringConnectionGroupKind        = schema.GroupKind{Group: Group, Kind: VPCPeeringConnectionKind}.String()
+	VPCPeeringConnectionKindAPIVersion   = VPCPeeringConnectionKind + "." + SchemeGroupVersion.String()
+	VPCPeeringConnectionGroupVersionKind = SchemeGroupVersion.WithKind(VPCPeeringConnectionKind)
+)
+
+// VPCPeeringConnectionAccepter type metadata.
+var (
+	VPCPeeringConnectionAccepterKind             = reflect.TypeOf(VPCPeeringConnectionAccepter{}).Name()
+	VPCPeeringConnectionAccepterGroupKind        = schema.GroupKind{Group: Group, Kind: VPCPeeringConnectionAccepterKind}.String()
+	VPCPeeringConnectionAccepterKindAPIVersion   = VPCPeeringConnectionAccepterKind + "." + SchemeGroupVersion.String()
+	VPCPeeringConnectionAccepterGroupVersionKind = SchemeGroupVersion.WithKind(VPCPeeringConnectionAccepterKind)
+)
+
+// TransitGateway type metadata.
+var (
+	TransitGatewayKind             = reflect.TypeOf(TransitGateway{}).Name()
+	TransitGatewayGroupKind        = schema.GroupKind{Group: Group, Kind: TransitGatewayKind}.String()
+	TransitGatewayKindAPIVersion   = TransitGatewayKind + "." + SchemeGroupVersion.String()
+	TransitGatewayGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayKind)
+)
+
+// TransitGatewayVPCAttachment type metadata.
+var (
+	TransitGatewayVPCAttachmentKind             = reflect.TypeOf(TransitGatewayVPCAttachment{}).Name()
+	TransitGatewayVPCAttachmentGroupKind        = schema.GroupKind{Group: Group, Kind: TransitGatewayVPCAttachmentKind}.String()
+	TransitGatewayVPCAttachmentKindAPIVersion   = TransitGatewayVPCAttachmentKind + "." + SchemeGroupVersion.String()
+	TransitGatewayVPCAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayVPCAttachmentKind)
+)
+
+// TransitGatewayRouteTable type metadata.
+var (
+	TransitGatewayRouteTableKind             = reflect.TypeOf(TransitGatewayRouteTable{}).Name()
+	TransitGatewayRouteTableGroupKind        = schema.GroupKind{Group: Group, Kind: TransitGatewayRouteTableKind}.String()
+	TransitGatewayRouteTableKindAPIVersion   = TransitGatewayRouteTableKind + "." + SchemeGroupVersion.String()
+	TransitGatewayRouteTableGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayRouteTableKind)
+)
+
+// TransitGatewayRouteTableAssociation type metadata.
+var (
+	TransitGatewayRouteTableAssociationKind             = reflect.TypeOf(TransitGatewayRouteTableAssociation{}).Name()
+	TransitGatewayRouteTableAssociationGroupKind        = schema.GroupKind{Group: Group, Kind: TransitGatewayRouteTableAssociationKind}.String()
+	TransitGatewayRouteTableAssociationKindAPIVersion   = TransitGatewayRouteTableAssociationKind + "." + SchemeGroupVersion.String()
+	TransitGatewayRouteTableAssociationGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayRouteTableAssociationKind)
+)
+
+// TransitGatewayRouteTablePropagation type metadata.
+var (
+	TransitGatewayRouteTablePropagationKind             = reflect.TypeOf(TransitGatewayRouteTablePropagation{}).Name()
+	TransitGatewayRouteTablePropagationGroupKind        = schema.GroupKind{Group: Group, Kind: TransitGatewayRouteTablePropagationKind}.String()
+	TransitGatewayRouteTablePropagationKindAPIVersion   = TransitGatewayRouteTablePropagationKind + "." + SchemeGroupVersion.String()
+	TransitGatewayRouteTablePropagationGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayRouteTablePropagationKind)
+)
+
+// TransitGatewayRoute type metadata.
+var (
+	TransitGatewayRouteKind             = reflect.TypeOf(TransitGatewayRoute{}).Name()
+	TransitGatewayRouteGroupKind        = schema.GroupKind{Group: Group, Kind: TransitGatewayRouteKind}.String()
+	TransitGatewayRouteKindAPIVersion   = TransitGatewayRouteKind + "." + SchemeGroupVersion.String()
+	TransitGatewayRouteGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayRouteKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&VPC{}, &VPCList{})
 	SchemeBuilder.Register(&Subnet{}, &SubnetList{})
@@ -104,4 +168,12 @@ func init() {
 	SchemeBuilder.Register(&RouteTable{}, &RouteTableList{})
 	SchemeBuilder.Register(&NATGateway{}, &NATGatewayList{})
 	SchemeBuilder.Register(&Address{}, &AddressList{})
+	SchemeBuilder.Register(&VPCPeeringConnection{}, &VPCPeeringConnectionList{})
+	SchemeBuilder.Register(&VPCPeeringConnectionAccepter{}, &VPCPeeringConnectionAccepterList{})
+	SchemeBuilder.Register(&TransitGateway{}, &TransitGatewayList{})
+	SchemeBuilder.Register(&TransitGatewayVPCAttachment{}, &TransitGatewayVPCAttachmentList{})
+	SchemeBuilder.Register(&TransitGatewayRouteTable{}, &TransitGatewayRouteTableList{})
+	SchemeBuilder.Register(&TransitGatewayRouteTableAssociation{}, &TransitGatewayRouteTableAssociationList{})
+	SchemeBuilder.Register(&TransitGatewayRouteTablePropagation{}, &TransitGatewayRouteTablePropagationList{})
+	SchemeBuilder.Register(&TransitGatewayRoute{}, &TransitGatewayRouteList{})
 }
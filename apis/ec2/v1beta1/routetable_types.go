@@ -67,9 +67,23 @@ type Route struct {
 	// The ID of a network interface.
 	NetworkInterfaceID *string `json:"networkInterfaceId,omitempty"`
 
+	// A referencer to retrieve the ID of a transit gateway
+	TransitGatewayIDRef *xpv1.Reference `json:"transitGatewayIdRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the ID of a transit
+	// gateway
+	TransitGatewayIDSelector *xpv1.Selector `json:"transitGatewayIdSelector,omitempty"`
+
 	// The ID of a transit gateway.
 	TransitGatewayID *string `json:"transitGatewayId,omitempty"`
 
+	// A referencer to retrieve the ID of a VPC peering connection
+	VpcPeeringConnectionIDRef *xpv1.Reference `json:"vpcPeeringConnectionIdRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the ID of a VPC peering
+	// connection
+	VpcPeeringConnectionIDSelector *xpv1.Selector `json:"vpcPeeringConnectionIdSelector,omitempty"`
+
 	// The ID of a VPC peering connection.
 	VpcPeeringConnectionID *string `json:"vpcPeeringConnectionId,omitempty"`
 }
@@ -80,6 +94,10 @@ func (r *Route) ClearRefSelectors() {
 	r.GatewayIDSelector = nil
 	r.NatGatewayIDSelector = nil
 	r.NatGatewayIDRef = nil
+	r.TransitGatewayIDRef = nil
+	r.TransitGatewayIDSelector = nil
+	r.VpcPeeringConnectionIDRef = nil
+	r.VpcPeeringConnectionIDSelector = nil
 }
 
 // RouteState describes a route state in the route table.
@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Defines the states of TransitGateway
+const (
+	TransitGatewayStatePending   = "pending"
+	TransitGatewayStateAvailable = "available"
+	TransitGatewayStateModifying = "modifying"
+	TransitGatewayStateDeleting  = "deleting"
+	TransitGatewayStateDeleted   = "deleted"
+)
+
+// TransitGatewayParameters define the desired state of an AWS Transit
+// Gateway. The options of a transit gateway cannot be changed after it has
+// been created.
+type TransitGatewayParameters struct {
+	// Region is the region you'd like your TransitGateway to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Description of the transit gateway.
+	// +immutable
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// AmazonSideASN is the private Autonomous System Number (ASN) for the
+	// Amazon side of a BGP session. Defaults to 64512.
+	// +immutable
+	// +optional
+	AmazonSideASN *int64 `json:"amazonSideAsn,omitempty"`
+
+	// AutoAcceptSharedAttachments indicates whether attachment requests are
+	// automatically accepted.
+	// +kubebuilder:validation:Enum=enable;disable
+	// +immutable
+	// +optional
+	AutoAcceptSharedAttachments *string `json:"autoAcceptSharedAttachments,omitempty"`
+
+	// DefaultRouteTableAssociation enables the automatic association of
+	// attachments with the default association route table.
+	// +kubebuilder:validation:Enum=enable;disable
+	// +immutable
+	// +optional
+	DefaultRouteTableAssociation *string `json:"defaultRouteTableAssociation,omitempty"`
+
+	// DefaultRouteTablePropagation enables the automatic propagation of
+	// attachment routes to the default propagation route table.
+	// +kubebuilder:validation:Enum=enable;disable
+	// +immutable
+	// +optional
+	DefaultRouteTablePropagation *string `json:"defaultRouteTablePropagation,omitempty"`
+
+	// DNSSupport enables DNS support.
+	// +kubebuilder:validation:Enum=enable;disable
+	// +immutable
+	// +optional
+	DNSSupport *string `json:"dnsSupport,omitempty"`
+
+	// VPNECMPSupport enables Equal Cost Multipath Protocol support for VPN
+	// attachments.
+	// +kubebuilder:validation:Enum=enable;disable
+	// +immutable
+	// +optional
+	VPNECMPSupport *string `json:"vpnEcmpSupport,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TransitGatewaySpec defines the desired state of a TransitGateway.
+type TransitGatewaySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TransitGatewayParameters `json:"forProvider"`
+}
+
+// TransitGatewayObservation keeps the state for the external resource
+type TransitGatewayObservation struct {
+	// TransitGatewayID is the ID of the transit gateway.
+	TransitGatewayID string `json:"transitGatewayId,omitempty"`
+
+	// TransitGatewayARN is the Amazon Resource Name of the transit gateway.
+	TransitGatewayARN string `json:"transitGatewayArn,omitempty"`
+
+	// State of the transit gateway.
+	State string `json:"state,omitempty"`
+
+	// OwnerID is the ID of the AWS account that owns the transit gateway.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// AssociationDefaultRouteTableID is the ID of the default association
+	// route table.
+	AssociationDefaultRouteTableID string `json:"associationDefaultRouteTableId,omitempty"`
+
+	// PropagationDefaultRouteTableID is the ID of the default propagation
+	// route table.
+	PropagationDefaultRouteTableID string `json:"propagationDefaultRouteTableId,omitempty"`
+}
+
+// A TransitGatewayStatus represents the observed state of a TransitGateway.
+type TransitGatewayStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TransitGatewayObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransitGateway is a managed resource that represents an AWS Transit
+// Gateway.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TransitGateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewaySpec   `json:"spec"`
+	Status TransitGatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayList contains a list of TransitGateways
+type TransitGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGateway `json:"items"`
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Defines the states of TransitGatewayRoute
+const (
+	TransitGatewayRouteStatePending   = "pending"
+	TransitGatewayRouteStateActive    = "active"
+	TransitGatewayRouteStateBlackhole = "blackhole"
+	TransitGatewayRouteStateDeleting  = "deleting"
+	TransitGatewayRouteStateDeleted   = "deleted"
+)
+
+// TransitGatewayRouteParameters define the desired state of a static route
+// in an AWS Transit Gateway route table.
+type TransitGatewayRouteParameters struct {
+	// Region is the region of the transit gateway.
+	// +immutable
+	Region string `json:"region"`
+
+	// DestinationCIDRBlock is the CIDR range used for destination matches.
+	// +immutable
+	DestinationCIDRBlock string `json:"destinationCidrBlock"`
+
+	// Blackhole indicates whether traffic matching this route is dropped.
+	// The attachment must not be set if it is true.
+	// +optional
+	Blackhole *bool `json:"blackhole,omitempty"`
+
+	// TransitGatewayRouteTableID is the ID of the transit gateway route table.
+	// +immutable
+	// +optional
+	TransitGatewayRouteTableID *string `json:"transitGatewayRouteTableId,omitempty"`
+
+	// TransitGatewayRouteTableIDRef references a TransitGatewayRouteTable to
+	// retrieve its ID.
+	// +immutable
+	// +optional
+	TransitGatewayRouteTableIDRef *xpv1.Reference `json:"transitGatewayRouteTableIdRef,omitempty"`
+
+	// TransitGatewayRouteTableIDSelector selects a reference to a
+	// TransitGatewayRouteTable to retrieve its ID.
+	// +optional
+	TransitGatewayRouteTableIDSelector *xpv1.Selector `json:"transitGatewayRouteTableIdSelector,omitempty"`
+
+	// TransitGatewayAttachmentID is the ID of the attachment that traffic is
+	// routed to.
+	// +optional
+	TransitGatewayAttachmentID *string `json:"transitGatewayAttachmentId,omitempty"`
+
+	// TransitGatewayAttachmentIDRef references a TransitGatewayVPCAttachment
+	// to retrieve its ID.
+	// +optional
+	TransitGatewayAttachmentIDRef *xpv1.Reference `json:"transitGatewayAttachmentIdRef,omitempty"`
+
+	// TransitGatewayAttachmentIDSelector selects a reference to a
+	// TransitGatewayVPCAttachment to retrieve its ID.
+	// +optional
+	TransitGatewayAttachmentIDSelector *xpv1.Selector `json:"transitGatewayAttachmentIdSelector,omitempty"`
+}
+
+// A TransitGatewayRouteSpec defines the desired state of a
+// TransitGatewayRoute.
+type TransitGatewayRouteSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TransitGatewayRouteParameters `json:"forProvider"`
+}
+
+// TransitGatewayRouteObservation keeps the state for the external resource
+type TransitGatewayRouteObservation struct {
+	// State of the route.
+	State string `json:"state,omitempty"`
+
+	// Type of the route.
+	Type string `json:"type,omitempty"`
+}
+
+// A TransitGatewayRouteStatus represents the observed state of a
+// TransitGatewayRoute.
+type TransitGatewayRouteStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TransitGatewayRouteObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransitGatewayRoute is a managed resource that represents a static route
+// in an AWS Transit Gateway route table.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DESTINATION",type="string",JSONPath=".spec.forProvider.destinationCidrBlock"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TransitGatewayRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewayRouteSpec   `json:"spec"`
+	Status TransitGatewayRouteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayRouteList contains a list of TransitGatewayRoutes
+type TransitGatewayRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGatewayRoute `json:"items"`
+}
@@ -0,0 +1,315 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Defines the states of TransitGatewayRouteTable
+const (
+	TransitGatewayRouteTableStatePending   = "pending"
+	TransitGatewayRouteTableStateAvailable = "available"
+	TransitGatewayRouteTableStateDeleting  = "deleting"
+	TransitGatewayRouteTableStateDeleted   = "deleted"
+)
+
+// Defines the states of TransitGatewayRouteTableAssociation
+const (
+	TransitGatewayAssociationStateAssociating    = "associating"
+	TransitGatewayAssociationStateAssociated     = "associated"
+	TransitGatewayAssociationStateDisassociating = "disassociating"
+	TransitGatewayAssociationStateDisassociated  = "disassociated"
+)
+
+// Defines the states of TransitGatewayRouteTablePropagation
+const (
+	TransitGatewayPropagationStateEnabling  = "enabling"
+	TransitGatewayPropagationStateEnabled   = "enabled"
+	TransitGatewayPropagationStateDisabling = "disabling"
+	TransitGatewayPropagationStateDisabled  = "disabled"
+)
+
+// TransitGatewayRouteTableParameters define the desired state of an AWS
+// Transit Gateway route table.
+type TransitGatewayRouteTableParameters struct {
+	// Region is the region you'd like your TransitGatewayRouteTable to be
+	// created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// TransitGatewayID is the ID of the transit gateway.
+	// +immutable
+	// +optional
+	TransitGatewayID *string `json:"transitGatewayId,omitempty"`
+
+	// TransitGatewayIDRef references a TransitGateway to retrieve its ID.
+	// +immutable
+	// +optional
+	TransitGatewayIDRef *xpv1.Reference `json:"transitGatewayIdRef,omitempty"`
+
+	// TransitGatewayIDSelector selects a reference to a TransitGateway to
+	// retrieve its ID.
+	// +optional
+	TransitGatewayIDSelector *xpv1.Selector `json:"transitGatewayIdSelector,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TransitGatewayRouteTableSpec defines the desired state of a
+// TransitGatewayRouteTable.
+type TransitGatewayRouteTableSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TransitGatewayRouteTableParameters `json:"forProvider"`
+}
+
+// TransitGatewayRouteTableObservation keeps the state for the external
+// resource
+type TransitGatewayRouteTableObservation struct {
+	// TransitGatewayRouteTableID is the ID of the route table.
+	TransitGatewayRouteTableID string `json:"transitGatewayRouteTableId,omitempty"`
+
+	// State of the route table.
+	State string `json:"state,omitempty"`
+
+	// DefaultAssociationRouteTable indicates whether this is the default
+	// association route table of the transit gateway.
+	DefaultAssociationRouteTable bool `json:"defaultAssociationRouteTable,omitempty"`
+
+	// DefaultPropagationRouteTable indicates whether this is the default
+	// propagation route table of the transit gateway.
+	DefaultPropagationRouteTable bool `json:"defaultPropagationRouteTable,omitempty"`
+}
+
+// A TransitGatewayRouteTableStatus represents the observed state of a
+// TransitGatewayRouteTable.
+type TransitGatewayRouteTableStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TransitGatewayRouteTableObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransitGatewayRouteTable is a managed resource that represents an AWS
+// Transit Gateway route table.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TransitGatewayRouteTable struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewayRouteTableSpec   `json:"spec"`
+	Status TransitGatewayRouteTableStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayRouteTableList contains a list of TransitGatewayRouteTables
+type TransitGatewayRouteTableList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGatewayRouteTable `json:"items"`
+}
+
+// TransitGatewayRouteTableAssociationParameters define the desired state of
+// the association of a transit gateway attachment with a route table.
+type TransitGatewayRouteTableAssociationParameters struct {
+	// Region is the region of the transit gateway.
+	// +immutable
+	Region string `json:"region"`
+
+	// TransitGatewayRouteTableID is the ID of the transit gateway route table.
+	// +immutable
+	// +optional
+	TransitGatewayRouteTableID *string `json:"transitGatewayRouteTableId,omitempty"`
+
+	// TransitGatewayRouteTableIDRef references a TransitGatewayRouteTable to
+	// retrieve its ID.
+	// +immutable
+	// +optional
+	TransitGatewayRouteTableIDRef *xpv1.Reference `json:"transitGatewayRouteTableIdRef,omitempty"`
+
+	// TransitGatewayRouteTableIDSelector selects a reference to a
+	// TransitGatewayRouteTable to retrieve its ID.
+	// +optional
+	TransitGatewayRouteTableIDSelector *xpv1.Selector `json:"transitGatewayRouteTableIdSelector,omitempty"`
+
+	// TransitGatewayAttachmentID is the ID of the attachment.
+	// +immutable
+	// +optional
+	TransitGatewayAttachmentID *string `json:"transitGatewayAttachmentId,omitempty"`
+
+	// TransitGatewayAttachmentIDRef references a TransitGatewayVPCAttachment
+	// to retrieve its ID.
+	// +immutable
+	// +optional
+	TransitGatewayAttachmentIDRef *xpv1.Reference `json:"transitGatewayAttachmentIdRef,omitempty"`
+
+	// TransitGatewayAttachmentIDSelector selects a reference to a
+	// TransitGatewayVPCAttachment to retrieve its ID.
+	// +optional
+	TransitGatewayAttachmentIDSelector *xpv1.Selector `json:"transitGatewayAttachmentIdSelector,omitempty"`
+}
+
+// A TransitGatewayRouteTableAssociationSpec defines the desired state of a
+// TransitGatewayRouteTableAssociation.
+type TransitGatewayRouteTableAssociationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TransitGatewayRouteTableAssociationParameters `json:"forProvider"`
+}
+
+// TransitGatewayRouteTableAttachmentObservation keeps the state of the
+// association or propagation of a transit gateway attachment.
+type TransitGatewayRouteTableAttachmentObservation struct {
+	// State of the association or propagation.
+	State string `json:"state,omitempty"`
+
+	// ResourceID is the ID of the attached resource.
+	ResourceID string `json:"resourceId,omitempty"`
+
+	// ResourceType is the type of the attached resource.
+	ResourceType string `json:"resourceType,omitempty"`
+}
+
+// A TransitGatewayRouteTableAssociationStatus represents the observed state
+// of a TransitGatewayRouteTableAssociation.
+type TransitGatewayRouteTableAssociationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TransitGatewayRouteTableAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransitGatewayRouteTableAssociation is a managed resource that associates
+// a transit gateway attachment with a transit gateway route table.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ROUTETABLE",type="string",JSONPath=".spec.forProvider.transitGatewayRouteTableId"
+// +kubebuilder:printcolumn:name="ATTACHMENT",type="string",JSONPath=".spec.forProvider.transitGatewayAttachmentId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TransitGatewayRouteTableAssociation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewayRouteTableAssociationSpec   `json:"spec"`
+	Status TransitGatewayRouteTableAssociationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayRouteTableAssociationList contains a list of
+// TransitGatewayRouteTableAssociations
+type TransitGatewayRouteTableAssociationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGatewayRouteTableAssociation `json:"items"`
+}
+
+// TransitGatewayRouteTablePropagationParameters define the desired state of
+// the propagation of the routes of a transit gateway attachment to a route
+// table.
+type TransitGatewayRouteTablePropagationParameters struct {
+	// Region is the region of the transit gateway.
+	// +immutable
+	Region string `json:"region"`
+
+	// TransitGatewayRouteTableID is the ID of the transit gateway route table.
+	// +immutable
+	// +optional
+	TransitGatewayRouteTableID *string `json:"transitGatewayRouteTableId,omitempty"`
+
+	// TransitGatewayRouteTableIDRef references a TransitGatewayRouteTable to
+	// retrieve its ID.
+	// +immutable
+	// +optional
+	TransitGatewayRouteTableIDRef *xpv1.Reference `json:"transitGatewayRouteTableIdRef,omitempty"`
+
+	// TransitGatewayRouteTableIDSelector selects a reference to a
+	// TransitGatewayRouteTable to retrieve its ID.
+	// +optional
+	TransitGatewayRouteTableIDSelector *xpv1.Selector `json:"transitGatewayRouteTableIdSelector,omitempty"`
+
+	// TransitGatewayAttachmentID is the ID of the attachment.
+	// +immutable
+	// +optional
+	TransitGatewayAttachmentID *string `json:"transitGatewayAttachmentId,omitempty"`
+
+	// TransitGatewayAttachmentIDRef references a TransitGatewayVPCAttachment
+	// to retrieve its ID.
+	// +immutable
+	// +optional
+	TransitGatewayAttachmentIDRef *xpv1.Reference `json:"transitGatewayAttachmentIdRef,omitempty"`
+
+	// TransitGatewayAttachmentIDSelector selects a reference to a
+	// TransitGatewayVPCAttachment to retrieve its ID.
+	// +optional
+	TransitGatewayAttachmentIDSelector *xpv1.Selector `json:"transitGatewayAttachmentIdSelector,omitempty"`
+}
+
+// A TransitGatewayRouteTablePropagationSpec defines the desired state of a
+// TransitGatewayRouteTablePropagation.
+type TransitGatewayRouteTablePropagationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TransitGatewayRouteTablePropagationParameters `json:"forProvider"`
+}
+
+// A TransitGatewayRouteTablePropagationStatus represents the observed state
+// of a TransitGatewayRouteTablePropagation.
+type TransitGatewayRouteTablePropagationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TransitGatewayRouteTableAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransitGatewayRouteTablePropagation is a managed resource that
+// propagates the routes of a transit gateway attachment to a transit gateway
+// route table.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ROUTETABLE",type="string",JSONPath=".spec.forProvider.transitGatewayRouteTableId"
+// +kubebuilder:printcolumn:name="ATTACHMENT",type="string",JSONPath=".spec.forProvider.transitGatewayAttachmentId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TransitGatewayRouteTablePropagation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewayRouteTablePropagationSpec   `json:"spec"`
+	Status TransitGatewayRouteTablePropagationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayRouteTablePropagationList contains a list of
+// TransitGatewayRouteTablePropagations
+type TransitGatewayRouteTablePropagationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGatewayRouteTablePropagation `json:"items"`
+}
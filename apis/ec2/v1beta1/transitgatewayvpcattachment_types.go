@@ -0,0 +1,155 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Defines the states of TransitGatewayVPCAttachment
+const (
+	TransitGatewayAttachmentStateInitiating        = "initiating"
+	TransitGatewayAttachmentStatePendingAcceptance = "pendingAcceptance"
+	TransitGatewayAttachmentStatePending           = "pending"
+	TransitGatewayAttachmentStateAvailable         = "available"
+	TransitGatewayAttachmentStateModifying         = "modifying"
+	TransitGatewayAttachmentStateDeleting          = "deleting"
+	TransitGatewayAttachmentStateDeleted           = "deleted"
+)
+
+// TransitGatewayVPCAttachmentParameters define the desired state of an AWS
+// Transit Gateway VPC attachment.
+type TransitGatewayVPCAttachmentParameters struct {
+	// Region is the region you'd like your TransitGatewayVPCAttachment to be
+	// created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// TransitGatewayID is the ID of the transit gateway.
+	// +immutable
+	// +optional
+	TransitGatewayID *string `json:"transitGatewayId,omitempty"`
+
+	// TransitGatewayIDRef references a TransitGateway to retrieve its ID.
+	// +immutable
+	// +optional
+	TransitGatewayIDRef *xpv1.Reference `json:"transitGatewayIdRef,omitempty"`
+
+	// TransitGatewayIDSelector selects a reference to a TransitGateway to
+	// retrieve its ID.
+	// +optional
+	TransitGatewayIDSelector *xpv1.Selector `json:"transitGatewayIdSelector,omitempty"`
+
+	// VPCID is the ID of the VPC to attach.
+	// +immutable
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId.
+	// +immutable
+	// +optional
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId.
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets, one per availability zone, in
+	// which the transit gateway places a network interface.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs references Subnets to retrieve their subnetIds.
+	// +optional
+	SubnetIDRefs []xpv1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets to retrieve their
+	// subnetIds.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// DNSSupport enables DNS support.
+	// +kubebuilder:validation:Enum=enable;disable
+	// +optional
+	DNSSupport *string `json:"dnsSupport,omitempty"`
+
+	// IPv6Support enables IPv6 support.
+	// +kubebuilder:validation:Enum=enable;disable
+	// +optional
+	IPv6Support *string `json:"ipv6Support,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TransitGatewayVPCAttachmentSpec defines the desired state of a
+// TransitGatewayVPCAttachment.
+type TransitGatewayVPCAttachmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TransitGatewayVPCAttachmentParameters `json:"forProvider"`
+}
+
+// TransitGatewayVPCAttachmentObservation keeps the state for the external
+// resource
+type TransitGatewayVPCAttachmentObservation struct {
+	// TransitGatewayAttachmentID is the ID of the attachment.
+	TransitGatewayAttachmentID string `json:"transitGatewayAttachmentId,omitempty"`
+
+	// State of the attachment.
+	State string `json:"state,omitempty"`
+
+	// VPCOwnerID is the ID of the AWS account that owns the VPC.
+	VPCOwnerID string `json:"vpcOwnerId,omitempty"`
+}
+
+// A TransitGatewayVPCAttachmentStatus represents the observed state of a
+// TransitGatewayVPCAttachment.
+type TransitGatewayVPCAttachmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TransitGatewayVPCAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransitGatewayVPCAttachment is a managed resource that represents the
+// attachment of a VPC to an AWS Transit Gateway.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TransitGatewayVPCAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewayVPCAttachmentSpec   `json:"spec"`
+	Status TransitGatewayVPCAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayVPCAttachmentList contains a list of
+// TransitGatewayVPCAttachments
+type TransitGatewayVPCAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGatewayVPCAttachment `json:"items"`
+}
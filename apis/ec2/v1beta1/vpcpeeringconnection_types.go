@@ -0,0 +1,248 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Defines the states of VPCPeeringConnection
+const (
+	VPCPeeringConnectionStatusInitiatingRequest = "initiating-request"
+	VPCPeeringConnectionStatusPendingAcceptance = "pending-acceptance"
+	VPCPeeringConnectionStatusProvisioning      = "provisioning"
+	VPCPeeringConnectionStatusActive            = "active"
+	VPCPeeringConnectionStatusDeleting          = "deleting"
+	VPCPeeringConnectionStatusDeleted           = "deleted"
+	VPCPeeringConnectionStatusRejected          = "rejected"
+	VPCPeeringConnectionStatusFailed            = "failed"
+	VPCPeeringConnectionStatusExpired           = "expired"
+)
+
+// VPCPeeringConnectionOptions are the options of one side of a VPC peering
+// connection. They can only be set once the connection is active.
+type VPCPeeringConnectionOptions struct {
+	// AllowDNSResolutionFromRemoteVPC enables the resolution of public DNS
+	// host names of this side of the connection to private IP addresses when
+	// they are queried from instances in the peer VPC.
+	// +optional
+	AllowDNSResolutionFromRemoteVPC *bool `json:"allowDnsResolutionFromRemoteVpc,omitempty"`
+}
+
+// VPCPeeringConnectionParameters define the desired state of the requester
+// side of an AWS VPC peering connection.
+type VPCPeeringConnectionParameters struct {
+	// Region is the region of the requester VPC.
+	// +immutable
+	Region string `json:"region"`
+
+	// VPCID is the ID of the requester VPC.
+	// +immutable
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId.
+	// +immutable
+	// +optional
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId.
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// PeerVPCID is the ID of the accepter VPC.
+	// +immutable
+	// +optional
+	PeerVPCID *string `json:"peerVpcId,omitempty"`
+
+	// PeerVPCIDRef references a VPC to retrieve its vpcId as the accepter
+	// VPC. It can only be used if the accepter VPC is managed from this
+	// cluster.
+	// +immutable
+	// +optional
+	PeerVPCIDRef *xpv1.Reference `json:"peerVpcIdRef,omitempty"`
+
+	// PeerVPCIDSelector selects a reference to a VPC to retrieve its vpcId as
+	// the accepter VPC.
+	// +optional
+	PeerVPCIDSelector *xpv1.Selector `json:"peerVpcIdSelector,omitempty"`
+
+	// PeerOwnerID is the ID of the AWS account that owns the accepter VPC.
+	// Defaults to the account of the requester.
+	// +immutable
+	// +optional
+	PeerOwnerID *string `json:"peerOwnerId,omitempty"`
+
+	// PeerRegion is the region of the accepter VPC. Defaults to the region
+	// of the requester.
+	// +immutable
+	// +optional
+	PeerRegion *string `json:"peerRegion,omitempty"`
+
+	// RequesterOptions are the options of the requester side of the
+	// connection.
+	// +optional
+	RequesterOptions *VPCPeeringConnectionOptions `json:"requesterOptions,omitempty"`
+
+	// Tags of the VPC peering connection.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A VPCPeeringConnectionSpec defines the desired state of a
+// VPCPeeringConnection.
+type VPCPeeringConnectionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VPCPeeringConnectionParameters `json:"forProvider"`
+}
+
+// VPCPeeringConnectionObservation keeps the state for the external resource
+type VPCPeeringConnectionObservation struct {
+	// VPCPeeringConnectionID is the ID of the VPC peering connection.
+	VPCPeeringConnectionID string `json:"vpcPeeringConnectionId,omitempty"`
+
+	// Status of the VPC peering connection.
+	Status string `json:"status,omitempty"`
+
+	// StatusMessage is the message of the status.
+	StatusMessage string `json:"statusMessage,omitempty"`
+
+	// ExpirationTime is the time at which an unaccepted connection expires.
+	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+
+	// RequesterCIDRBlock is the IPv4 CIDR block of the requester VPC.
+	RequesterCIDRBlock string `json:"requesterCidrBlock,omitempty"`
+
+	// AccepterCIDRBlock is the IPv4 CIDR block of the accepter VPC.
+	AccepterCIDRBlock string `json:"accepterCidrBlock,omitempty"`
+}
+
+// A VPCPeeringConnectionStatus represents the observed state of a
+// VPCPeeringConnection.
+type VPCPeeringConnectionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VPCPeeringConnectionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPCPeeringConnection is a managed resource that represents the requester
+// side of an AWS VPC peering connection.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPCPeeringConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPCPeeringConnectionSpec   `json:"spec"`
+	Status VPCPeeringConnectionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPCPeeringConnectionList contains a list of VPCPeeringConnections
+type VPCPeeringConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPCPeeringConnection `json:"items"`
+}
+
+// VPCPeeringConnectionAccepterParameters define the desired state of the
+// accepter side of an AWS VPC peering connection.
+type VPCPeeringConnectionAccepterParameters struct {
+	// Region is the region of the accepter VPC.
+	// +immutable
+	Region string `json:"region"`
+
+	// VPCPeeringConnectionID is the ID of the VPC peering connection to
+	// accept.
+	// +immutable
+	// +optional
+	VPCPeeringConnectionID *string `json:"vpcPeeringConnectionId,omitempty"`
+
+	// VPCPeeringConnectionIDRef references a VPCPeeringConnection to
+	// retrieve its ID.
+	// +immutable
+	// +optional
+	VPCPeeringConnectionIDRef *xpv1.Reference `json:"vpcPeeringConnectionIdRef,omitempty"`
+
+	// VPCPeeringConnectionIDSelector selects a reference to a
+	// VPCPeeringConnection to retrieve its ID.
+	// +optional
+	VPCPeeringConnectionIDSelector *xpv1.Selector `json:"vpcPeeringConnectionIdSelector,omitempty"`
+
+	// AccepterOptions are the options of the accepter side of the
+	// connection.
+	// +optional
+	AccepterOptions *VPCPeeringConnectionOptions `json:"accepterOptions,omitempty"`
+
+	// Tags of the VPC peering connection in the accepter account.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A VPCPeeringConnectionAccepterSpec defines the desired state of a
+// VPCPeeringConnectionAccepter.
+type VPCPeeringConnectionAccepterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VPCPeeringConnectionAccepterParameters `json:"forProvider"`
+}
+
+// A VPCPeeringConnectionAccepterStatus represents the observed state of a
+// VPCPeeringConnectionAccepter.
+type VPCPeeringConnectionAccepterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VPCPeeringConnectionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPCPeeringConnectionAccepter is a managed resource that accepts a VPC
+// peering connection and manages the accepter side of it. It may use a
+// different ProviderConfig than the requester, so that connections across
+// accounts and regions can be accepted. Deleting it deletes the connection.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPCPeeringConnectionAccepter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPCPeeringConnectionAccepterSpec   `json:"spec"`
+	Status VPCPeeringConnectionAccepterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPCPeeringConnectionAccepterList contains a list of
+// VPCPeeringConnectionAccepters
+type VPCPeeringConnectionAccepterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPCPeeringConnectionAccepter `json:"items"`
+}
@@ -753,11 +753,31 @@ func (in *Route) DeepCopyInto(out *Route) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.TransitGatewayIDRef != nil {
+		in, out := &in.TransitGatewayIDRef, &out.TransitGatewayIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.TransitGatewayIDSelector != nil {
+		in, out := &in.TransitGatewayIDSelector, &out.TransitGatewayIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TransitGatewayID != nil {
 		in, out := &in.TransitGatewayID, &out.TransitGatewayID
 		*out = new(string)
 		**out = **in
 	}
+	if in.VpcPeeringConnectionIDRef != nil {
+		in, out := &in.VpcPeeringConnectionIDRef, &out.VpcPeeringConnectionIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.VpcPeeringConnectionIDSelector != nil {
+		in, out := &in.VpcPeeringConnectionIDSelector, &out.VpcPeeringConnectionIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.VpcPeeringConnectionID != nil {
 		in, out := &in.VpcPeeringConnectionID, &out.VpcPeeringConnectionID
 		*out = new(string)
@@ -1308,62 +1328,136 @@ func (in *Tag) DeepCopy() *Tag {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *UserIDGroupPair) DeepCopyInto(out *UserIDGroupPair) {
+func (in *TransitGateway) DeepCopyInto(out *TransitGateway) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGateway.
+func (in *TransitGateway) DeepCopy() *TransitGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayList) DeepCopyInto(out *TransitGatewayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransitGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayList.
+func (in *TransitGatewayList) DeepCopy() *TransitGatewayList {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayObservation) DeepCopyInto(out *TransitGatewayObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayObservation.
+func (in *TransitGatewayObservation) DeepCopy() *TransitGatewayObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayParameters) DeepCopyInto(out *TransitGatewayParameters) {
 	*out = *in
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.GroupID != nil {
-		in, out := &in.GroupID, &out.GroupID
-		*out = new(string)
+	if in.AmazonSideASN != nil {
+		in, out := &in.AmazonSideASN, &out.AmazonSideASN
+		*out = new(int64)
 		**out = **in
 	}
-	if in.GroupName != nil {
-		in, out := &in.GroupName, &out.GroupName
+	if in.AutoAcceptSharedAttachments != nil {
+		in, out := &in.AutoAcceptSharedAttachments, &out.AutoAcceptSharedAttachments
 		*out = new(string)
 		**out = **in
 	}
-	if in.UserID != nil {
-		in, out := &in.UserID, &out.UserID
+	if in.DefaultRouteTableAssociation != nil {
+		in, out := &in.DefaultRouteTableAssociation, &out.DefaultRouteTableAssociation
 		*out = new(string)
 		**out = **in
 	}
-	if in.VPCID != nil {
-		in, out := &in.VPCID, &out.VPCID
+	if in.DefaultRouteTablePropagation != nil {
+		in, out := &in.DefaultRouteTablePropagation, &out.DefaultRouteTablePropagation
 		*out = new(string)
 		**out = **in
 	}
-	if in.VPCIDRef != nil {
-		in, out := &in.VPCIDRef, &out.VPCIDRef
-		*out = new(v1.Reference)
+	if in.DNSSupport != nil {
+		in, out := &in.DNSSupport, &out.DNSSupport
+		*out = new(string)
 		**out = **in
 	}
-	if in.VPCIDSelector != nil {
-		in, out := &in.VPCIDSelector, &out.VPCIDSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.VPCPeeringConnectionID != nil {
-		in, out := &in.VPCPeeringConnectionID, &out.VPCPeeringConnectionID
+	if in.VPNECMPSupport != nil {
+		in, out := &in.VPNECMPSupport, &out.VPNECMPSupport
 		*out = new(string)
 		**out = **in
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserIDGroupPair.
-func (in *UserIDGroupPair) DeepCopy() *UserIDGroupPair {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayParameters.
+func (in *TransitGatewayParameters) DeepCopy() *TransitGatewayParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(UserIDGroupPair)
+	out := new(TransitGatewayParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPC) DeepCopyInto(out *VPC) {
+func (in *TransitGatewayRoute) DeepCopyInto(out *TransitGatewayRoute) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1371,18 +1465,18 @@ func (in *VPC) DeepCopyInto(out *VPC) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPC.
-func (in *VPC) DeepCopy() *VPC {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRoute.
+func (in *TransitGatewayRoute) DeepCopy() *TransitGatewayRoute {
 	if in == nil {
 		return nil
 	}
-	out := new(VPC)
+	out := new(TransitGatewayRoute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VPC) DeepCopyObject() runtime.Object {
+func (in *TransitGatewayRoute) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1390,78 +1484,157 @@ func (in *VPC) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCCIDRBlockAssociation) DeepCopyInto(out *VPCCIDRBlockAssociation) {
+func (in *TransitGatewayRouteList) DeepCopyInto(out *TransitGatewayRouteList) {
 	*out = *in
-	out.CIDRBlockState = in.CIDRBlockState
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransitGatewayRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockAssociation.
-func (in *VPCCIDRBlockAssociation) DeepCopy() *VPCCIDRBlockAssociation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteList.
+func (in *TransitGatewayRouteList) DeepCopy() *TransitGatewayRouteList {
 	if in == nil {
 		return nil
 	}
-	out := new(VPCCIDRBlockAssociation)
+	out := new(TransitGatewayRouteList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayRouteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCCIDRBlockState) DeepCopyInto(out *VPCCIDRBlockState) {
+func (in *TransitGatewayRouteObservation) DeepCopyInto(out *TransitGatewayRouteObservation) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockState.
-func (in *VPCCIDRBlockState) DeepCopy() *VPCCIDRBlockState {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteObservation.
+func (in *TransitGatewayRouteObservation) DeepCopy() *TransitGatewayRouteObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(VPCCIDRBlockState)
+	out := new(TransitGatewayRouteObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCIPv6CidrBlockAssociation) DeepCopyInto(out *VPCIPv6CidrBlockAssociation) {
+func (in *TransitGatewayRouteParameters) DeepCopyInto(out *TransitGatewayRouteParameters) {
 	*out = *in
-	out.IPv6CIDRBlockState = in.IPv6CIDRBlockState
+	if in.Blackhole != nil {
+		in, out := &in.Blackhole, &out.Blackhole
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TransitGatewayRouteTableID != nil {
+		in, out := &in.TransitGatewayRouteTableID, &out.TransitGatewayRouteTableID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayRouteTableIDRef != nil {
+		in, out := &in.TransitGatewayRouteTableIDRef, &out.TransitGatewayRouteTableIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.TransitGatewayRouteTableIDSelector != nil {
+		in, out := &in.TransitGatewayRouteTableIDSelector, &out.TransitGatewayRouteTableIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransitGatewayAttachmentID != nil {
+		in, out := &in.TransitGatewayAttachmentID, &out.TransitGatewayAttachmentID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayAttachmentIDRef != nil {
+		in, out := &in.TransitGatewayAttachmentIDRef, &out.TransitGatewayAttachmentIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.TransitGatewayAttachmentIDSelector != nil {
+		in, out := &in.TransitGatewayAttachmentIDSelector, &out.TransitGatewayAttachmentIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCIPv6CidrBlockAssociation.
-func (in *VPCIPv6CidrBlockAssociation) DeepCopy() *VPCIPv6CidrBlockAssociation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteParameters.
+func (in *TransitGatewayRouteParameters) DeepCopy() *TransitGatewayRouteParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(VPCIPv6CidrBlockAssociation)
+	out := new(TransitGatewayRouteParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCList) DeepCopyInto(out *VPCList) {
+func (in *TransitGatewayRouteSpec) DeepCopyInto(out *TransitGatewayRouteSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]VPC, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteSpec.
+func (in *TransitGatewayRouteSpec) DeepCopy() *TransitGatewayRouteSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(TransitGatewayRouteSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCList.
-func (in *VPCList) DeepCopy() *VPCList {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteStatus) DeepCopyInto(out *TransitGatewayRouteStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteStatus.
+func (in *TransitGatewayRouteStatus) DeepCopy() *TransitGatewayRouteStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VPCList)
+	out := new(TransitGatewayRouteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTable) DeepCopyInto(out *TransitGatewayRouteTable) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTable.
+func (in *TransitGatewayRouteTable) DeepCopy() *TransitGatewayRouteTable {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTable)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VPCList) DeepCopyObject() runtime.Object {
+func (in *TransitGatewayRouteTable) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1469,47 +1642,832 @@ func (in *VPCList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCObservation) DeepCopyInto(out *VPCObservation) {
+func (in *TransitGatewayRouteTableAssociation) DeepCopyInto(out *TransitGatewayRouteTableAssociation) {
 	*out = *in
-	if in.CIDRBlockAssociationSet != nil {
-		in, out := &in.CIDRBlockAssociationSet, &out.CIDRBlockAssociationSet
-		*out = make([]VPCCIDRBlockAssociation, len(*in))
-		copy(*out, *in)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAssociation.
+func (in *TransitGatewayRouteTableAssociation) DeepCopy() *TransitGatewayRouteTableAssociation {
+	if in == nil {
+		return nil
 	}
-	if in.IPv6CIDRBlockAssociationSet != nil {
-		in, out := &in.IPv6CIDRBlockAssociationSet, &out.IPv6CIDRBlockAssociationSet
-		*out = make([]VPCIPv6CidrBlockAssociation, len(*in))
-		copy(*out, *in)
+	out := new(TransitGatewayRouteTableAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayRouteTableAssociation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
+	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCObservation.
-func (in *VPCObservation) DeepCopy() *VPCObservation {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTableAssociationList) DeepCopyInto(out *TransitGatewayRouteTableAssociationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransitGatewayRouteTableAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAssociationList.
+func (in *TransitGatewayRouteTableAssociationList) DeepCopy() *TransitGatewayRouteTableAssociationList {
 	if in == nil {
 		return nil
 	}
-	out := new(VPCObservation)
+	out := new(TransitGatewayRouteTableAssociationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayRouteTableAssociationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCParameters) DeepCopyInto(out *VPCParameters) {
+func (in *TransitGatewayRouteTableAssociationParameters) DeepCopyInto(out *TransitGatewayRouteTableAssociationParameters) {
 	*out = *in
-	if in.Region != nil {
-		in, out := &in.Region, &out.Region
+	if in.TransitGatewayRouteTableID != nil {
+		in, out := &in.TransitGatewayRouteTableID, &out.TransitGatewayRouteTableID
 		*out = new(string)
 		**out = **in
 	}
-	if in.EnableDNSSupport != nil {
-		in, out := &in.EnableDNSSupport, &out.EnableDNSSupport
-		*out = new(bool)
+	if in.TransitGatewayRouteTableIDRef != nil {
+		in, out := &in.TransitGatewayRouteTableIDRef, &out.TransitGatewayRouteTableIDRef
+		*out = new(v1.Reference)
 		**out = **in
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]Tag, len(*in))
-		copy(*out, *in)
+	if in.TransitGatewayRouteTableIDSelector != nil {
+		in, out := &in.TransitGatewayRouteTableIDSelector, &out.TransitGatewayRouteTableIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransitGatewayAttachmentID != nil {
+		in, out := &in.TransitGatewayAttachmentID, &out.TransitGatewayAttachmentID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayAttachmentIDRef != nil {
+		in, out := &in.TransitGatewayAttachmentIDRef, &out.TransitGatewayAttachmentIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.TransitGatewayAttachmentIDSelector != nil {
+		in, out := &in.TransitGatewayAttachmentIDSelector, &out.TransitGatewayAttachmentIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAssociationParameters.
+func (in *TransitGatewayRouteTableAssociationParameters) DeepCopy() *TransitGatewayRouteTableAssociationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTableAssociationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTableAssociationSpec) DeepCopyInto(out *TransitGatewayRouteTableAssociationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAssociationSpec.
+func (in *TransitGatewayRouteTableAssociationSpec) DeepCopy() *TransitGatewayRouteTableAssociationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTableAssociationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTableAssociationStatus) DeepCopyInto(out *TransitGatewayRouteTableAssociationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAssociationStatus.
+func (in *TransitGatewayRouteTableAssociationStatus) DeepCopy() *TransitGatewayRouteTableAssociationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTableAssociationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTableAttachmentObservation) DeepCopyInto(out *TransitGatewayRouteTableAttachmentObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAttachmentObservation.
+func (in *TransitGatewayRouteTableAttachmentObservation) DeepCopy() *TransitGatewayRouteTableAttachmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTableAttachmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTableList) DeepCopyInto(out *TransitGatewayRouteTableList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransitGatewayRouteTable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableList.
+func (in *TransitGatewayRouteTableList) DeepCopy() *TransitGatewayRouteTableList {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTableList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayRouteTableList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTableObservation) DeepCopyInto(out *TransitGatewayRouteTableObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableObservation.
+func (in *TransitGatewayRouteTableObservation) DeepCopy() *TransitGatewayRouteTableObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTableObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTableParameters) DeepCopyInto(out *TransitGatewayRouteTableParameters) {
+	*out = *in
+	if in.TransitGatewayID != nil {
+		in, out := &in.TransitGatewayID, &out.TransitGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayIDRef != nil {
+		in, out := &in.TransitGatewayIDRef, &out.TransitGatewayIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.TransitGatewayIDSelector != nil {
+		in, out := &in.TransitGatewayIDSelector, &out.TransitGatewayIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableParameters.
+func (in *TransitGatewayRouteTableParameters) DeepCopy() *TransitGatewayRouteTableParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTableParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTablePropagation) DeepCopyInto(out *TransitGatewayRouteTablePropagation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTablePropagation.
+func (in *TransitGatewayRouteTablePropagation) DeepCopy() *TransitGatewayRouteTablePropagation {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTablePropagation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayRouteTablePropagation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTablePropagationList) DeepCopyInto(out *TransitGatewayRouteTablePropagationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransitGatewayRouteTablePropagation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTablePropagationList.
+func (in *TransitGatewayRouteTablePropagationList) DeepCopy() *TransitGatewayRouteTablePropagationList {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTablePropagationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayRouteTablePropagationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTablePropagationParameters) DeepCopyInto(out *TransitGatewayRouteTablePropagationParameters) {
+	*out = *in
+	if in.TransitGatewayRouteTableID != nil {
+		in, out := &in.TransitGatewayRouteTableID, &out.TransitGatewayRouteTableID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayRouteTableIDRef != nil {
+		in, out := &in.TransitGatewayRouteTableIDRef, &out.TransitGatewayRouteTableIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.TransitGatewayRouteTableIDSelector != nil {
+		in, out := &in.TransitGatewayRouteTableIDSelector, &out.TransitGatewayRouteTableIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransitGatewayAttachmentID != nil {
+		in, out := &in.TransitGatewayAttachmentID, &out.TransitGatewayAttachmentID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayAttachmentIDRef != nil {
+		in, out := &in.TransitGatewayAttachmentIDRef, &out.TransitGatewayAttachmentIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.TransitGatewayAttachmentIDSelector != nil {
+		in, out := &in.TransitGatewayAttachmentIDSelector, &out.TransitGatewayAttachmentIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTablePropagationParameters.
+func (in *TransitGatewayRouteTablePropagationParameters) DeepCopy() *TransitGatewayRouteTablePropagationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTablePropagationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTablePropagationSpec) DeepCopyInto(out *TransitGatewayRouteTablePropagationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTablePropagationSpec.
+func (in *TransitGatewayRouteTablePropagationSpec) DeepCopy() *TransitGatewayRouteTablePropagationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTablePropagationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTablePropagationStatus) DeepCopyInto(out *TransitGatewayRouteTablePropagationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTablePropagationStatus.
+func (in *TransitGatewayRouteTablePropagationStatus) DeepCopy() *TransitGatewayRouteTablePropagationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTablePropagationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTableSpec) DeepCopyInto(out *TransitGatewayRouteTableSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableSpec.
+func (in *TransitGatewayRouteTableSpec) DeepCopy() *TransitGatewayRouteTableSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTableSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTableStatus) DeepCopyInto(out *TransitGatewayRouteTableStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableStatus.
+func (in *TransitGatewayRouteTableStatus) DeepCopy() *TransitGatewayRouteTableStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTableStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewaySpec) DeepCopyInto(out *TransitGatewaySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewaySpec.
+func (in *TransitGatewaySpec) DeepCopy() *TransitGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayStatus) DeepCopyInto(out *TransitGatewayStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayStatus.
+func (in *TransitGatewayStatus) DeepCopy() *TransitGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayVPCAttachment) DeepCopyInto(out *TransitGatewayVPCAttachment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachment.
+func (in *TransitGatewayVPCAttachment) DeepCopy() *TransitGatewayVPCAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayVPCAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayVPCAttachment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayVPCAttachmentList) DeepCopyInto(out *TransitGatewayVPCAttachmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransitGatewayVPCAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachmentList.
+func (in *TransitGatewayVPCAttachmentList) DeepCopy() *TransitGatewayVPCAttachmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayVPCAttachmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayVPCAttachmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayVPCAttachmentObservation) DeepCopyInto(out *TransitGatewayVPCAttachmentObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachmentObservation.
+func (in *TransitGatewayVPCAttachmentObservation) DeepCopy() *TransitGatewayVPCAttachmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayVPCAttachmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayVPCAttachmentParameters) DeepCopyInto(out *TransitGatewayVPCAttachmentParameters) {
+	*out = *in
+	if in.TransitGatewayID != nil {
+		in, out := &in.TransitGatewayID, &out.TransitGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayIDRef != nil {
+		in, out := &in.TransitGatewayIDRef, &out.TransitGatewayIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.TransitGatewayIDSelector != nil {
+		in, out := &in.TransitGatewayIDSelector, &out.TransitGatewayIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.VPCIDSelector != nil {
+		in, out := &in.VPCIDSelector, &out.VPCIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDRefs != nil {
+		in, out := &in.SubnetIDRefs, &out.SubnetIDRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSSupport != nil {
+		in, out := &in.DNSSupport, &out.DNSSupport
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6Support != nil {
+		in, out := &in.IPv6Support, &out.IPv6Support
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachmentParameters.
+func (in *TransitGatewayVPCAttachmentParameters) DeepCopy() *TransitGatewayVPCAttachmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayVPCAttachmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayVPCAttachmentSpec) DeepCopyInto(out *TransitGatewayVPCAttachmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachmentSpec.
+func (in *TransitGatewayVPCAttachmentSpec) DeepCopy() *TransitGatewayVPCAttachmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayVPCAttachmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayVPCAttachmentStatus) DeepCopyInto(out *TransitGatewayVPCAttachmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachmentStatus.
+func (in *TransitGatewayVPCAttachmentStatus) DeepCopy() *TransitGatewayVPCAttachmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayVPCAttachmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserIDGroupPair) DeepCopyInto(out *UserIDGroupPair) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupName != nil {
+		in, out := &in.GroupName, &out.GroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.VPCIDSelector != nil {
+		in, out := &in.VPCIDSelector, &out.VPCIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCPeeringConnectionID != nil {
+		in, out := &in.VPCPeeringConnectionID, &out.VPCPeeringConnectionID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserIDGroupPair.
+func (in *UserIDGroupPair) DeepCopy() *UserIDGroupPair {
+	if in == nil {
+		return nil
+	}
+	out := new(UserIDGroupPair)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPC) DeepCopyInto(out *VPC) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPC.
+func (in *VPC) DeepCopy() *VPC {
+	if in == nil {
+		return nil
+	}
+	out := new(VPC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPC) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCCIDRBlockAssociation) DeepCopyInto(out *VPCCIDRBlockAssociation) {
+	*out = *in
+	out.CIDRBlockState = in.CIDRBlockState
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockAssociation.
+func (in *VPCCIDRBlockAssociation) DeepCopy() *VPCCIDRBlockAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCCIDRBlockAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCCIDRBlockState) DeepCopyInto(out *VPCCIDRBlockState) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockState.
+func (in *VPCCIDRBlockState) DeepCopy() *VPCCIDRBlockState {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCCIDRBlockState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCIPv6CidrBlockAssociation) DeepCopyInto(out *VPCIPv6CidrBlockAssociation) {
+	*out = *in
+	out.IPv6CIDRBlockState = in.IPv6CIDRBlockState
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCIPv6CidrBlockAssociation.
+func (in *VPCIPv6CidrBlockAssociation) DeepCopy() *VPCIPv6CidrBlockAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCIPv6CidrBlockAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCList) DeepCopyInto(out *VPCList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VPC, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCList.
+func (in *VPCList) DeepCopy() *VPCList {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCObservation) DeepCopyInto(out *VPCObservation) {
+	*out = *in
+	if in.CIDRBlockAssociationSet != nil {
+		in, out := &in.CIDRBlockAssociationSet, &out.CIDRBlockAssociationSet
+		*out = make([]VPCCIDRBlockAssociation, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPv6CIDRBlockAssociationSet != nil {
+		in, out := &in.IPv6CIDRBlockAssociationSet, &out.IPv6CIDRBlockAssociationSet
+		*out = make([]VPCIPv6CidrBlockAssociation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCObservation.
+func (in *VPCObservation) DeepCopy() *VPCObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCParameters) DeepCopyInto(out *VPCParameters) {
+	*out = *in
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnableDNSSupport != nil {
+		in, out := &in.EnableDNSSupport, &out.EnableDNSSupport
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
 	}
 	if in.EnableDNSHostNames != nil {
 		in, out := &in.EnableDNSHostNames, &out.EnableDNSHostNames
@@ -1533,6 +2491,336 @@ func (in *VPCParameters) DeepCopy() *VPCParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnection) DeepCopyInto(out *VPCPeeringConnection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnection.
+func (in *VPCPeeringConnection) DeepCopy() *VPCPeeringConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCPeeringConnection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionAccepter) DeepCopyInto(out *VPCPeeringConnectionAccepter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionAccepter.
+func (in *VPCPeeringConnectionAccepter) DeepCopy() *VPCPeeringConnectionAccepter {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionAccepter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCPeeringConnectionAccepter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionAccepterList) DeepCopyInto(out *VPCPeeringConnectionAccepterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VPCPeeringConnectionAccepter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionAccepterList.
+func (in *VPCPeeringConnectionAccepterList) DeepCopy() *VPCPeeringConnectionAccepterList {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionAccepterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCPeeringConnectionAccepterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionAccepterParameters) DeepCopyInto(out *VPCPeeringConnectionAccepterParameters) {
+	*out = *in
+	if in.VPCPeeringConnectionID != nil {
+		in, out := &in.VPCPeeringConnectionID, &out.VPCPeeringConnectionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCPeeringConnectionIDRef != nil {
+		in, out := &in.VPCPeeringConnectionIDRef, &out.VPCPeeringConnectionIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.VPCPeeringConnectionIDSelector != nil {
+		in, out := &in.VPCPeeringConnectionIDSelector, &out.VPCPeeringConnectionIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccepterOptions != nil {
+		in, out := &in.AccepterOptions, &out.AccepterOptions
+		*out = new(VPCPeeringConnectionOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionAccepterParameters.
+func (in *VPCPeeringConnectionAccepterParameters) DeepCopy() *VPCPeeringConnectionAccepterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionAccepterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionAccepterSpec) DeepCopyInto(out *VPCPeeringConnectionAccepterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionAccepterSpec.
+func (in *VPCPeeringConnectionAccepterSpec) DeepCopy() *VPCPeeringConnectionAccepterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionAccepterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionAccepterStatus) DeepCopyInto(out *VPCPeeringConnectionAccepterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionAccepterStatus.
+func (in *VPCPeeringConnectionAccepterStatus) DeepCopy() *VPCPeeringConnectionAccepterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionAccepterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionList) DeepCopyInto(out *VPCPeeringConnectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VPCPeeringConnection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionList.
+func (in *VPCPeeringConnectionList) DeepCopy() *VPCPeeringConnectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCPeeringConnectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionObservation) DeepCopyInto(out *VPCPeeringConnectionObservation) {
+	*out = *in
+	if in.ExpirationTime != nil {
+		in, out := &in.ExpirationTime, &out.ExpirationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionObservation.
+func (in *VPCPeeringConnectionObservation) DeepCopy() *VPCPeeringConnectionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionOptions) DeepCopyInto(out *VPCPeeringConnectionOptions) {
+	*out = *in
+	if in.AllowDNSResolutionFromRemoteVPC != nil {
+		in, out := &in.AllowDNSResolutionFromRemoteVPC, &out.AllowDNSResolutionFromRemoteVPC
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionOptions.
+func (in *VPCPeeringConnectionOptions) DeepCopy() *VPCPeeringConnectionOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionParameters) DeepCopyInto(out *VPCPeeringConnectionParameters) {
+	*out = *in
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.VPCIDSelector != nil {
+		in, out := &in.VPCIDSelector, &out.VPCIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PeerVPCID != nil {
+		in, out := &in.PeerVPCID, &out.PeerVPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PeerVPCIDRef != nil {
+		in, out := &in.PeerVPCIDRef, &out.PeerVPCIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.PeerVPCIDSelector != nil {
+		in, out := &in.PeerVPCIDSelector, &out.PeerVPCIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PeerOwnerID != nil {
+		in, out := &in.PeerOwnerID, &out.PeerOwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PeerRegion != nil {
+		in, out := &in.PeerRegion, &out.PeerRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.RequesterOptions != nil {
+		in, out := &in.RequesterOptions, &out.RequesterOptions
+		*out = new(VPCPeeringConnectionOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionParameters.
+func (in *VPCPeeringConnectionParameters) DeepCopy() *VPCPeeringConnectionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionSpec) DeepCopyInto(out *VPCPeeringConnectionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionSpec.
+func (in *VPCPeeringConnectionSpec) DeepCopy() *VPCPeeringConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionStatus) DeepCopyInto(out *VPCPeeringConnectionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionStatus.
+func (in *VPCPeeringConnectionStatus) DeepCopy() *VPCPeeringConnectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCSpec) DeepCopyInto(out *VPCSpec) {
 	*out = *in
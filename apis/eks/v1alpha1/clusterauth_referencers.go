@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	eksv1beta1 "github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	iamv1alpha1 "github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+)
+
+// ResolveReferences of this ClusterAuth
+func (mg *ClusterAuth) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.clusterName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ClusterName,
+		Reference:    mg.Spec.ForProvider.ClusterNameRef,
+		Selector:     mg.Spec.ForProvider.ClusterNameSelector,
+		To:           reference.To{Managed: &eksv1beta1.Cluster{}, List: &eksv1beta1.ClusterList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.clusterName")
+	}
+	mg.Spec.ForProvider.ClusterName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ClusterNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.mapRoles[].roleArn
+	for i := range mg.Spec.ForProvider.MapRoles {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: mg.Spec.ForProvider.MapRoles[i].RoleARN,
+			Reference:    mg.Spec.ForProvider.MapRoles[i].RoleARNRef,
+			Selector:     mg.Spec.ForProvider.MapRoles[i].RoleARNSelector,
+			To:           reference.To{Managed: &iamv1beta1.IAMRole{}, List: &iamv1beta1.IAMRoleList{}},
+			Extract:      iamv1beta1.IAMRoleARN(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.mapRoles[%d].roleArn", i)
+		}
+		mg.Spec.ForProvider.MapRoles[i].RoleARN = rsp.ResolvedValue
+		mg.Spec.ForProvider.MapRoles[i].RoleARNRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.mapUsers[].userArn
+	for i := range mg.Spec.ForProvider.MapUsers {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: mg.Spec.ForProvider.MapUsers[i].UserARN,
+			Reference:    mg.Spec.ForProvider.MapUsers[i].UserARNRef,
+			Selector:     mg.Spec.ForProvider.MapUsers[i].UserARNSelector,
+			To:           reference.To{Managed: &iamv1alpha1.IAMUser{}, List: &iamv1alpha1.IAMUserList{}},
+			Extract:      iamv1alpha1.IAMUserARN(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.mapUsers[%d].userArn", i)
+		}
+		mg.Spec.ForProvider.MapUsers[i].UserARN = rsp.ResolvedValue
+		mg.Spec.ForProvider.MapUsers[i].UserARNRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
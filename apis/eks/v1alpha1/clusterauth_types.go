@@ -0,0 +1,177 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// MapRole maps an IAM role to a Kubernetes user and groups.
+type MapRole struct {
+	// The ARN of the IAM role to map.
+	// +optional
+	RoleARN string `json:"roleArn,omitempty"`
+
+	// RoleARNRef is a reference to an IAMRole used to set the RoleARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects references to IAMRole used to set the RoleARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleArnSelector,omitempty"`
+
+	// The user name within Kubernetes to map to the IAM role. Node roles have
+	// to use system:node:{{EC2PrivateDNSName}}.
+	Username string `json:"username"`
+
+	// A list of groups within Kubernetes to which the role is mapped. Node
+	// roles have to be in system:bootstrappers and system:nodes.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// MapUser maps an IAM user to a Kubernetes user and groups.
+type MapUser struct {
+	// The ARN of the IAM user to map.
+	// +optional
+	UserARN string `json:"userArn,omitempty"`
+
+	// UserARNRef is a reference to an IAMUser used to set the UserARN.
+	// +optional
+	UserARNRef *xpv1.Reference `json:"userArnRef,omitempty"`
+
+	// UserARNSelector selects references to IAMUser used to set the UserARN.
+	// +optional
+	UserARNSelector *xpv1.Selector `json:"userArnSelector,omitempty"`
+
+	// The user name within Kubernetes to map to the IAM user.
+	Username string `json:"username"`
+
+	// A list of groups within Kubernetes to which the user is mapped.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// ClusterAuthParameters define the desired state of the IAM mappings of an
+// AWS Elastic Kubernetes Service Cluster.
+type ClusterAuthParameters struct {
+	// Region is the region of the cluster.
+	// +immutable
+	Region string `json:"region"`
+
+	// The name of the Amazon EKS cluster whose aws-auth ConfigMap the mappings
+	// are written to.
+	//
+	// ClusterName is a required field
+	// +immutable
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// ClusterNameRef is a reference to a Cluster used to set
+	// the ClusterName.
+	// +immutable
+	// +optional
+	ClusterNameRef *xpv1.Reference `json:"clusterNameRef,omitempty"`
+
+	// ClusterNameSelector selects references to a Cluster used
+	// to set the ClusterName.
+	// +optional
+	ClusterNameSelector *xpv1.Selector `json:"clusterNameSelector,omitempty"`
+
+	// MapRoles are the IAM roles that are granted access to the cluster. Only
+	// the entries of these roles are managed; the other entries of the mapRoles
+	// key of the aws-auth ConfigMap are left untouched. Entries that existed
+	// before are updated, and restored to their original value rather than
+	// removed once they are no longer desired or the ClusterAuth is deleted.
+	// +optional
+	MapRoles []MapRole `json:"mapRoles,omitempty"`
+
+	// MapUsers are the IAM users that are granted access to the cluster. Only
+	// the entries of these users are managed; the other entries of the
+	// mapUsers key of the aws-auth ConfigMap are left untouched. Entries that
+	// existed before are updated, and restored to their original value rather
+	// than removed once they are no longer desired or the ClusterAuth is
+	// deleted.
+	// +optional
+	MapUsers []MapUser `json:"mapUsers,omitempty"`
+}
+
+// A ClusterAuthSpec defines the desired state of an EKS ClusterAuth.
+type ClusterAuthSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClusterAuthParameters `json:"forProvider"`
+}
+
+// ClusterAuthObservation is the observed state of a ClusterAuth.
+type ClusterAuthObservation struct {
+	// MapRoles are the ARNs of the IAM roles whose entries were added to the
+	// aws-auth ConfigMap by this ClusterAuth. These entries are removed once
+	// they are no longer desired or the ClusterAuth is deleted.
+	MapRoles []string `json:"mapRoles,omitempty"`
+
+	// MapUsers are the ARNs of the IAM users whose entries were added to the
+	// aws-auth ConfigMap by this ClusterAuth. These entries are removed once
+	// they are no longer desired or the ClusterAuth is deleted.
+	MapUsers []string `json:"mapUsers,omitempty"`
+
+	// ReplacedMapRoles are the original entries of the IAM roles that existed
+	// in the aws-auth ConfigMap before this ClusterAuth updated them. These
+	// entries are restored once they are no longer desired or the ClusterAuth
+	// is deleted.
+	ReplacedMapRoles []MapRole `json:"replacedMapRoles,omitempty"`
+
+	// ReplacedMapUsers are the original entries of the IAM users that existed
+	// in the aws-auth ConfigMap before this ClusterAuth updated them. These
+	// entries are restored once they are no longer desired or the ClusterAuth
+	// is deleted.
+	ReplacedMapUsers []MapUser `json:"replacedMapUsers,omitempty"`
+}
+
+// A ClusterAuthStatus represents the observed state of an EKS ClusterAuth.
+type ClusterAuthStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ClusterAuthObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ClusterAuth is a managed resource that represents entries of the aws-auth
+// ConfigMap of an AWS Elastic Kubernetes Service Cluster, which map IAM roles
+// and users to Kubernetes users and groups.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.clusterName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ClusterAuth struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterAuthSpec   `json:"spec"`
+	Status ClusterAuthStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterAuthList contains a list of ClusterAuth items
+type ClusterAuthList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterAuth `json:"items"`
+}
@@ -50,6 +50,13 @@ type NodeGroupParameters struct {
 	// +optional
 	AMIType *string `json:"amiType,omitempty"`
 
+	// The capacity type for your node group. ON_DEMAND is used if no capacity
+	// type is specified.
+	// +immutable
+	// +optional
+	// +kubebuilder:validation:Enum=ON_DEMAND;SPOT
+	CapacityType *string `json:"capacityType,omitempty"`
+
 	// The name of the cluster to create the node group in.
 	//
 	// ClusterName is a required field
@@ -73,6 +80,13 @@ type NodeGroupParameters struct {
 	// +optional
 	DiskSize *int64 `json:"diskSize,omitempty"`
 
+	// Force the version update if the existing node group's pods are unable to
+	// be drained due to a pod disruption budget issue. If an update fails because
+	// pods could not be drained, you can force the update after it fails to
+	// terminate the old node whether or not any pods are running on the node.
+	// +optional
+	ForceUpdateVersion *bool `json:"forceUpdateVersion,omitempty"`
+
 	// The instance type to use for your node group. Currently, you can specify
 	// a single instance type for a node group. The default value for this parameter
 	// is t3.medium. If you choose a GPU instance type, be sure to specify the AL2_x86_64_GPU
@@ -86,6 +100,14 @@ type NodeGroupParameters struct {
 	// +optional
 	Labels map[string]string `json:"labels,omitempty"`
 
+	// An object representing a node group's launch template specification. If
+	// specified, then do not specify instanceTypes, diskSize, or remoteAccess
+	// and make sure that the launch template meets the requirements in
+	// launchTemplateSpecification. Changing the version of the launch template
+	// updates the node group.
+	// +optional
+	LaunchTemplate *LaunchTemplateSpecification `json:"launchTemplate,omitempty"`
+
 	// The Amazon Resource Name (ARN) of the IAM role to associate with your node
 	// group. The Amazon EKS worker node kubelet daemon makes calls to AWS APIs
 	// on your behalf. Worker nodes receive permissions for these API calls through
@@ -113,8 +135,11 @@ type NodeGroupParameters struct {
 	// By default, the latest available AMI version for the node group's current
 	// Kubernetes version is used. For more information, see Amazon EKS-Optimized
 	// Linux AMI Versions (https://docs.aws.amazon.com/eks/latest/userguide/eks-linux-ami-versions.html)
-	// in the Amazon EKS User Guide.
-	// +immutable
+	// in the Amazon EKS User Guide. Changing the release version updates the
+	// node group to the given AMI version. A release version of a Kubernetes
+	// version other than the node group's is ignored; earlier versions of this
+	// provider set it to the initial AMI version, so remove it to let the AMI
+	// version follow Kubernetes version upgrades.
 	// +optional
 	ReleaseVersion *string `json:"releaseVersion,omitempty"`
 
@@ -153,6 +178,14 @@ type NodeGroupParameters struct {
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
 
+	// The Kubernetes taints to be applied to the nodes in the node group.
+	// +optional
+	Taints []Taint `json:"taints,omitempty"`
+
+	// The node group update configuration.
+	// +optional
+	UpdateConfig *NodeGroupUpdateConfig `json:"updateConfig,omitempty"`
+
 	// The Kubernetes version to use for your managed nodes. By default, the Kubernetes
 	// version of the cluster is used, and this is the only accepted specified value.
 	// +optional
@@ -207,6 +240,55 @@ type NodeGroupScalingConfig struct {
 	MinSize *int64 `json:"minSize,omitempty"`
 }
 
+// LaunchTemplateSpecification is the launch template used by a node group.
+// Either the ID or the name of the launch template must be specified.
+type LaunchTemplateSpecification struct {
+	// The ID of the launch template.
+	// +immutable
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// The name of the launch template.
+	// +immutable
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// The version of the launch template to use. If no version is specified,
+	// then the template's default version is used.
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
+
+// Taint is a Kubernetes taint applied to the nodes of a node group.
+type Taint struct {
+	// The effect of the taint.
+	// +kubebuilder:validation:Enum=NO_SCHEDULE;NO_EXECUTE;PREFER_NO_SCHEDULE
+	Effect string `json:"effect"`
+
+	// The key of the taint.
+	Key string `json:"key"`
+
+	// The value of the taint.
+	// +optional
+	Value *string `json:"value,omitempty"`
+}
+
+// NodeGroupUpdateConfig is the update configuration of a node group. Only one
+// of the fields can be specified.
+type NodeGroupUpdateConfig struct {
+	// The maximum number of nodes unavailable at once during a version update.
+	// Nodes will be updated in parallel. This value or maxUnavailablePercentage
+	// is required to have a value. The maximum number is 100.
+	// +optional
+	MaxUnavailable *int64 `json:"maxUnavailable,omitempty"`
+
+	// The maximum percentage of nodes unavailable during a version update. This
+	// percentage of nodes will be updated in parallel, up to 100 nodes at once.
+	// This value or maxUnavailable is required to have a value.
+	// +optional
+	MaxUnavailablePercentage *int64 `json:"maxUnavailablePercentage,omitempty"`
+}
+
 // NodeGroupScalingConfigStatus is the observed scaling configuration for a node group.
 type NodeGroupScalingConfigStatus struct {
 	// The current number of worker nodes for the managed node group.
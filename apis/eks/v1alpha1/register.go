@@ -48,9 +48,27 @@ var (
 	FargateProfileGroupKind        = schema.GroupKind{Group: Group, Kind: FargateProfileKind}.String()
 	FargateProfileKindAPIVersion   = FargateProfileKind + "." + SchemeGroupVersion.String()
 	FargateProfileGroupVersionKind = SchemeGroupVersion.WithKind(FargateProfileKind)
+
+	AddonKind             = reflect.TypeOf(Addon{}).Name()
+	AddonGroupKind        = schema.GroupKind{Group: Group, Kind: AddonKind}.String()
+	AddonKindAPIVersion   = AddonKind + "." + SchemeGroupVersion.String()
+	AddonGroupVersionKind = SchemeGroupVersion.WithKind(AddonKind)
+
+	IdentityProviderConfigKind             = reflect.TypeOf(IdentityProviderConfig{}).Name()
+	IdentityProviderConfigGroupKind        = schema.GroupKind{Group: Group, Kind: IdentityProviderConfigKind}.String()
+	IdentityProviderConfigKindAPIVersion   = IdentityProviderConfigKind + "." + SchemeGroupVersion.String()
+	IdentityProviderConfigGroupVersionKind = SchemeGroupVersion.WithKind(IdentityProviderConfigKind)
+
+	ClusterAuthKind             = reflect.TypeOf(ClusterAuth{}).Name()
+	ClusterAuthGroupKind        = schema.GroupKind{Group: Group, Kind: ClusterAuthKind}.String()
+	ClusterAuthKindAPIVersion   = ClusterAuthKind + "." + SchemeGroupVersion.String()
+	ClusterAuthGroupVersionKind = SchemeGroupVersion.WithKind(ClusterAuthKind)
 )
 
 func init() {
 	SchemeBuilder.Register(&NodeGroup{}, &NodeGroupList{})
 	SchemeBuilder.Register(&FargateProfile{}, &FargateProfileList{})
+	SchemeBuilder.Register(&Addon{}, &AddonList{})
+	SchemeBuilder.Register(&IdentityProviderConfig{}, &IdentityProviderConfigList{})
+	SchemeBuilder.Register(&ClusterAuth{}, &ClusterAuthList{})
 }
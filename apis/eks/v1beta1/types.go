@@ -57,8 +57,8 @@ type ClusterParameters struct {
 	// +optional
 	Region *string `json:"region,omitempty"`
 
-	// The encryption configuration for the cluster.
-	// +immutable
+	// The encryption configuration for the cluster. Encryption may be enabled
+	// on an existing cluster, but cannot be changed or disabled once enabled.
 	// +optional
 	EncryptionConfig []EncryptionConfig `json:"encryptionConfig,omitempty"`
 
@@ -256,6 +256,41 @@ type ClusterObservation struct {
 
 	// The current status of the cluster.
 	Status ClusterStatusType `json:"status,omitempty"`
+
+	// The most recent update made to the cluster, such as a version upgrade,
+	// a configuration change or the association of an encryption configuration.
+	// +optional
+	Update *ClusterUpdate `json:"update,omitempty"`
+}
+
+// ClusterUpdate is an asynchronous update made to a cluster.
+type ClusterUpdate struct {
+	// A UUID that is used to track the update.
+	ID string `json:"id"`
+
+	// The Unix epoch timestamp in seconds for when the update was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// Any errors associated with a Failed update.
+	Errors []ClusterUpdateError `json:"errors,omitempty"`
+
+	// The current status of the update.
+	Status string `json:"status,omitempty"`
+
+	// The type of the update.
+	Type string `json:"type,omitempty"`
+}
+
+// ClusterUpdateError is an error associated with an update.
+type ClusterUpdateError struct {
+	// A brief description of the error.
+	ErrorCode string `json:"errorCode,omitempty"`
+
+	// A more complete description of the error.
+	ErrorMessage string `json:"errorMessage,omitempty"`
+
+	// An optional field that contains the resource IDs associated with the error.
+	ResourceIDs []string `json:"resourceIds,omitempty"`
 }
 
 // Identity is the identity information for a cluster.
@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+// AccessPointParameters define the desired state of an AWS S3 access point.
+type AccessPointParameters struct {
+	// Region is where the Bucket referenced by this AccessPoint resides.
+	// +immutable
+	Region string `json:"region"`
+
+	// AccountID is the ID of the AWS account that owns the bucket. Defaults
+	// to the account the provider is authenticated to.
+	// +optional
+	// +immutable
+	AccountID *string `json:"accountId,omitempty"`
+
+	// BucketName is the name of the bucket the access point is attached to.
+	// +optional
+	// +immutable
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// BucketNameRef references to an S3Bucket to retrieve its bucketName
+	// +optional
+	BucketNameRef *xpv1.Reference `json:"bucketNameRef,omitempty"`
+
+	// BucketNameSelector selects a reference to an S3Bucket to retrieve its bucketName
+	// +optional
+	BucketNameSelector *xpv1.Selector `json:"bucketNameSelector,omitempty"`
+
+	// VPCConfiguration restricts access to this access point to requests
+	// from the specified VPC.
+	// +optional
+	// +immutable
+	VPCConfiguration *AccessPointVPCConfiguration `json:"vpcConfiguration,omitempty"`
+
+	// PublicAccessBlockConfiguration that is applied to this access point.
+	// +optional
+	// +immutable
+	PublicAccessBlockConfiguration *v1beta1.PublicAccessBlockConfiguration `json:"publicAccessBlockConfiguration,omitempty"`
+
+	// RawPolicy is a stringified version of the access point policy.
+	// Either policy or rawPolicy may be specified.
+	// +optional
+	RawPolicy *string `json:"rawPolicy,omitempty"`
+
+	// Policy is a well defined type which can be parsed into a JSON access
+	// point policy. Either policy or rawPolicy may be specified.
+	// +optional
+	Policy *BucketPolicyBody `json:"policy,omitempty"`
+}
+
+// AccessPointVPCConfiguration is the VPC that an access point accepts
+// requests from.
+type AccessPointVPCConfiguration struct {
+	// VPCID is the ID of the VPC.
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+}
+
+// AccessPointObservation keeps the state for the external resource.
+type AccessPointObservation struct {
+	// ARN of the access point.
+	ARN string `json:"arn,omitempty"`
+
+	// Alias of the access point, which can be used wherever a bucket name
+	// is accepted.
+	Alias string `json:"alias,omitempty"`
+
+	// NetworkOrigin is VPC if the access point only accepts requests from a
+	// VPC, and Internet otherwise.
+	NetworkOrigin string `json:"networkOrigin,omitempty"`
+
+	// CreationDate is the time the access point was created.
+	CreationDate *metav1.Time `json:"creationDate,omitempty"`
+}
+
+// An AccessPointSpec defines the desired state of an AccessPoint.
+type AccessPointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccessPointParameters `json:"forProvider"`
+}
+
+// An AccessPointStatus represents the observed state of an AccessPoint.
+type AccessPointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccessPointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccessPoint is a managed resource that represents an AWS S3 access
+// point. The name of the access point is the external name of the resource.
+// +kubebuilder:printcolumn:name="BUCKETNAME",type="string",JSONPath=".spec.forProvider.bucketName"
+// +kubebuilder:printcolumn:name="ALIAS",type="string",JSONPath=".status.atProvider.alias"
+// +kubebuilder:printcolumn:name="ORIGIN",type="string",JSONPath=".status.atProvider.networkOrigin"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccessPoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessPointSpec   `json:"spec"`
+	Status AccessPointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessPointList contains a list of AccessPoints
+type AccessPointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessPoint `json:"items"`
+}
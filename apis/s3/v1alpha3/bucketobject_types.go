@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+// BucketObjectParameters define the desired state of an AWS S3 object.
+type BucketObjectParameters struct {
+	// Region is where the Bucket referenced by this BucketObject resides.
+	// +immutable
+	Region string `json:"region"`
+
+	// BucketName is the name of the bucket the object is placed in.
+	// +optional
+	// +immutable
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// BucketNameRef references to a Bucket to retrieve its bucketName
+	// +optional
+	BucketNameRef *xpv1.Reference `json:"bucketNameRef,omitempty"`
+
+	// BucketNameSelector selects a reference to a Bucket to retrieve its bucketName
+	// +optional
+	BucketNameSelector *xpv1.Selector `json:"bucketNameSelector,omitempty"`
+
+	// Key is the name of the object in the bucket.
+	// +immutable
+	Key string `json:"key"`
+
+	// Content is the literal content of the object. Exactly one of content,
+	// contentSecretRef or contentConfigMapRef must be specified.
+	// +optional
+	Content *string `json:"content,omitempty"`
+
+	// ContentSecretRef selects a key of a Secret whose value is the content
+	// of the object.
+	// +optional
+	ContentSecretRef *xpv1.SecretKeySelector `json:"contentSecretRef,omitempty"`
+
+	// ContentConfigMapRef selects a key of a ConfigMap whose value is the
+	// content of the object.
+	// +optional
+	ContentConfigMapRef *ConfigMapKeySelector `json:"contentConfigMapRef,omitempty"`
+
+	// ContentType is a standard MIME type describing the format of the
+	// object data.
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// CacheControl can be used to specify caching behavior along the
+	// request/reply chain.
+	// +optional
+	CacheControl *string `json:"cacheControl,omitempty"`
+
+	// ServerSideEncryption is the server-side encryption algorithm used
+	// when storing this object in Amazon S3.
+	// +optional
+	// +kubebuilder:validation:Enum=AES256;"aws:kms"
+	ServerSideEncryption *string `json:"serverSideEncryption,omitempty"`
+
+	// SSEKMSKeyID specifies the ID of the symmetric customer managed AWS
+	// KMS CMK to use for object encryption when serverSideEncryption is
+	// aws:kms. The key may be given by its ID, its ARN or an alias. The key
+	// of existing objects is not compared when it is given by an alias.
+	// +optional
+	SSEKMSKeyID *string `json:"sseKMSKeyId,omitempty"`
+
+	// Metadata is a map of metadata to store with the object in S3. Keys
+	// are stored in lower case.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// Tags is the tag set of the object.
+	// +optional
+	Tags []v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// A ConfigMapKeySelector is a reference to a ConfigMap key in an arbitrary
+// namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// The key to select.
+	Key string `json:"key"`
+}
+
+// BucketObjectObservation keeps the state for the external resource.
+type BucketObjectObservation struct {
+	// ETag is the entity tag of the object written last by the controller.
+	ETag string `json:"etag,omitempty"`
+
+	// ContentMD5 is the hex encoded MD5 digest of the content written last
+	// by the controller.
+	ContentMD5 string `json:"contentMD5,omitempty"`
+
+	// VersionID is the version of the object written last by the
+	// controller, if the bucket is versioned.
+	VersionID string `json:"versionId,omitempty"`
+}
+
+// A BucketObjectSpec defines the desired state of a BucketObject.
+type BucketObjectSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BucketObjectParameters `json:"forProvider"`
+}
+
+// A BucketObjectStatus represents the observed state of a BucketObject.
+type BucketObjectStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BucketObjectObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BucketObject is a managed resource that represents a small object in an
+// AWS S3 bucket.
+// +kubebuilder:printcolumn:name="BUCKETNAME",type="string",JSONPath=".spec.forProvider.bucketName"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".spec.forProvider.key"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type BucketObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BucketObjectSpec   `json:"spec"`
+	Status BucketObjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BucketObjectList contains a list of BucketObjects
+type BucketObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BucketObject `json:"items"`
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+// MultiRegionAccessPointParameters define the desired state of an AWS S3
+// Multi-Region access point.
+type MultiRegionAccessPointParameters struct {
+	// AccountID is the ID of the AWS account that owns the buckets. Defaults
+	// to the account the provider is authenticated to.
+	// +optional
+	// +immutable
+	AccountID *string `json:"accountId,omitempty"`
+
+	// Regions are the buckets that requests to the Multi-Region access point
+	// are routed to, at most one per AWS region.
+	// +kubebuilder:validation:MinItems=1
+	// +immutable
+	Regions []MultiRegionAccessPointRegion `json:"regions"`
+
+	// PublicAccessBlockConfiguration that is applied to this Multi-Region
+	// access point.
+	// +optional
+	// +immutable
+	PublicAccessBlockConfiguration *v1beta1.PublicAccessBlockConfiguration `json:"publicAccessBlockConfiguration,omitempty"`
+
+	// RawPolicy is a stringified version of the Multi-Region access point
+	// policy. Either policy or rawPolicy may be specified. A policy cannot be
+	// removed once it is set.
+	// +optional
+	RawPolicy *string `json:"rawPolicy,omitempty"`
+
+	// Policy is a well defined type which can be parsed into a JSON
+	// Multi-Region access point policy. Either policy or rawPolicy may be
+	// specified. A policy cannot be removed once it is set.
+	// +optional
+	Policy *BucketPolicyBody `json:"policy,omitempty"`
+}
+
+// MultiRegionAccessPointRegion is a bucket that a Multi-Region access point
+// routes requests to.
+type MultiRegionAccessPointRegion struct {
+	// BucketName is the name of the bucket.
+	// +optional
+	// +immutable
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// BucketNameRef references to an S3Bucket to retrieve its bucketName
+	// +optional
+	BucketNameRef *xpv1.Reference `json:"bucketNameRef,omitempty"`
+
+	// BucketNameSelector selects a reference to an S3Bucket to retrieve its bucketName
+	// +optional
+	BucketNameSelector *xpv1.Selector `json:"bucketNameSelector,omitempty"`
+}
+
+// MultiRegionAccessPointObservation keeps the state for the external
+// resource.
+type MultiRegionAccessPointObservation struct {
+	// ARN of the Multi-Region access point.
+	ARN string `json:"arn,omitempty"`
+
+	// Alias of the Multi-Region access point, which identifies it in the
+	// hostname of its endpoint.
+	Alias string `json:"alias,omitempty"`
+
+	// Status of the Multi-Region access point, e.g. CREATING or READY.
+	Status string `json:"status,omitempty"`
+
+	// CreatedAt is the time the Multi-Region access point was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// RequestTokenARN identifies the asynchronous request that creates the
+	// Multi-Region access point.
+	RequestTokenARN string `json:"requestTokenArn,omitempty"`
+}
+
+// A MultiRegionAccessPointSpec defines the desired state of a
+// MultiRegionAccessPoint.
+type MultiRegionAccessPointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MultiRegionAccessPointParameters `json:"forProvider"`
+}
+
+// A MultiRegionAccessPointStatus represents the observed state of a
+// MultiRegionAccessPoint.
+type MultiRegionAccessPointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MultiRegionAccessPointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MultiRegionAccessPoint is a managed resource that represents an AWS S3
+// Multi-Region access point. The name of the Multi-Region access point is the
+// external name of the resource.
+// +kubebuilder:printcolumn:name="ALIAS",type="string",JSONPath=".status.atProvider.alias"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type MultiRegionAccessPoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MultiRegionAccessPointSpec   `json:"spec"`
+	Status MultiRegionAccessPointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MultiRegionAccessPointList contains a list of MultiRegionAccessPoints
+type MultiRegionAccessPointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MultiRegionAccessPoint `json:"items"`
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	network "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
 	identityv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
 	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
@@ -64,6 +65,114 @@ func (mg *BucketPolicy) ResolveReferences(ctx context.Context, c client.Reader)
 	return nil
 }
 
+// ResolveReferences of this BucketObject
+func (mg *BucketObject) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.bucketName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.BucketName),
+		Reference:    mg.Spec.ForProvider.BucketNameRef,
+		Selector:     mg.Spec.ForProvider.BucketNameSelector,
+		To:           reference.To{Managed: &v1beta1.Bucket{}, List: &v1beta1.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.bucketName")
+	}
+	mg.Spec.ForProvider.BucketName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.BucketNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this AccessPoint
+func (mg *AccessPoint) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.bucketName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.BucketName),
+		Reference:    mg.Spec.ForProvider.BucketNameRef,
+		Selector:     mg.Spec.ForProvider.BucketNameSelector,
+		To:           reference.To{Managed: &v1beta1.Bucket{}, List: &v1beta1.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.bucketName")
+	}
+	mg.Spec.ForProvider.BucketName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.BucketNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.vpcConfiguration.vpcId
+	if mg.Spec.ForProvider.VPCConfiguration != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VPCConfiguration.VPCID),
+			Reference:    mg.Spec.ForProvider.VPCConfiguration.VPCIDRef,
+			Selector:     mg.Spec.ForProvider.VPCConfiguration.VPCIDSelector,
+			To:           reference.To{Managed: &network.VPC{}, List: &network.VPCList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.vpcConfiguration.vpcId")
+		}
+		mg.Spec.ForProvider.VPCConfiguration.VPCID = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.VPCConfiguration.VPCIDRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.policy.statements[*].principal
+	if mg.Spec.ForProvider.Policy != nil {
+		for i := range mg.Spec.ForProvider.Policy.Statements {
+			statement := mg.Spec.ForProvider.Policy.Statements[i]
+			if err := ResolvePrincipal(ctx, r, statement.Principal, i); err != nil {
+				return err
+			}
+			if err := ResolvePrincipal(ctx, r, statement.NotPrincipal, i); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ResolveReferences of this MultiRegionAccessPoint
+func (mg *MultiRegionAccessPoint) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.regions[*].bucketName
+	for i := range mg.Spec.ForProvider.Regions {
+		region := &mg.Spec.ForProvider.Regions[i]
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(region.BucketName),
+			Reference:    region.BucketNameRef,
+			Selector:     region.BucketNameSelector,
+			To:           reference.To{Managed: &v1beta1.Bucket{}, List: &v1beta1.BucketList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.regions[%d].bucketName", i))
+		}
+		region.BucketName = reference.ToPtrValue(rsp.ResolvedValue)
+		region.BucketNameRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.policy.statements[*].principal
+	if mg.Spec.ForProvider.Policy != nil {
+		for i := range mg.Spec.ForProvider.Policy.Statements {
+			statement := mg.Spec.ForProvider.Policy.Statements[i]
+			if err := ResolvePrincipal(ctx, r, statement.Principal, i); err != nil {
+				return err
+			}
+			if err := ResolvePrincipal(ctx, r, statement.NotPrincipal, i); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // ResolvePrincipal resolves all the IAMUser and IAMRole references in a BucketPrincipal
 func ResolvePrincipal(ctx context.Context, r *reference.APIResolver, principal *BucketPrincipal, statementIndex int) error {
 	if principal == nil {
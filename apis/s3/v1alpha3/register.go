@@ -45,6 +45,33 @@ var (
 	BucketPolicyGroupVersionKind = SchemeGroupVersion.WithKind(BucketPolicyKind)
 )
 
+// BucketObject type metadata.
+var (
+	BucketObjectKind             = reflect.TypeOf(BucketObject{}).Name()
+	BucketObjectGroupKind        = schema.GroupKind{Group: Group, Kind: BucketObjectKind}.String()
+	BucketObjectKindAPIVersion   = BucketObjectKind + "." + SchemeGroupVersion.String()
+	BucketObjectGroupVersionKind = SchemeGroupVersion.WithKind(BucketObjectKind)
+)
+
+// AccessPoint type metadata.
+var (
+	AccessPointKind             = reflect.TypeOf(AccessPoint{}).Name()
+	AccessPointGroupKind        = schema.GroupKind{Group: Group, Kind: AccessPointKind}.String()
+	AccessPointKindAPIVersion   = AccessPointKind + "." + SchemeGroupVersion.String()
+	AccessPointGroupVersionKind = SchemeGroupVersion.WithKind(AccessPointKind)
+)
+
+// MultiRegionAccessPoint type metadata.
+var (
+	MultiRegionAccessPointKind             = reflect.TypeOf(MultiRegionAccessPoint{}).Name()
+	MultiRegionAccessPointGroupKind        = schema.GroupKind{Group: Group, Kind: MultiRegionAccessPointKind}.String()
+	MultiRegionAccessPointKindAPIVersion   = MultiRegionAccessPointKind + "." + SchemeGroupVersion.String()
+	MultiRegionAccessPointGroupVersionKind = SchemeGroupVersion.WithKind(MultiRegionAccessPointKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&BucketPolicy{}, &BucketPolicyList{})
+	SchemeBuilder.Register(&BucketObject{}, &BucketObjectList{})
+	SchemeBuilder.Register(&AccessPoint{}, &AccessPointList{})
+	SchemeBuilder.Register(&MultiRegionAccessPoint{}, &MultiRegionAccessPointList{})
 }
@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// AnalyticsConfiguration specifies the configuration and any analyses for the
+// analytics filter of an Amazon S3 bucket.
+type AnalyticsConfiguration struct {
+	// ID is the unique identifier of the analytics configuration.
+	ID string `json:"id"`
+
+	// Filter is used to describe a set of objects for analyses. A filter
+	// must have exactly one prefix, one tag, or one conjunction
+	// (AnalyticsAndOperator). If no filter is provided, all objects will be
+	// considered in any analysis.
+	// +optional
+	Filter *AnalyticsFilter `json:"filter,omitempty"`
+
+	// StorageClassAnalysis contains data related to access patterns to be
+	// collected and made available to analyze the tradeoffs between
+	// different storage classes.
+	StorageClassAnalysis StorageClassAnalysis `json:"storageClassAnalysis"`
+}
+
+// AnalyticsFilter is used to describe a set of objects for analyses. A filter
+// must have exactly one prefix, one tag, or one conjunction
+// (AnalyticsAndOperator).
+type AnalyticsFilter struct {
+	// A conjunction (logical AND) of predicates, which is used in
+	// evaluating an analytics filter. The operator must have at least two
+	// predicates.
+	// +optional
+	And *AnalyticsAndOperator `json:"and,omitempty"`
+
+	// Prefix is the prefix to use when evaluating an analytics filter.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Tag is the tag to use when evaluating an analytics filter.
+	// +optional
+	Tag *Tag `json:"tag,omitempty"`
+}
+
+// AnalyticsAndOperator is a conjunction (logical AND) of predicates, which is
+// used in evaluating an analytics filter.
+type AnalyticsAndOperator struct {
+	// Prefix is the prefix to use when evaluating an AND predicate. The
+	// prefix that an object must have to be included in the analytics
+	// results.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Tags is the list of tags to use when evaluating an AND predicate.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// StorageClassAnalysis specifies data related to access patterns to be
+// collected and made available to analyze the tradeoffs between different
+// storage classes for an Amazon S3 bucket.
+type StorageClassAnalysis struct {
+	// DataExport specifies how data related to the storage class analysis
+	// for an Amazon S3 bucket should be exported.
+	// +optional
+	DataExport *StorageClassAnalysisDataExport `json:"dataExport,omitempty"`
+}
+
+// StorageClassAnalysisDataExport is a container for data related to the
+// storage class analysis for an Amazon S3 bucket for export.
+type StorageClassAnalysisDataExport struct {
+	// Destination is the place to store the data for an analysis.
+	Destination AnalyticsExportDestination `json:"destination"`
+
+	// OutputSchemaVersion is the version of the output schema to use when
+	// exporting data.
+	// +kubebuilder:validation:Enum=V_1
+	OutputSchemaVersion string `json:"outputSchemaVersion"`
+}
+
+// AnalyticsExportDestination is where to publish the analytics results.
+type AnalyticsExportDestination struct {
+	// S3BucketDestination is a destination signifying output to an S3
+	// bucket.
+	S3BucketDestination AnalyticsS3BucketDestination `json:"s3BucketDestination"`
+}
+
+// AnalyticsS3BucketDestination contains information about where to publish
+// the analytics results.
+type AnalyticsS3BucketDestination struct {
+	// Bucket is the Amazon Resource Name (ARN) of the bucket to which data
+	// is exported.
+	Bucket string `json:"bucket"`
+
+	// BucketAccountID is the account ID that owns the destination S3
+	// bucket. If no account ID is provided, the owner is not validated
+	// before exporting data.
+	// +optional
+	BucketAccountID *string `json:"bucketAccountId,omitempty"`
+
+	// Format specifies the file format used when exporting data to Amazon
+	// S3.
+	// +kubebuilder:validation:Enum=CSV
+	Format string `json:"format"`
+
+	// Prefix is the prefix to use when exporting data. The prefix is
+	// prepended to all results.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+}
@@ -142,6 +142,46 @@ type BucketParameters struct {
 	// PublicAccessBlockConfiguration that you want to apply to this Amazon
 	// S3 bucket.
 	PublicAccessBlockConfiguration *PublicAccessBlockConfiguration `json:"publicAccessBlockConfiguration,omitempty"`
+
+	// ObjectLockConfiguration places an Object Lock configuration on the
+	// bucket, for example to retain new objects for a default period. It
+	// can only be set on buckets with ObjectLockEnabledForBucket.
+	// +optional
+	ObjectLockConfiguration *ObjectLockConfiguration `json:"objectLockConfiguration,omitempty"`
+
+	// OwnershipControls determines the ownership of the objects uploaded to
+	// the bucket, and whether ACLs are used.
+	// +optional
+	OwnershipControls *OwnershipControls `json:"ownershipControls,omitempty"`
+
+	// IntelligentTieringConfigurations are the S3 Intelligent-Tiering
+	// configurations of the bucket that archive objects in the
+	// Intelligent-Tiering storage class.
+	// +optional
+	IntelligentTieringConfigurations []IntelligentTieringConfiguration `json:"intelligentTieringConfigurations,omitempty"`
+
+	// InventoryConfigurations are the S3 Inventory configurations of the
+	// bucket that publish lists of its objects and their metadata.
+	// +optional
+	InventoryConfigurations []InventoryConfiguration `json:"inventoryConfigurations,omitempty"`
+
+	// AnalyticsConfigurations are the storage class analytics
+	// configurations of the bucket.
+	// +optional
+	AnalyticsConfigurations []AnalyticsConfiguration `json:"analyticsConfigurations,omitempty"`
+
+	// MetricsConfigurations are the CloudWatch request metrics
+	// configurations of the bucket.
+	// +optional
+	MetricsConfigurations []MetricsConfiguration `json:"metricsConfigurations,omitempty"`
+
+	// ForceDestroy indicates that all objects, object versions and delete
+	// markers of the bucket should be deleted and all incomplete multipart
+	// uploads aborted when the bucket is deleted, so that the bucket can be
+	// deleted even if it is not empty. Large buckets are emptied across
+	// several reconciles. These objects are not recoverable.
+	// +optional
+	ForceDestroy *bool `json:"forceDestroy,omitempty"`
 }
 
 // BucketSpec represents the desired state of the Bucket.
@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// IntelligentTieringConfiguration specifies the S3 Intelligent-Tiering
+// configuration for an Amazon S3 bucket.
+type IntelligentTieringConfiguration struct {
+	// ID is the unique identifier of the S3 Intelligent-Tiering configuration.
+	ID string `json:"id"`
+
+	// Filter specifies a bucket filter. The configuration only includes
+	// objects that meet the filter's criteria.
+	// +optional
+	Filter *IntelligentTieringFilter `json:"filter,omitempty"`
+
+	// Status specifies the status of the configuration.
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	Status string `json:"status"`
+
+	// Tierings specifies the S3 Intelligent-Tiering storage class tiers of
+	// the configuration.
+	Tierings []Tiering `json:"tierings"`
+}
+
+// IntelligentTieringFilter specifies the objects that an S3
+// Intelligent-Tiering configuration applies to. Objects must match the prefix
+// and all tags to be included.
+type IntelligentTieringFilter struct {
+	// Prefix is an object key name prefix that identifies the subset of
+	// objects to which the configuration applies.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Tags that identify the subset of objects to which the configuration
+	// applies.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// Tiering specifies the number of consecutive days of no access after which
+// an object will be eligible to be transitioned to the corresponding tier.
+type Tiering struct {
+	// AccessTier is the S3 Intelligent-Tiering access tier.
+	// +kubebuilder:validation:Enum=ARCHIVE_ACCESS;DEEP_ARCHIVE_ACCESS
+	AccessTier string `json:"accessTier"`
+
+	// Days is the number of consecutive days of no access after which an
+	// object will be eligible to be transitioned to the corresponding tier.
+	// The minimum number of days specified for Archive Access tier must be
+	// at least 90 days and Deep Archive Access tier must be at least 180
+	// days.
+	Days int64 `json:"days"`
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// InventoryConfiguration specifies the inventory configuration for an Amazon
+// S3 bucket.
+type InventoryConfiguration struct {
+	// ID is the unique identifier of the inventory configuration.
+	ID string `json:"id"`
+
+	// Destination contains information about where to publish the inventory
+	// results.
+	Destination InventoryDestination `json:"destination"`
+
+	// Filter specifies an inventory filter. The inventory only includes
+	// objects that meet the filter's criteria.
+	// +optional
+	Filter *InventoryFilter `json:"filter,omitempty"`
+
+	// IncludedObjectVersions is the object versions to include in the
+	// inventory list. If set to All, the list includes all the object
+	// versions, which adds the version-related fields VersionId, IsLatest,
+	// and DeleteMarker to the list. If set to Current, the list does not
+	// contain these version-related fields.
+	// +kubebuilder:validation:Enum=All;Current
+	IncludedObjectVersions string `json:"includedObjectVersions"`
+
+	// IsEnabled specifies whether the inventory is enabled or disabled. If
+	// set to true, an inventory list is generated. If set to false, no
+	// inventory list is generated.
+	IsEnabled bool `json:"isEnabled"`
+
+	// OptionalFields contains the optional fields that are included in the
+	// inventory results.
+	// +optional
+	OptionalFields []string `json:"optionalFields,omitempty"`
+
+	// Schedule specifies the schedule for generating inventory results.
+	Schedule InventorySchedule `json:"schedule"`
+}
+
+// InventoryDestination specifies the inventory configuration for an Amazon
+// S3 bucket.
+type InventoryDestination struct {
+	// S3BucketDestination contains the bucket name, file format, bucket
+	// owner (optional), and prefix (optional) where inventory results are
+	// published.
+	S3BucketDestination InventoryS3BucketDestination `json:"s3BucketDestination"`
+}
+
+// InventoryS3BucketDestination contains the bucket name, file format, bucket
+// owner (optional), and prefix (optional) where inventory results are
+// published.
+type InventoryS3BucketDestination struct {
+	// AccountID is the account ID that owns the destination S3 bucket. If
+	// no account ID is provided, the owner is not validated before
+	// exporting data.
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
+
+	// Bucket is the Amazon Resource Name (ARN) of the bucket where
+	// inventory results will be published.
+	Bucket string `json:"bucket"`
+
+	// Encryption contains the type of server-side encryption used to
+	// encrypt the inventory results.
+	// +optional
+	Encryption *InventoryEncryption `json:"encryption,omitempty"`
+
+	// Format specifies the output format of the inventory results.
+	// +kubebuilder:validation:Enum=CSV;ORC;Parquet
+	Format string `json:"format"`
+
+	// Prefix is the prefix that is prepended to all inventory results.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+}
+
+// InventoryEncryption contains the type of server-side encryption used to
+// encrypt the inventory results.
+type InventoryEncryption struct {
+	// SSEKMS specifies the use of SSE-KMS to encrypt delivered inventory
+	// reports.
+	// +optional
+	SSEKMS *SSEKMS `json:"sseKMS,omitempty"`
+
+	// SSES3 specifies the use of SSE-S3 to encrypt delivered inventory
+	// reports.
+	// +optional
+	SSES3 *SSES3 `json:"sseS3,omitempty"`
+}
+
+// SSEKMS specifies the use of SSE-KMS to encrypt delivered inventory reports.
+type SSEKMS struct {
+	// KeyID specifies the ID of the AWS Key Management Service (AWS KMS)
+	// symmetric customer managed customer master key (CMK) to use for
+	// encrypting inventory reports.
+	KeyID string `json:"keyId"`
+}
+
+// SSES3 specifies the use of SSE-S3 to encrypt delivered inventory reports.
+type SSES3 struct{}
+
+// InventoryFilter specifies an inventory filter. The inventory only includes
+// objects that meet the filter's criteria.
+type InventoryFilter struct {
+	// Prefix is the prefix that an object must have to be included in the
+	// inventory results.
+	Prefix string `json:"prefix"`
+}
+
+// InventorySchedule specifies the schedule for generating inventory results.
+type InventorySchedule struct {
+	// Frequency specifies how frequently inventory results are produced.
+	// +kubebuilder:validation:Enum=Daily;Weekly
+	Frequency string `json:"frequency"`
+}
@@ -26,17 +26,141 @@ import (
 type ProviderConfigSpec struct {
 	// Credentials required to authenticate to this provider.
 	Credentials ProviderCredentials `json:"credentials"`
+
+	// AssumeRoleChain is an ordered list of IAM roles to assume. The first
+	// role is assumed using the identity resolved from Credentials and every
+	// subsequent role is assumed using the credentials of the one before it.
+	// The credentials of the last role in the chain are used to connect to
+	// the AWS API.
+	// +optional
+	AssumeRoleChain []AssumeRoleOptions `json:"assumeRoleChain,omitempty"`
+
+	// Endpoint overrides the endpoints that the AWS API calls made with this
+	// ProviderConfig are sent to.
+	// +optional
+	Endpoint *EndpointConfig `json:"endpoint,omitempty"`
+
+	// DefaultTags are added to the tags of every managed resource that uses
+	// this ProviderConfig and supports tagging. The tags given in the spec of
+	// a managed resource take precedence over the default tags. Changing or
+	// removing a default tag updates or removes it on the managed resources
+	// that have not given it another value.
+	// +optional
+	DefaultTags map[string]string `json:"defaultTags,omitempty"`
+}
+
+// EndpointConfig is used to override the endpoints that the AWS API calls are
+// sent to, for example to use VPC endpoints or a local AWS stand-in.
+type EndpointConfig struct {
+	// URLs maps AWS service endpoint IDs, such as ec2, s3 or sts, to the URL
+	// of the endpoint that the calls to that service are sent to. Services
+	// that are not listed here use their default endpoint.
+	// +optional
+	URLs map[string]string `json:"urls,omitempty"`
+
+	// SigningRegion is the region that the calls sent to the endpoints in
+	// URLs are signed for. Defaults to the region of the managed resource.
+	// +optional
+	SigningRegion *string `json:"signingRegion,omitempty"`
+
+	// PartitionID is the AWS partition, such as aws, aws-cn or aws-us-gov,
+	// that the endpoints in URLs belong to.
+	// +optional
+	PartitionID *string `json:"partitionID,omitempty"`
+
+	// UseFIPSEndpoint causes the FIPS 140-2 compliant endpoint of a service
+	// to be used. Calls to services that do not have a FIPS endpoint in the
+	// region fail.
+	// +optional
+	UseFIPSEndpoint *bool `json:"useFIPSEndpoint,omitempty"`
+
+	// UseDualStackEndpoint causes the IPv4 and IPv6 dual-stack endpoint of a
+	// service to be used for the services that support it, such as S3.
+	// +optional
+	UseDualStackEndpoint *bool `json:"useDualStackEndpoint,omitempty"`
+
+	// CABundleSecretRef references a PEM encoded certificate authority bundle
+	// that is used to verify the TLS certificates of the endpoints.
+	// +optional
+	CABundleSecretRef *xpv1.SecretKeySelector `json:"caBundleSecretRef,omitempty"`
+}
+
+// AssumeRoleOptions define the options for assuming an IAM role.
+type AssumeRoleOptions struct {
+	// RoleARN is the Amazon Resource Name (ARN) of the IAM role to assume.
+	RoleARN string `json:"roleARN"`
+
+	// ExternalID is a unique identifier that might be required by the trust
+	// policy of the role being assumed.
+	// +optional
+	ExternalID *string `json:"externalID,omitempty"`
+
+	// RoleSessionName is an identifier for the assumed role session. A
+	// timestamp based name is generated if it is not given.
+	// +optional
+	RoleSessionName *string `json:"roleSessionName,omitempty"`
+
+	// Tags is the list of session tags passed to the assumed role session.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// TransitiveTagKeys is the list of session tag keys that persist to the
+	// subsequent sessions in the chain.
+	// +optional
+	TransitiveTagKeys []string `json:"transitiveTagKeys,omitempty"`
+
+	// Duration of the assumed role session, such as 15m or 1h. Defaults to 15
+	// minutes, which is also the minimum allowed by AWS.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// Tag is a session tag passed when assuming a role.
+type Tag struct {
+	// Key of the session tag.
+	Key string `json:"key"`
+
+	// Value of the session tag.
+	Value string `json:"value"`
 }
 
+// CredentialsSourceWebIdentity indicates that the provider should obtain its
+// credentials by assuming an IAM role with an OIDC web identity token.
+const CredentialsSourceWebIdentity xpv1.CredentialsSource = "WebIdentity"
+
 // ProviderCredentials required to authenticate.
 type ProviderCredentials struct {
 	// Source of the provider credentials.
-	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem
+	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem;WebIdentity
 	Source xpv1.CredentialsSource `json:"source"`
 
+	// WebIdentity defines the IAM role and the OIDC token used to obtain
+	// credentials. Required when the source is WebIdentity.
+	// +optional
+	WebIdentity *WebIdentityConfig `json:"webIdentity,omitempty"`
+
 	xpv1.CommonCredentialSelectors `json:",inline"`
 }
 
+// WebIdentityConfig defines the options for assuming an IAM role with an OIDC
+// web identity token.
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_providers_oidc.html
+type WebIdentityConfig struct {
+	// RoleARN is the Amazon Resource Name (ARN) of the IAM role to assume.
+	RoleARN string `json:"roleARN"`
+
+	// TokenFile is the path of the file that contains the OIDC token, such
+	// as a projected service account token. Defaults to the path in the
+	// AWS_WEB_IDENTITY_TOKEN_FILE environment variable.
+	// +optional
+	TokenFile *string `json:"tokenFile,omitempty"`
+
+	// RoleSessionName is an identifier for the assumed role session. A
+	// timestamp based name is generated if it is not given.
+	// +optional
+	RoleSessionName *string `json:"roleSessionName,omitempty"`
+}
+
 // A ProviderConfigStatus represents the status of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
@@ -19,6 +19,7 @@ package main
 import (
 	"os"
 	"path/filepath"
+	"strings"
 
 	"gopkg.in/alecthomas/kingpin.v2"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -38,9 +39,14 @@ func main() {
 		debug          = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
 		syncPeriod     = app.Flag("sync", "Controller manager sync period duration such as 300ms, 1.5h or 2h45m").Short('s').Default("1h").Duration()
 		leaderElection = app.Flag("leader-election", "Use leader election for the conroller manager.").Short('l').Default("false").OverrideDefaultFromEnvar("LEADER_ELECTION").Bool()
+		enableGroups   = app.Flag("enable-groups", "Comma separated API groups, such as ec2 or rds.aws.crossplane.io, whose controllers should be started. All groups are enabled by default. The API types of the groups that enabled groups reference stay registered, even if their controllers are not started.").Envar("ENABLE_GROUPS").Strings()
+		disableGroups  = app.Flag("disable-groups", "Comma separated API groups whose controllers should not be started. Their API types stay registered if an enabled group references them.").Envar("DISABLE_GROUPS").Strings()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
+	groups, err := controller.ResolveGroups(*enableGroups, *disableGroups)
+	kingpin.FatalIfError(err, "Cannot resolve API groups")
+
 	zl := zap.New(zap.UseDevMode(*debug))
 	log := logging.NewLogrLogger(zl.WithName("provider-aws"))
 	if *debug {
@@ -50,7 +56,7 @@ func main() {
 		ctrl.SetLogger(zl)
 	}
 
-	log.Debug("Starting", "sync-period", syncPeriod.String())
+	log.Debug("Starting", "sync-period", syncPeriod.String(), "groups", strings.Join(groups, ","))
 
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
@@ -62,8 +68,8 @@ func main() {
 	})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 
-	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add AWS APIs to scheme")
-	kingpin.FatalIfError(controller.Setup(mgr, log, ratelimiter.NewDefaultProviderRateLimiter(ratelimiter.DefaultProviderRPS)), "Cannot setup AWS controllers")
+	kingpin.FatalIfError(apis.AddToSchemeForGroups(mgr.GetScheme(), groups), "Cannot add AWS APIs to scheme")
+	kingpin.FatalIfError(controller.SetupGroups(mgr, log, ratelimiter.NewDefaultProviderRateLimiter(ratelimiter.DefaultProviderRPS), groups), "Cannot setup AWS controllers")
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 
 }
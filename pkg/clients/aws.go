@@ -21,7 +21,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"net/url"
 	"os"
 	"strconv"
@@ -32,13 +31,17 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/aws/endpoints"
 	"github.com/aws/aws-sdk-go-v2/aws/external"
+	"github.com/aws/aws-sdk-go-v2/aws/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	awsv1 "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	stscredsv1 "github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	endpointsv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
+	stsv1 "github.com/aws/aws-sdk-go/service/sts"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/go-ini/ini"
@@ -59,6 +62,16 @@ const DefaultSection = ini.DefaultSection
 // of region.
 const GlobalRegion = "aws-global"
 
+// DefaultAssumeRoleDuration is the duration of an assumed role session when
+// none is specified in the ProviderConfig.
+const DefaultAssumeRoleDuration = 15 * time.Minute
+
+// assumeRoleExpiryWindow is how long before their expiration the credentials
+// of an assumed role are refreshed.
+const assumeRoleExpiryWindow = time.Minute
+
+const errWebIdentityConfigMissing = "webIdentity configuration is required when the credentials source is WebIdentity"
+
 // A FieldOption determines how common Go types are translated to the types
 // required by the AWS Go SDK.
 type FieldOption int
@@ -97,22 +110,74 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
 
-	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
+	data, err := getCredentialsData(ctx, c, pc)
+	if err != nil {
+		return nil, err
+	}
+	ca, err := getCABundleData(ctx, c, pc.Spec.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	key := configCacheKey{uid: pc.GetUID(), region: region}
+	if cfg, ok := configs.get(key, pc.GetGeneration(), data, ca); ok {
+		cp := cfg.(*aws.Config).Copy()
+		return SetResolver(ctx, mg, &cp), nil
+	}
+	cfg, err := newConfig(ctx, c, pc, data, region)
+	if err != nil {
+		return nil, err
+	}
+	configs.set(key, pc.GetGeneration(), cfg, data, ca)
+	cp := cfg.Copy()
+	return SetResolver(ctx, mg, &cp), nil
+}
+
+// newConfig builds the *aws.Config described by the supplied ProviderConfig
+// using the given credentials data.
+func newConfig(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, data []byte, region string) (*aws.Config, error) {
+	var cfg *aws.Config
+	var err error
+	switch pc.Spec.Credentials.Source { //nolint:exhaustive
 	case xpv1.CredentialsSourceInjectedIdentity:
-		cfg, err := UsePodServiceAccount(ctx, []byte{}, DefaultSection, region)
-		return SetResolver(ctx, mg, cfg), err
+		cfg, err = UsePodServiceAccount(ctx, data, DefaultSection, region)
+	case v1beta1.CredentialsSourceWebIdentity:
+		cfg, err = UseWebIdentity(ctx, pc.Spec.Credentials.WebIdentity, region)
+		err = errors.Wrap(err, "cannot use web identity")
+	default:
+		cfg, err = UseProviderSecret(ctx, data, DefaultSection, region)
+	}
+	if err != nil {
+		return nil, err
+	}
+	cfg.Handlers.CompleteAttempt.PushBackNamed(NewMetricsHandler(pc.GetName()))
+	if err := SetEndpointConfig(ctx, c, cfg, pc.Spec.Endpoint); err != nil {
+		return nil, errors.Wrap(err, "cannot set endpoint configuration")
+	}
+	cfg, err = UseAssumeRoleChain(ctx, cfg, pc.Spec.AssumeRoleChain)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot assume role chain")
+	}
+	AddAdaptiveRateLimiting(&cfg.Handlers, getAccountID(ctx, *cfg, pc.GetName()))
+	return cfg, nil
+}
+
+// getCredentialsData extracts the credentials data of the supplied
+// ProviderConfig. Credentials sources that are not backed by data, such as
+// InjectedIdentity, result in nil.
+func getCredentialsData(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) ([]byte, error) {
+	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
+	case xpv1.CredentialsSourceInjectedIdentity, v1beta1.CredentialsSourceWebIdentity:
+		return nil, nil
 	default:
 		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
-		if err != nil {
-			return nil, errors.Wrap(err, "cannot get credentials")
-		}
-		cfg, err := UseProviderSecret(ctx, data, DefaultSection, region)
-		return SetResolver(ctx, mg, cfg), err
+		return data, errors.Wrap(err, "cannot get credentials")
 	}
 }
 
 // SetResolver parses annotations from the managed resource
-// and returns a configuration accordingly.
+// and returns a configuration accordingly. The endpoint given in the
+// annotations takes precedence over the endpoint configuration of the
+// ProviderConfig.
 func SetResolver(ctx context.Context, mg resource.Managed, cfg *aws.Config) *aws.Config {
 	if ServiceID, ok := mg.GetAnnotations()["aws.alpha.crossplane.io/endpointServiceID"]; ok {
 		if URL, ok := mg.GetAnnotations()["aws.alpha.crossplane.io/endpointURL"]; ok {
@@ -123,7 +188,10 @@ func SetResolver(ctx context.Context, mg resource.Managed, cfg *aws.Config) *aws
 				endpoint.SigningRegion = Region
 			}
 
-			defaultResolver := endpoints.NewDefaultResolver()
+			var defaultResolver aws.EndpointResolver = endpoints.NewDefaultResolver()
+			if cfg.EndpointResolver != nil {
+				defaultResolver = cfg.EndpointResolver
+			}
 			endpointResolver := func(service, region string) (aws.Endpoint, error) {
 				if strings.Contains(ServiceID, service) {
 					return endpoint, nil
@@ -150,7 +218,12 @@ func UseProvider(ctx context.Context, c client.Client, mg resource.Managed, regi
 	}
 
 	if aws.BoolValue(p.Spec.UseServiceAccount) {
-		return UsePodServiceAccount(ctx, []byte{}, DefaultSection, region)
+		cfg, err := UsePodServiceAccount(ctx, []byte{}, DefaultSection, region)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Handlers.CompleteAttempt.PushBackNamed(NewMetricsHandler(p.GetName()))
+		return cfg, nil
 	}
 
 	if p.Spec.CredentialsSecretRef == nil {
@@ -163,7 +236,12 @@ func UseProvider(ctx context.Context, c client.Client, mg resource.Managed, regi
 		return nil, errors.Wrap(err, "cannot get credentials secret")
 	}
 
-	return UseProviderSecret(ctx, secret.Data[csr.Key], DefaultSection, region)
+	cfg, err := UseProviderSecret(ctx, secret.Data[csr.Key], DefaultSection, region)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Handlers.CompleteAttempt.PushBackNamed(NewMetricsHandler(p.GetName()))
+	return cfg, nil
 }
 
 // CredentialsIDSecret retrieves AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY from the data which contains
@@ -222,45 +300,103 @@ func UseProviderSecret(_ context.Context, data []byte, profile, region string) (
 
 // UsePodServiceAccount assumes an IAM role configured via a ServiceAccount.
 // https://docs.aws.amazon.com/eks/latest/userguide/iam-roles-for-service-accounts.html
-//
-// TODO(hasheddan): This should be replaced by the implementation of the Web
-// Identity Token Provider in the following PR after merge and subsequent
-// release of AWS SDK: https://github.com/aws/aws-sdk-go-v2/pull/488
 func UsePodServiceAccount(ctx context.Context, _ []byte, _, region string) (*aws.Config, error) {
+	return UseWebIdentity(ctx, &v1beta1.WebIdentityConfig{RoleARN: os.Getenv("AWS_ROLE_ARN")}, region)
+}
+
+// UseWebIdentity assumes the IAM role given in the supplied configuration
+// using the OIDC web identity token found in its token file.
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_providers_oidc.html
+func UseWebIdentity(ctx context.Context, w *v1beta1.WebIdentityConfig, region string) (*aws.Config, error) {
+	if w == nil {
+		return nil, errors.New(errWebIdentityConfigMissing)
+	}
 	cfg, err := external.LoadDefaultAWSConfig()
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load default AWS config")
 	}
 	cfg.Region = region
-	svc := sts.New(cfg)
+	p := stscreds.NewWebIdentityRoleProvider(sts.New(cfg), w.RoleARN, aws.StringValue(w.RoleSessionName),
+		stscreds.IdentityTokenFile(webIdentityTokenFile(w)), func(o *stscreds.WebIdentityRoleProviderOptions) {
+			o.ExpiryWindow = assumeRoleExpiryWindow
+		})
+	if _, err := p.Retrieve(ctx); err != nil {
+		return nil, errors.Wrapf(err, "cannot assume role %s", w.RoleARN)
+	}
+	cfg.Credentials = p
+	return &cfg, nil
+}
 
-	b, err := ioutil.ReadFile(os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE"))
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to read web identity token file in pod")
-	}
-	token := string(b)
-	sess := strconv.FormatInt(time.Now().UnixNano(), 10)
-	role := os.Getenv("AWS_ROLE_ARN")
-	resp, err := svc.AssumeRoleWithWebIdentityRequest(
-		&sts.AssumeRoleWithWebIdentityInput{
-			RoleSessionName:  &sess,
-			WebIdentityToken: &token,
-			RoleArn:          &role,
-		}).Send(ctx)
-	if err != nil {
-		return nil, err
+// webIdentityTokenFile returns the path of the web identity token file given
+// in the supplied configuration, falling back to the one that is injected
+// into the pod by the EKS pod identity webhook.
+func webIdentityTokenFile(w *v1beta1.WebIdentityConfig) string {
+	if w.TokenFile != nil {
+		return *w.TokenFile
 	}
-	creds := aws.Credentials{
-		AccessKeyID:     aws.StringValue(resp.Credentials.AccessKeyId),
-		SecretAccessKey: aws.StringValue(resp.Credentials.SecretAccessKey),
-		SessionToken:    aws.StringValue(resp.Credentials.SessionToken),
+	return os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE")
+}
+
+// UseAssumeRoleChain returns a copy of the supplied config whose credentials
+// are obtained by assuming the given IAM roles in order, each one using the
+// credentials of the previous one.
+func UseAssumeRoleChain(ctx context.Context, cfg *aws.Config, chain []v1beta1.AssumeRoleOptions) (*aws.Config, error) {
+	for _, o := range chain {
+		p := NewAssumeRoleCredentialsProvider(sts.New(*cfg), o)
+		if _, err := p.Retrieve(ctx); err != nil {
+			return nil, errors.Wrapf(err, "cannot assume role %s", o.RoleARN)
+		}
+		c := cfg.Copy()
+		c.Credentials = p
+		cfg = &c
 	}
-	shared := external.SharedConfig{
-		Credentials: creds,
-		Region:      region,
+	return cfg, nil
+}
+
+// NewAssumeRoleCredentialsProvider returns a credentials provider that assumes
+// the given IAM role and refreshes the credentials before they expire.
+func NewAssumeRoleCredentialsProvider(client stscreds.AssumeRoler, o v1beta1.AssumeRoleOptions) aws.CredentialsProvider {
+	p := &aws.SafeCredentialsProvider{}
+	p.RetrieveFn = func() (aws.Credentials, error) {
+		resp, err := client.AssumeRoleRequest(GenerateAssumeRoleInput(o)).Send(context.Background())
+		if err != nil {
+			return aws.Credentials{Source: stscreds.ProviderName}, err
+		}
+		return aws.Credentials{
+			AccessKeyID:     aws.StringValue(resp.Credentials.AccessKeyId),
+			SecretAccessKey: aws.StringValue(resp.Credentials.SecretAccessKey),
+			SessionToken:    aws.StringValue(resp.Credentials.SessionToken),
+			Source:          stscreds.ProviderName,
+			CanExpire:       true,
+			Expires:         aws.TimeValue(resp.Credentials.Expiration).Add(-assumeRoleExpiryWindow),
+		}, nil
+	}
+	return p
+}
+
+// GenerateAssumeRoleInput returns the input for the AssumeRole call described
+// by the given options.
+func GenerateAssumeRoleInput(o v1beta1.AssumeRoleOptions) *sts.AssumeRoleInput {
+	in := &sts.AssumeRoleInput{
+		RoleArn:           aws.String(o.RoleARN),
+		RoleSessionName:   o.RoleSessionName,
+		ExternalId:        o.ExternalID,
+		DurationSeconds:   aws.Int64(int64(DefaultAssumeRoleDuration / time.Second)),
+		TransitiveTagKeys: o.TransitiveTagKeys,
+	}
+	if in.RoleSessionName == nil {
+		in.RoleSessionName = aws.String(strconv.FormatInt(time.Now().UnixNano(), 10))
+	}
+	if o.Duration != nil {
+		in.DurationSeconds = aws.Int64(int64(o.Duration.Duration / time.Second))
+	}
+	if len(o.Tags) != 0 {
+		in.Tags = make([]sts.Tag, len(o.Tags))
+		for i, t := range o.Tags {
+			in.Tags[i] = sts.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+		}
 	}
-	config, err := external.LoadDefaultAWSConfig(shared)
-	return &config, err
+	return in
 }
 
 // NOTE(muvaf): ACK-generated controllers use aws/aws-sdk-go instead of
@@ -268,10 +404,60 @@ func UsePodServiceAccount(ctx context.Context, _ []byte, _, region string) (*aws
 
 // GetConfigV1 constructs an *awsv1.Config that can be used to authenticate to AWS
 // API by the AWSv1 clients.
-func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) { // nolint:gocyclo
-	if mg.GetProviderConfigReference() == nil {
-		return nil, errors.New("providerConfigRef cannot be empty")
+func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) {
+	switch {
+	case mg.GetProviderConfigReference() != nil:
+		return UseProviderConfigV1(ctx, c, mg, region)
+	case mg.GetProviderReference() != nil:
+		return UseProviderV1(ctx, c, mg, region)
+	default:
+		return nil, errors.New("neither providerConfigRef nor providerRef is given")
 	}
+}
+
+// UseProviderV1 to produce a session that can be used to authenticate to AWS.
+// Deprecated: Use UseProviderConfigV1.
+func UseProviderV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) {
+	p := &v1alpha3.Provider{}
+	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderReference().Name}, p); err != nil {
+		return nil, errors.Wrap(err, "cannot get referenced Provider")
+	}
+
+	if region == "" {
+		region = p.Spec.Region
+	}
+
+	var cfg *awsv1.Config
+	var err error
+	if aws.BoolValue(p.Spec.UseServiceAccount) {
+		cfg, err = UsePodServiceAccountV1(ctx, []byte{}, DefaultSection, region)
+		err = errors.Wrap(err, "cannot use pod service account")
+	} else {
+		if p.Spec.CredentialsSecretRef == nil {
+			return nil, errors.New("provider does not have a secret reference")
+		}
+		csr := p.Spec.CredentialsSecretRef
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: csr.Namespace, Name: csr.Name}, secret); err != nil {
+			return nil, errors.Wrap(err, "cannot get credentials secret")
+		}
+		cfg, err = UseProviderSecretV1(ctx, secret.Data[csr.Key], DefaultSection, region)
+		err = errors.Wrap(err, "cannot use secret")
+	}
+	if err != nil {
+		return nil, err
+	}
+	s, err := session.NewSession(SetResolverV1(ctx, mg, cfg))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create session")
+	}
+	s.Handlers.CompleteAttempt.PushBackNamed(NewMetricsHandlerV1(p.GetName()))
+	return s, nil
+}
+
+// UseProviderConfigV1 to produce a session that can be used to authenticate
+// to AWS.
+func UseProviderConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) { // nolint:gocyclo
 	pc := &v1beta1.ProviderConfig{}
 	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
 		return nil, errors.Wrap(err, "cannot get referenced ProviderConfig")
@@ -281,24 +467,60 @@ func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, regi
 	if err := t.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
-	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
-	case xpv1.CredentialsSourceInjectedIdentity:
-		cfg, err := UsePodServiceAccountV1(ctx, []byte{}, mg, DefaultSection, region)
-		if err != nil {
-			return nil, errors.Wrap(err, "cannot use pod service account")
-		}
-		return session.NewSession(cfg)
-	default:
-		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
+	data, err := getCredentialsData(ctx, c, pc)
+	if err != nil {
+		return nil, err
+	}
+	ca, err := getCABundleData(ctx, c, pc.Spec.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	key := configCacheKey{uid: pc.GetUID(), region: region}
+	sess, ok := sessions.get(key, pc.GetGeneration(), data, ca)
+	if !ok {
+		cfg, err := newConfigV1(ctx, c, pc, data, region)
 		if err != nil {
-			return nil, errors.Wrap(err, "cannot get credentials")
+			return nil, err
 		}
-		cfg, err := UseProviderSecretV1(ctx, data, mg, DefaultSection, region)
+		s, err := session.NewSession(cfg)
 		if err != nil {
-			return nil, errors.Wrap(err, "cannot use secret")
+			return nil, errors.Wrap(err, "cannot create session")
 		}
-		return session.NewSession(cfg)
+		s.Handlers.CompleteAttempt.PushBackNamed(NewMetricsHandlerV1(pc.GetName()))
+		AddAdaptiveRateLimitingV1(&s.Handlers, getAccountIDV1(ctx, s, pc.GetName()))
+		sessions.set(key, pc.GetGeneration(), s, data, ca)
+		sess = s
+	}
+	// NOTE: The endpoints given in the annotations of the managed resource
+	// take precedence over the ones in the ProviderConfig.
+	s := sess.(*session.Session)
+	return s.Copy(SetResolverV1(ctx, mg, s.Config.Copy())), nil
+}
+
+// newConfigV1 builds the *awsv1.Config described by the supplied
+// ProviderConfig using the given credentials data.
+func newConfigV1(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, data []byte, region string) (*awsv1.Config, error) {
+	var cfg *awsv1.Config
+	var err error
+	switch pc.Spec.Credentials.Source { //nolint:exhaustive
+	case xpv1.CredentialsSourceInjectedIdentity:
+		cfg, err = UsePodServiceAccountV1(ctx, data, DefaultSection, region)
+		err = errors.Wrap(err, "cannot use pod service account")
+	case v1beta1.CredentialsSourceWebIdentity:
+		cfg, err = UseWebIdentityV1(ctx, pc.Spec.Credentials.WebIdentity, region)
+		err = errors.Wrap(err, "cannot use web identity")
+	default:
+		cfg, err = UseProviderSecretV1(ctx, data, DefaultSection, region)
+		err = errors.Wrap(err, "cannot use secret")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := SetEndpointConfigV1(ctx, c, cfg, pc.Spec.Endpoint); err != nil {
+		return nil, errors.Wrap(err, "cannot set endpoint configuration")
 	}
+	cfg, err = UseAssumeRoleChainV1(ctx, cfg, pc.Spec.AssumeRoleChain)
+	return cfg, errors.Wrap(err, "cannot assume role chain")
 }
 
 // UseProviderSecretV1 retrieves AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY from
@@ -307,7 +529,7 @@ func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, regi
 // [default]
 // aws_access_key_id = <YOUR_ACCESS_KEY_ID>
 // aws_secret_access_key = <YOUR_SECRET_ACCESS_KEY>
-func UseProviderSecretV1(ctx context.Context, data []byte, mg resource.Managed, profile, region string) (*awsv1.Config, error) {
+func UseProviderSecretV1(_ context.Context, data []byte, profile, region string) (*awsv1.Config, error) {
 	config, err := ini.InsensitiveLoad(data)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot parse credentials secret")
@@ -330,41 +552,64 @@ func UseProviderSecretV1(ctx context.Context, data []byte, mg resource.Managed,
 	}
 
 	creds := credentials.NewStaticCredentials(accessKeyID.Value(), secretAccessKey.Value(), sessionToken.Value())
-	return SetResolverV1(ctx, mg, awsv1.NewConfig().WithCredentials(creds).WithRegion(region)), nil
+	return awsv1.NewConfig().WithCredentials(creds).WithRegion(region), nil
 }
 
 // UsePodServiceAccountV1 assumes an IAM role configured via a ServiceAccount.
 // https://docs.aws.amazon.com/eks/latest/userguide/iam-roles-for-service-accounts.html
-func UsePodServiceAccountV1(ctx context.Context, _ []byte, mg resource.Managed, _, region string) (*awsv1.Config, error) {
-	cfg, err := external.LoadDefaultAWSConfig()
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to load default AWS config")
-	}
-	cfg.Region = region
-	svc := sts.New(cfg)
+func UsePodServiceAccountV1(ctx context.Context, _ []byte, _, region string) (*awsv1.Config, error) {
+	return UseWebIdentityV1(ctx, &v1beta1.WebIdentityConfig{RoleARN: os.Getenv("AWS_ROLE_ARN")}, region)
+}
 
-	b, err := ioutil.ReadFile(os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE"))
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to read web identity token file in pod")
-	}
-	token := string(b)
-	sess := strconv.FormatInt(time.Now().UnixNano(), 10)
-	role := os.Getenv("AWS_ROLE_ARN")
-	resp, err := svc.AssumeRoleWithWebIdentityRequest(
-		&sts.AssumeRoleWithWebIdentityInput{
-			RoleSessionName:  &sess,
-			WebIdentityToken: &token,
-			RoleArn:          &role,
-		}).Send(ctx)
+// UseWebIdentityV1 assumes the IAM role given in the supplied configuration
+// using the OIDC web identity token found in its token file and produces a
+// *awsv1.Config
+func UseWebIdentityV1(ctx context.Context, w *v1beta1.WebIdentityConfig, region string) (*awsv1.Config, error) {
+	if w == nil {
+		return nil, errors.New(errWebIdentityConfigMissing)
+	}
+	sess, err := session.NewSession(awsv1.NewConfig().WithRegion(region))
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "cannot create session")
+	}
+	p := stscredsv1.NewWebIdentityRoleProvider(stsv1.New(sess), w.RoleARN, awsv1.StringValue(w.RoleSessionName), webIdentityTokenFile(w))
+	p.ExpiryWindow = assumeRoleExpiryWindow
+	creds := credentials.NewCredentials(p)
+	if _, err := creds.GetWithContext(ctx); err != nil {
+		return nil, errors.Wrapf(err, "cannot assume role %s", w.RoleARN)
 	}
-	creds := credentials.NewStaticCredentials(
-		aws.StringValue(resp.Credentials.AccessKeyId),
-		aws.StringValue(resp.Credentials.SecretAccessKey),
-		aws.StringValue(resp.Credentials.SessionToken))
+	return awsv1.NewConfig().WithCredentials(creds).WithRegion(region), nil
+}
 
-	return SetResolverV1(ctx, mg, awsv1.NewConfig().WithCredentials(creds).WithRegion(region)), nil
+// UseAssumeRoleChainV1 returns a copy of the supplied V1 config whose
+// credentials are obtained by assuming the given IAM roles in order, each one
+// using the credentials of the previous one.
+func UseAssumeRoleChainV1(ctx context.Context, cfg *awsv1.Config, chain []v1beta1.AssumeRoleOptions) (*awsv1.Config, error) {
+	for _, o := range chain {
+		sess, err := session.NewSession(cfg)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot create session")
+		}
+		creds := stscredsv1.NewCredentials(sess, o.RoleARN, func(p *stscredsv1.AssumeRoleProvider) {
+			p.ExternalID = o.ExternalID
+			p.RoleSessionName = awsv1.StringValue(o.RoleSessionName)
+			p.ExpiryWindow = assumeRoleExpiryWindow
+			if o.Duration != nil {
+				p.Duration = o.Duration.Duration
+			}
+			for _, t := range o.Tags {
+				p.Tags = append(p.Tags, &stsv1.Tag{Key: awsv1.String(t.Key), Value: awsv1.String(t.Value)})
+			}
+			if len(o.TransitiveTagKeys) != 0 {
+				p.TransitiveTagKeys = awsv1.StringSlice(o.TransitiveTagKeys)
+			}
+		})
+		if _, err := creds.GetWithContext(ctx); err != nil {
+			return nil, errors.Wrapf(err, "cannot assume role %s", o.RoleARN)
+		}
+		cfg = cfg.Copy().WithCredentials(creds)
+	}
+	return cfg, nil
 }
 
 // SetResolverV1 parses annotations from the managed resource
@@ -379,12 +624,16 @@ func SetResolverV1(ctx context.Context, mg resource.Managed, cfg *awsv1.Config)
 				endpoint.SigningRegion = Region
 			}
 
+			defaultResolver := endpointsv1.DefaultResolver()
+			if cfg.EndpointResolver != nil {
+				defaultResolver = cfg.EndpointResolver
+			}
 			endpointResolver := func(service, region string, optFns ...func(*endpointsv1.Options)) (endpointsv1.ResolvedEndpoint, error) {
 				if strings.Contains(ServiceID, service) {
 					return endpoint, nil
 				}
 
-				return endpointsv1.DefaultResolver().EndpointFor(service, region, optFns...)
+				return defaultResolver.EndpointFor(service, region, optFns...)
 			}
 			cfg.EndpointResolver = endpointsv1.ResolverFunc(endpointResolver)
 		}
@@ -578,6 +827,88 @@ func CompactAndEscapeJSON(s string) (string, error) {
 	return url.QueryEscape(buffer.String()), nil
 }
 
+// GetDefaultTags returns the default tags of the ProviderConfig that the
+// supplied managed resource references.
+func GetDefaultTags(ctx context.Context, c client.Client, mg resource.Managed) (map[string]string, error) {
+	if mg.GetProviderConfigReference() == nil {
+		return nil, nil
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, "cannot get referenced ProviderConfig")
+	}
+	return pc.Spec.DefaultTags, nil
+}
+
+// AnnotationKeyDefaultTags is the annotation that records the default tags
+// of the ProviderConfig that were added to the tags of a managed resource, so
+// that they can be updated or removed once the default tags change.
+const AnnotationKeyDefaultTags = "aws.crossplane.io/default-tags"
+
+// MergeTags returns the tags that should be set in the spec of the supplied
+// managed resource. The default tags of its ProviderConfig are overridden by
+// the given tags of the resource, which are in turn overridden by the
+// external tags that Crossplane adds to every resource. The default tags that
+// were added are recorded on the resource, so that the ones that are changed
+// or removed from the ProviderConfig are updated or removed later, unless the
+// resource has given them another value since.
+func MergeTags(ctx context.Context, c client.Client, mg resource.Managed, tags map[string]string) (map[string]string, error) {
+	return mergeTags(ctx, c, mg, tags, false)
+}
+
+// MergeTagsIfSet is like MergeTags but returns nil if the supplied tags are
+// empty and there are no default tags to add or remove, so that the tags of
+// a resource that are managed outside of Crossplane are left untouched.
+func MergeTagsIfSet(ctx context.Context, c client.Client, mg resource.Managed, tags map[string]string) (map[string]string, error) {
+	return mergeTags(ctx, c, mg, tags, true)
+}
+
+func mergeTags(ctx context.Context, c client.Client, mg resource.Managed, tags map[string]string, ifSet bool) (map[string]string, error) { // nolint:gocyclo
+	defaults, err := GetDefaultTags(ctx, c, mg)
+	if err != nil {
+		return nil, err
+	}
+	applied := map[string]string{}
+	if a, ok := mg.GetAnnotations()[AnnotationKeyDefaultTags]; ok {
+		if err := json.Unmarshal([]byte(a), &applied); err != nil {
+			return nil, errors.Wrap(err, "cannot parse the default tags annotation")
+		}
+	}
+	if ifSet && len(tags) == 0 && len(defaults) == 0 && len(applied) == 0 {
+		return nil, nil
+	}
+	merged := make(map[string]string, len(defaults)+len(tags))
+	for k, v := range tags {
+		// A default tag that still has the value it was added with is removed
+		// here and added again below if it is still a default tag.
+		if av, ok := applied[k]; ok && av == v {
+			continue
+		}
+		merged[k] = v
+	}
+	added := make(map[string]string, len(defaults))
+	for k, v := range defaults {
+		if _, ok := merged[k]; !ok {
+			merged[k] = v
+			added[k] = v
+		}
+	}
+	for k, v := range resource.GetExternalTags(mg) {
+		merged[k] = v
+		delete(added, k)
+	}
+	if len(added) == 0 {
+		meta.RemoveAnnotations(mg, AnnotationKeyDefaultTags)
+		return merged, nil
+	}
+	a, err := json.Marshal(added)
+	if err != nil {
+		return nil, err
+	}
+	meta.AddAnnotations(mg, map[string]string{AnnotationKeyDefaultTags: string(a)})
+	return merged, nil
+}
+
 // DiffTags returns tags that should be added or removed.
 func DiffTags(local, remote map[string]string) (add map[string]string, remove []string) {
 	add = make(map[string]string, len(local))
@@ -19,13 +19,30 @@ package aws
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1alpha3"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 const (
@@ -76,6 +93,432 @@ func TestUseProviderSecret(t *testing.T) {
 	g.Expect(config).NotTo(BeNil())
 }
 
+func TestGetConfigV1(t *testing.T) {
+	credentials := []byte(fmt.Sprintf(awsCredentialsFileFormat, "default", "testID", "testSecret"))
+	secretRef := &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "creds", Namespace: "crossplane-system"},
+		Key:             "credentials",
+	}
+	withProviderRef := &fake.Managed{ProviderReferencer: fake.ProviderReferencer{Ref: &xpv1.Reference{Name: "example"}}}
+
+	type want struct {
+		region string
+		id     string
+		err    error
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		mg   resource.Managed
+		want want
+	}{
+		"NoReference": {
+			mg: &fake.Managed{},
+			want: want{
+				err: errors.New("neither providerConfigRef nor providerRef is given"),
+			},
+		},
+		"ProviderRef": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					switch o := obj.(type) {
+					case *v1alpha3.Provider:
+						o.Spec.Region = "us-west-2"
+						o.Spec.CredentialsSecretRef = secretRef
+					case *corev1.Secret:
+						o.Data = map[string][]byte{secretRef.Key: credentials}
+					}
+					return nil
+				}),
+			},
+			mg: withProviderRef,
+			want: want{
+				region: "us-west-2",
+				id:     "testID",
+			},
+		},
+		"ProviderRefWithoutSecretRef": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			mg:   withProviderRef,
+			want: want{
+				err: errors.New("provider does not have a secret reference"),
+			},
+		},
+		"GetProviderFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg:   withProviderRef,
+			want: want{
+				err: errors.Wrap(errBoom, "cannot get referenced Provider"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			sess, err := GetConfigV1(context.Background(), tc.kube, tc.mg, "")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("GetConfigV1(...): -want error, +got error:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.region, *sess.Config.Region); diff != "" {
+				t.Errorf("GetConfigV1(...): -want region, +got region:\n%s", diff)
+			}
+			creds, err := sess.Config.Credentials.Get()
+			if err != nil {
+				t.Fatalf("Credentials.Get(): %s", err)
+			}
+			if diff := cmp.Diff(tc.want.id, creds.AccessKeyID); diff != "" {
+				t.Errorf("GetConfigV1(...): -want access key ID, +got access key ID:\n%s", diff)
+			}
+		})
+	}
+}
+
+type fakeAssumeRoler struct {
+	in  *sts.AssumeRoleInput
+	out *sts.AssumeRoleOutput
+	err error
+}
+
+func (f *fakeAssumeRoler) AssumeRoleRequest(in *sts.AssumeRoleInput) sts.AssumeRoleRequest {
+	f.in = in
+	return sts.AssumeRoleRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: f.out, Error: f.err},
+	}
+}
+
+func TestGenerateAssumeRoleInput(t *testing.T) {
+	arn := "arn:aws:iam::123456789012:role/crossplane"
+	session := "crossplane"
+	external := "external"
+
+	cases := map[string]struct {
+		in   v1beta1.AssumeRoleOptions
+		want *sts.AssumeRoleInput
+	}{
+		"Defaults": {
+			in: v1beta1.AssumeRoleOptions{RoleARN: arn, RoleSessionName: &session},
+			want: &sts.AssumeRoleInput{
+				RoleArn:         &arn,
+				RoleSessionName: &session,
+				DurationSeconds: aws.Int64(900),
+			},
+		},
+		"AllFields": {
+			in: v1beta1.AssumeRoleOptions{
+				RoleARN:           arn,
+				RoleSessionName:   &session,
+				ExternalID:        &external,
+				Duration:          &metav1.Duration{Duration: time.Hour},
+				Tags:              []v1beta1.Tag{{Key: "team", Value: "platform"}},
+				TransitiveTagKeys: []string{"team"},
+			},
+			want: &sts.AssumeRoleInput{
+				RoleArn:           &arn,
+				RoleSessionName:   &session,
+				ExternalId:        &external,
+				DurationSeconds:   aws.Int64(3600),
+				Tags:              []sts.Tag{{Key: aws.String("team"), Value: aws.String("platform")}},
+				TransitiveTagKeys: []string{"team"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateAssumeRoleInput(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateAssumeRoleInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewAssumeRoleCredentialsProvider(t *testing.T) {
+	errBoom := errors.New("boom")
+	expiration := time.Now().Add(time.Hour)
+
+	cases := map[string]struct {
+		client *fakeAssumeRoler
+		want   aws.Credentials
+		err    error
+	}{
+		"Successful": {
+			client: &fakeAssumeRoler{out: &sts.AssumeRoleOutput{Credentials: &sts.Credentials{
+				AccessKeyId:     aws.String("id"),
+				SecretAccessKey: aws.String("secret"),
+				SessionToken:    aws.String("token"),
+				Expiration:      &expiration,
+			}}},
+			want: aws.Credentials{
+				AccessKeyID:     "id",
+				SecretAccessKey: "secret",
+				SessionToken:    "token",
+				Source:          "AssumeRoleProvider",
+				CanExpire:       true,
+				Expires:         expiration.Add(-assumeRoleExpiryWindow),
+			},
+		},
+		"Failed": {
+			client: &fakeAssumeRoler{err: errBoom},
+			want:   aws.Credentials{Source: "AssumeRoleProvider"},
+			err:    errBoom,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := NewAssumeRoleCredentialsProvider(tc.client, v1beta1.AssumeRoleOptions{RoleARN: "arn"})
+			got, err := p.Retrieve(context.Background())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if aws.StringValue(tc.client.in.RoleArn) != "arn" {
+				t.Errorf("AssumeRoleRequest(...): unexpected role ARN %s", aws.StringValue(tc.client.in.RoleArn))
+			}
+		})
+	}
+}
+
+func TestWebIdentityTokenFile(t *testing.T) {
+	if err := os.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", "/var/run/secrets/eks.amazonaws.com/serviceaccount/token"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Unsetenv("AWS_WEB_IDENTITY_TOKEN_FILE") // nolint:errcheck
+
+	cases := map[string]struct {
+		in   *v1beta1.WebIdentityConfig
+		want string
+	}{
+		"TokenFileGiven": {
+			in:   &v1beta1.WebIdentityConfig{TokenFile: aws.String("/var/run/secrets/tokens/crossplane")},
+			want: "/var/run/secrets/tokens/crossplane",
+		},
+		"FallbackToEnvironment": {
+			in:   &v1beta1.WebIdentityConfig{},
+			want: "/var/run/secrets/eks.amazonaws.com/serviceaccount/token",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, webIdentityTokenFile(tc.in)); diff != "" {
+				t.Errorf("webIdentityTokenFile(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetDefaultTags(t *testing.T) {
+	defaults := map[string]string{"team": "platform"}
+
+	type args struct {
+		kube client.Client
+		mg   resource.Managed
+	}
+	type want struct {
+		tags map[string]string
+		err  error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoProviderConfigReference": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{},
+		},
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						pc := obj.(*v1beta1.ProviderConfig)
+						pc.Spec.DefaultTags = defaults
+						return nil
+					}),
+				},
+				mg: &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "example"}}},
+			},
+			want: want{
+				tags: defaults,
+			},
+		},
+		"GetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				mg: &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "example"}}},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, "cannot get referenced ProviderConfig"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetDefaultTags(context.Background(), tc.args.kube, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tags, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMergeTags(t *testing.T) {
+	managed := func(defaultTags string) *fake.Managed {
+		mg := &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "example"}}}
+		mg.SetName("example")
+		if defaultTags != "" {
+			meta.AddAnnotations(mg, map[string]string{AnnotationKeyDefaultTags: defaultTags})
+		}
+		return mg
+	}
+	external := resource.GetExternalTags(managed(""))
+	withExternal := func(tags map[string]string) map[string]string {
+		for k, v := range external {
+			tags[k] = v
+		}
+		return tags
+	}
+
+	withDefaults := func(defaults map[string]string) client.Client {
+		return &test.MockClient{
+			MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				obj.(*v1beta1.ProviderConfig).Spec.DefaultTags = defaults
+				return nil
+			}),
+		}
+	}
+
+	type args struct {
+		kube        client.Client
+		defaultTags string
+		tags        map[string]string
+		ifSet       bool
+	}
+	type want struct {
+		tags        map[string]string
+		defaultTags string
+		err         error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ResourceTagsOverrideDefaults": {
+			args: args{
+				kube: withDefaults(map[string]string{"team": "platform", "env": "dev"}),
+				tags: map[string]string{"env": "prod"},
+			},
+			want: want{
+				tags:        withExternal(map[string]string{"team": "platform", "env": "prod"}),
+				defaultTags: `{"team":"platform"}`,
+			},
+		},
+		"ExternalTagsOverrideAll": {
+			args: args{
+				kube: withDefaults(map[string]string{resource.ExternalResourceTagKeyName: "default"}),
+				tags: map[string]string{resource.ExternalResourceTagKeyName: "resource"},
+			},
+			want: want{
+				tags: external,
+			},
+		},
+		"ChangedDefaultsAreUpdated": {
+			args: args{
+				kube:        withDefaults(map[string]string{"team": "platform", "env": "staging"}),
+				defaultTags: `{"team":"platform","env":"dev","old":"tag"}`,
+				tags:        map[string]string{"team": "platform", "env": "dev", "old": "tag", "app": "web"},
+			},
+			want: want{
+				tags:        withExternal(map[string]string{"team": "platform", "env": "staging", "app": "web"}),
+				defaultTags: `{"env":"staging","team":"platform"}`,
+			},
+		},
+		"OverriddenDefaultsAreKept": {
+			args: args{
+				kube:        withDefaults(map[string]string{"team": "platform"}),
+				defaultTags: `{"team":"platform","old":"tag"}`,
+				tags:        map[string]string{"team": "web", "old": "mine"},
+			},
+			want: want{
+				tags: withExternal(map[string]string{"team": "web", "old": "mine"}),
+			},
+		},
+		"NothingToMerge": {
+			args: args{
+				kube:  withDefaults(nil),
+				ifSet: true,
+			},
+		},
+		"RemovedDefaultsAreMerged": {
+			args: args{
+				kube:        withDefaults(nil),
+				defaultTags: `{"team":"platform"}`,
+				tags:        map[string]string{"team": "platform"},
+				ifSet:       true,
+			},
+			want: want{
+				tags: external,
+			},
+		},
+		"InvalidAnnotation": {
+			args: args{
+				kube:        withDefaults(nil),
+				defaultTags: "{",
+			},
+			want: want{
+				err: errors.Wrap(errors.New("unexpected end of JSON input"), "cannot parse the default tags annotation"),
+			},
+		},
+		"GetDefaultTagsFailed": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, "cannot get referenced ProviderConfig"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := managed(tc.args.defaultTags)
+			merge := MergeTags
+			if tc.args.ifSet {
+				merge = MergeTagsIfSet
+			}
+			got, err := merge(context.Background(), tc.args.kube, mg, tc.args.tags)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tags, got); diff != "" {
+				t.Errorf("tags: -want, +got:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.defaultTags, mg.GetAnnotations()[AnnotationKeyDefaultTags]); diff != "" {
+				t.Errorf("default tags: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestDiffTags(t *testing.T) {
 	type args struct {
 		local  map[string]string
@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Building a config requires extracting and parsing the
+// credentials and, for the roles that are assumed, calls to STS. The built
+// configs are cached so that this is done once per ProviderConfig instead of
+// on every reconcile. The credentials providers of the cached configs refresh
+// the temporary credentials of the assumed roles before they expire.
+
+var (
+	// configs caches the *aws.Config built for ProviderConfigs.
+	configs = newConfigCache()
+
+	// sessions caches the *session.Session built for ProviderConfigs.
+	sessions = newConfigCache()
+)
+
+// EvictConfigs removes the configs and sessions cached for the
+// ProviderConfig with the given UID. It should be called once the
+// ProviderConfig is deleted.
+func EvictConfigs(uid types.UID) {
+	configs.evict(uid)
+	sessions.evict(uid)
+}
+
+// configCacheKey identifies the config built for a ProviderConfig in a
+// region.
+type configCacheKey struct {
+	uid    types.UID
+	region string
+}
+
+type configCacheEntry struct {
+	generation int64
+	checksum   [sha256.Size]byte
+	value      interface{}
+}
+
+// configCache stores the configs built for ProviderConfigs. A cached config
+// is valid as long as neither the spec of the ProviderConfig, as tracked by
+// its generation, nor the data it was built with, such as the credentials
+// and the CA bundle, change. ProviderConfigs without a UID are never cached.
+type configCache struct {
+	mu      sync.RWMutex
+	entries map[configCacheKey]configCacheEntry
+}
+
+func newConfigCache() *configCache {
+	return &configCache{entries: map[configCacheKey]configCacheEntry{}}
+}
+
+// get returns the config cached for the given key if it was built with the
+// given generation of the ProviderConfig and data. A config that was built
+// with a previous generation or data is evicted.
+func (c *configCache) get(key configCacheKey, generation int64, data ...[]byte) (interface{}, bool) {
+	if key.uid == "" {
+		return nil, false
+	}
+	c.mu.RLock()
+	e, ok := c.entries[key]
+	c.mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	if e.generation != generation || e.checksum != checksum(data...) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		// The entry may have been replaced by an up to date one while we did
+		// not hold the lock.
+		if cur, ok := c.entries[key]; ok && cur.generation == e.generation && cur.checksum == e.checksum {
+			delete(c.entries, key)
+		}
+		return nil, false
+	}
+	return e.value, true
+}
+
+// set caches the config built for the given key with the given generation of
+// the ProviderConfig and data, replacing the one built with any previous
+// generation or data.
+func (c *configCache) set(key configCacheKey, generation int64, v interface{}, data ...[]byte) {
+	if key.uid == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = configCacheEntry{
+		generation: generation,
+		checksum:   checksum(data...),
+		value:      v,
+	}
+}
+
+// evict removes the configs cached for all regions of the ProviderConfig with
+// the given UID.
+func (c *configCache) evict(uid types.UID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k := range c.entries {
+		if k.uid == uid {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// checksum returns the SHA-256 checksum of the supplied data. Every element
+// is prefixed with its length so that moving bytes from one element to the
+// next results in a different checksum.
+func checksum(data ...[]byte) [sha256.Size]byte {
+	h := sha256.New()
+	l := make([]byte, 8)
+	for _, d := range data {
+		binary.BigEndian.PutUint64(l, uint64(len(d)))
+		_, _ = h.Write(l)
+		_, _ = h.Write(d)
+	}
+	var sum [sha256.Size]byte
+	copy(sum[:], h.Sum(nil))
+	return sum
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConfigCache(t *testing.T) {
+	key := configCacheKey{uid: "uid", region: "us-east-1"}
+	data := []byte("[default]\naws_access_key_id = id")
+	ca := []byte("-----BEGIN CERTIFICATE-----")
+
+	type args struct {
+		key        configCacheKey
+		generation int64
+		data       [][]byte
+	}
+	type want struct {
+		value   interface{}
+		ok      bool
+		evicted bool
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Hit": {
+			args: args{key: key, generation: 1, data: [][]byte{data, ca}},
+			want: want{value: "cached", ok: true},
+		},
+		"OtherRegion": {
+			args: args{key: configCacheKey{uid: "uid", region: "eu-west-1"}, generation: 1, data: [][]byte{data, ca}},
+		},
+		"ProviderConfigChanged": {
+			args: args{key: key, generation: 2, data: [][]byte{data, ca}},
+			want: want{evicted: true},
+		},
+		"CredentialsChanged": {
+			args: args{key: key, generation: 1, data: [][]byte{[]byte("[default]\naws_access_key_id = rotated"), ca}},
+			want: want{evicted: true},
+		},
+		"CABundleChanged": {
+			args: args{key: key, generation: 1, data: [][]byte{data, []byte("-----BEGIN CERTIFICATE-----\n")}},
+			want: want{evicted: true},
+		},
+		"CABundleRemoved": {
+			args: args{key: key, generation: 1, data: [][]byte{data, nil}},
+			want: want{evicted: true},
+		},
+		"NoUID": {
+			args: args{key: configCacheKey{region: "us-east-1"}, generation: 1, data: [][]byte{data, ca}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newConfigCache()
+			c.set(key, 1, "cached", data, ca)
+			c.set(configCacheKey{region: "us-east-1"}, 1, "cached", data, ca)
+			v, ok := c.get(tc.args.key, tc.args.generation, tc.args.data...)
+			if diff := cmp.Diff(tc.want.value, v); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ok, ok); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			_, cached := c.entries[key]
+			if diff := cmp.Diff(tc.want.evicted, !cached); diff != "" {
+				t.Errorf("evicted: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigCacheEvict(t *testing.T) {
+	data := []byte("[default]\naws_access_key_id = id")
+
+	c := newConfigCache()
+	c.set(configCacheKey{uid: "uid", region: "us-east-1"}, 1, "cached", data)
+	c.set(configCacheKey{uid: "uid", region: "eu-west-1"}, 1, "cached", data)
+	c.set(configCacheKey{uid: "other", region: "us-east-1"}, 1, "cached", data)
+	c.evict("uid")
+
+	want := map[configCacheKey]bool{{uid: "other", region: "us-east-1"}: true}
+	got := map[configCacheKey]bool{}
+	for k := range c.entries {
+		got[k] = true
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(configCacheKey{})); diff != "" {
+		t.Errorf("evict(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TypeDrifted resources differ from their external resource in AWS, for
+// example because the external resource was changed outside of Crossplane.
+const TypeDrifted xpv1.ConditionType = "Drifted"
+
+// Reasons a resource is or is not drifted.
+const (
+	ReasonDriftDetected xpv1.ConditionReason = "DriftDetected"
+	ReasonNoDrift       xpv1.ConditionReason = "NoDrift"
+)
+
+const reasonDriftDetected event.Reason = "DriftDetected"
+
+// forProviderPath is the path that drifted fields are reported relative to.
+const forProviderPath = "spec.forProvider"
+
+// DriftedFields returns the paths of the fields that differ between the
+// supplied desired parameters and the parameters observed in AWS, in
+// alphabetical order. Fields that are not set in the desired parameters are
+// not considered drifted, and neither are references, selectors and the
+// supplied ignored fields, which are given by their JSON path relative to the
+// parameters, e.g. resourcesVpcConfig.subnetIds.
+func DriftedFields(observed, desired interface{}, ignore ...string) ([]string, error) {
+	patch, err := CreateJSONPatch(observed, desired)
+	if err != nil {
+		return nil, err
+	}
+	m := map[string]interface{}{}
+	if err := json.Unmarshal(patch, &m); err != nil {
+		return nil, err
+	}
+	ignored := make(map[string]bool, len(ignore))
+	for _, i := range ignore {
+		ignored[i] = true
+	}
+	fields := driftedFields(m, "", ignored)
+	sort.Strings(fields)
+	return fields, nil
+}
+
+func driftedFields(patch map[string]interface{}, prefix string, ignored map[string]bool) []string {
+	var fields []string
+	for k, v := range patch {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		if v == nil || ignored[path] || strings.HasSuffix(k, "Ref") || strings.HasSuffix(k, "Refs") || strings.HasSuffix(k, "Selector") {
+			continue
+		}
+		if nested, ok := v.(map[string]interface{}); ok {
+			fields = append(fields, driftedFields(nested, path, ignored)...)
+			continue
+		}
+		fields = append(fields, path)
+	}
+	return fields
+}
+
+// Drifted returns a condition that indicates the supplied fields of the
+// resource differ from its external resource.
+func Drifted(fields []string) xpv1.Condition {
+	paths := make([]string, len(fields))
+	for i, f := range fields {
+		paths[i] = forProviderPath + "." + f
+	}
+	return xpv1.Condition{
+		Type:               TypeDrifted,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDriftDetected,
+		Message:            "Fields differ from the external resource: " + strings.Join(paths, ", "),
+	}
+}
+
+// NoDrift returns a condition that indicates the resource no longer differs
+// from its external resource.
+func NoDrift() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDrifted,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoDrift,
+	}
+}
+
+// RecordDrift reports the supplied drifted fields of the supplied managed
+// resource in its Drifted condition and, if they changed since the last
+// report, in an event. A resource without drifted fields that was previously
+// reported as drifted is reported as no longer drifted. The recorder may be
+// nil, in which case no event is recorded.
+func RecordDrift(r event.Recorder, mg resource.Managed, fields []string) {
+	if len(fields) == 0 {
+		if mg.GetCondition(TypeDrifted).Status == corev1.ConditionTrue {
+			mg.SetConditions(NoDrift())
+		}
+		return
+	}
+	c := Drifted(fields)
+	if prev := mg.GetCondition(TypeDrifted); prev.Status == c.Status && prev.Message == c.Message {
+		return
+	}
+	mg.SetConditions(c)
+	if r != nil {
+		r.Event(mg, event.Normal(reasonDriftDetected, c.Message))
+	}
+}
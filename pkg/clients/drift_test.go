@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type driftParams struct {
+	Region      string            `json:"region"`
+	Version     *string           `json:"version,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Subnets     []string          `json:"subnets,omitempty"`
+	SubnetRefs  []xpv1.Reference  `json:"subnetRefs,omitempty"`
+	Description *string           `json:"description,omitempty"`
+	Nested      *driftParams      `json:"nested,omitempty"`
+}
+
+func TestDriftedFields(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		observed driftParams
+		desired  driftParams
+		ignore   []string
+		want     []string
+	}{
+		"NoDrift": {
+			reason:   "Equal parameters should not be drifted.",
+			observed: driftParams{Version: String("1.18")},
+			desired:  driftParams{Version: String("1.18")},
+		},
+		"Drifted": {
+			reason:   "Fields that differ should be reported in alphabetical order.",
+			observed: driftParams{Version: String("1.17"), Subnets: []string{"a"}, Labels: map[string]string{"a": "b"}},
+			desired:  driftParams{Version: String("1.18"), Subnets: []string{"a", "b"}, Labels: map[string]string{"a": "c"}},
+			want:     []string{"labels.a", "subnets", "version"},
+		},
+		"Nested": {
+			reason:   "Nested fields should be reported by their path.",
+			observed: driftParams{Nested: &driftParams{Version: String("1.17")}},
+			desired:  driftParams{Nested: &driftParams{Version: String("1.18")}},
+			want:     []string{"nested.version"},
+		},
+		"UnsetDesired": {
+			reason:   "Fields that are not set in the desired parameters should not be drifted.",
+			observed: driftParams{Description: String("console")},
+		},
+		"Ignored": {
+			reason:  "References and ignored fields should not be drifted.",
+			desired: driftParams{Region: "us-east-1", SubnetRefs: []xpv1.Reference{{Name: "a"}}},
+			ignore:  []string{"region"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := DriftedFields(tc.observed, tc.desired, tc.ignore...)
+			if err != nil {
+				t.Fatalf("DriftedFields(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nDriftedFields(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+type countingRecorder struct {
+	events int
+}
+
+func (r *countingRecorder) Event(_ runtime.Object, _ event.Event) { r.events++ }
+
+func (r *countingRecorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+func TestRecordDrift(t *testing.T) {
+	type want struct {
+		c      xpv1.Condition
+		events int
+	}
+	cases := map[string]struct {
+		reason string
+		c      []xpv1.Condition
+		fields []string
+		want   want
+	}{
+		"Drifted": {
+			reason: "Drifted fields should be reported in a condition and an event.",
+			fields: []string{"version"},
+			want:   want{c: Drifted([]string{"version"}), events: 1},
+		},
+		"StillDrifted": {
+			reason: "Fields that were already reported should not be reported in another event.",
+			c:      []xpv1.Condition{Drifted([]string{"version"})},
+			fields: []string{"version"},
+			want:   want{c: Drifted([]string{"version"})},
+		},
+		"NoLongerDrifted": {
+			reason: "A resource that is no longer drifted should be reported as such.",
+			c:      []xpv1.Condition{Drifted([]string{"version"})},
+			want:   want{c: NoDrift()},
+		},
+		"NeverDrifted": {
+			reason: "A resource that was never drifted should not have a Drifted condition.",
+			want:   want{c: xpv1.Condition{Type: TypeDrifted, Status: "Unknown"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			mg.SetConditions(tc.c...)
+			r := &countingRecorder{}
+			RecordDrift(r, mg, tc.fields)
+			got := want{c: mg.GetCondition(TypeDrifted), events: r.events}
+			if diff := cmp.Diff(tc.want, got, test.EquateConditions(), cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nRecordDrift(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
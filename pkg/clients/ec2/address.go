@@ -1,11 +1,15 @@
 package ec2
 
 import (
+	"context"
 	"sort"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
@@ -120,6 +124,29 @@ func CompareTags(tags []v1beta1.Tag, ec2Tags []ec2.Tag) bool {
 	return true
 }
 
+// MergeTags returns the supplied tags merged with the default tags of the
+// ProviderConfig of the supplied managed resource and the external tags that
+// Crossplane adds to every resource, sorted by key. It returns nil if there
+// is nothing to merge; see awsclients.MergeTagsIfSet.
+func MergeTags(ctx context.Context, kube client.Client, mg resource.Managed, tags []v1beta1.Tag) ([]v1beta1.Tag, error) {
+	tagMap := make(map[string]string, len(tags))
+	for _, t := range tags {
+		tagMap[t.Key] = t.Value
+	}
+	tagMap, err := awsclients.MergeTagsIfSet(ctx, kube, mg, tagMap)
+	if err != nil || tagMap == nil {
+		return nil, err
+	}
+	res := make([]v1beta1.Tag, 0, len(tagMap))
+	for k, v := range tagMap {
+		res = append(res, v1beta1.Tag{Key: k, Value: v})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Key < res[j].Key
+	})
+	return res, nil
+}
+
 // SortTags sorts array of v1beta1.Tag and ec2.Tag on 'Key'
 func SortTags(tags []v1beta1.Tag, ec2Tags []ec2.Tag) {
 	sort.Slice(tags, func(i, j int) bool {
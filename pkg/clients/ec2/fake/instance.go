@@ -0,0 +1,90 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.InstanceClient = (*MockInstanceClient)(nil)
+
+// MockInstanceClient is a type that implements all the methods for InstanceClient interface
+type MockInstanceClient struct {
+	MockRun                   func(*ec2.RunInstancesInput) ec2.RunInstancesRequest
+	MockDescribe              func(*ec2.DescribeInstancesInput) ec2.DescribeInstancesRequest
+	MockDescribeAttribute     func(*ec2.DescribeInstanceAttributeInput) ec2.DescribeInstanceAttributeRequest
+	MockTerminate             func(*ec2.TerminateInstancesInput) ec2.TerminateInstancesRequest
+	MockStop                  func(*ec2.StopInstancesInput) ec2.StopInstancesRequest
+	MockStart                 func(*ec2.StartInstancesInput) ec2.StartInstancesRequest
+	MockModifyAttribute       func(*ec2.ModifyInstanceAttributeInput) ec2.ModifyInstanceAttributeRequest
+	MockModifyMetadataOptions func(*ec2.ModifyInstanceMetadataOptionsInput) ec2.ModifyInstanceMetadataOptionsRequest
+	MockCreateTags            func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags            func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// RunInstancesRequest mocks RunInstancesRequest method
+func (m *MockInstanceClient) RunInstancesRequest(input *ec2.RunInstancesInput) ec2.RunInstancesRequest {
+	return m.MockRun(input)
+}
+
+// DescribeInstancesRequest mocks DescribeInstancesRequest method
+func (m *MockInstanceClient) DescribeInstancesRequest(input *ec2.DescribeInstancesInput) ec2.DescribeInstancesRequest {
+	return m.MockDescribe(input)
+}
+
+// DescribeInstanceAttributeRequest mocks DescribeInstanceAttributeRequest method
+func (m *MockInstanceClient) DescribeInstanceAttributeRequest(input *ec2.DescribeInstanceAttributeInput) ec2.DescribeInstanceAttributeRequest {
+	return m.MockDescribeAttribute(input)
+}
+
+// TerminateInstancesRequest mocks TerminateInstancesRequest method
+func (m *MockInstanceClient) TerminateInstancesRequest(input *ec2.TerminateInstancesInput) ec2.TerminateInstancesRequest {
+	return m.MockTerminate(input)
+}
+
+// StopInstancesRequest mocks StopInstancesRequest method
+func (m *MockInstanceClient) StopInstancesRequest(input *ec2.StopInstancesInput) ec2.StopInstancesRequest {
+	return m.MockStop(input)
+}
+
+// StartInstancesRequest mocks StartInstancesRequest method
+func (m *MockInstanceClient) StartInstancesRequest(input *ec2.StartInstancesInput) ec2.StartInstancesRequest {
+	return m.MockStart(input)
+}
+
+// ModifyInstanceAttributeRequest mocks ModifyInstanceAttributeRequest method
+func (m *MockInstanceClient) ModifyInstanceAttributeRequest(input *ec2.ModifyInstanceAttributeInput) ec2.ModifyInstanceAttributeRequest {
+	return m.MockModifyAttribute(input)
+}
+
+// ModifyInstanceMetadataOptionsRequest mocks ModifyInstanceMetadataOptionsRequest method
+func (m *MockInstanceClient) ModifyInstanceMetadataOptionsRequest(input *ec2.ModifyInstanceMetadataOptionsInput) ec2.ModifyInstanceMetadataOptionsRequest {
+	return m.MockModifyMetadataOptions(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockInstanceClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockInstanceClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
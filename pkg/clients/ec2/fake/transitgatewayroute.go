@@ -0,0 +1,54 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.TransitGatewayRouteClient = (*MockTransitGatewayRouteClient)(nil)
+
+// MockTransitGatewayRouteClient is a type that implements all the methods for TransitGatewayRouteClient interface
+type MockTransitGatewayRouteClient struct {
+	MockCreate  func(*ec2.CreateTransitGatewayRouteInput) ec2.CreateTransitGatewayRouteRequest
+	MockSearch  func(*ec2.SearchTransitGatewayRoutesInput) ec2.SearchTransitGatewayRoutesRequest
+	MockReplace func(*ec2.ReplaceTransitGatewayRouteInput) ec2.ReplaceTransitGatewayRouteRequest
+	MockDelete  func(*ec2.DeleteTransitGatewayRouteInput) ec2.DeleteTransitGatewayRouteRequest
+}
+
+// CreateTransitGatewayRouteRequest mocks CreateTransitGatewayRouteRequest method
+func (m *MockTransitGatewayRouteClient) CreateTransitGatewayRouteRequest(input *ec2.CreateTransitGatewayRouteInput) ec2.CreateTransitGatewayRouteRequest {
+	return m.MockCreate(input)
+}
+
+// SearchTransitGatewayRoutesRequest mocks SearchTransitGatewayRoutesRequest method
+func (m *MockTransitGatewayRouteClient) SearchTransitGatewayRoutesRequest(input *ec2.SearchTransitGatewayRoutesInput) ec2.SearchTransitGatewayRoutesRequest {
+	return m.MockSearch(input)
+}
+
+// ReplaceTransitGatewayRouteRequest mocks ReplaceTransitGatewayRouteRequest method
+func (m *MockTransitGatewayRouteClient) ReplaceTransitGatewayRouteRequest(input *ec2.ReplaceTransitGatewayRouteInput) ec2.ReplaceTransitGatewayRouteRequest {
+	return m.MockReplace(input)
+}
+
+// DeleteTransitGatewayRouteRequest mocks DeleteTransitGatewayRouteRequest method
+func (m *MockTransitGatewayRouteClient) DeleteTransitGatewayRouteRequest(input *ec2.DeleteTransitGatewayRouteInput) ec2.DeleteTransitGatewayRouteRequest {
+	return m.MockDelete(input)
+}
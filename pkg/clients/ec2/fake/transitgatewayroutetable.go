@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.TransitGatewayRouteTableClient = (*MockTransitGatewayRouteTableClient)(nil)
+
+// MockTransitGatewayRouteTableClient is a type that implements all the methods for TransitGatewayRouteTableClient interface
+type MockTransitGatewayRouteTableClient struct {
+	MockCreate     func(*ec2.CreateTransitGatewayRouteTableInput) ec2.CreateTransitGatewayRouteTableRequest
+	MockDescribe   func(*ec2.DescribeTransitGatewayRouteTablesInput) ec2.DescribeTransitGatewayRouteTablesRequest
+	MockDelete     func(*ec2.DeleteTransitGatewayRouteTableInput) ec2.DeleteTransitGatewayRouteTableRequest
+	MockCreateTags func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// CreateTransitGatewayRouteTableRequest mocks CreateTransitGatewayRouteTableRequest method
+func (m *MockTransitGatewayRouteTableClient) CreateTransitGatewayRouteTableRequest(input *ec2.CreateTransitGatewayRouteTableInput) ec2.CreateTransitGatewayRouteTableRequest {
+	return m.MockCreate(input)
+}
+
+// DescribeTransitGatewayRouteTablesRequest mocks DescribeTransitGatewayRouteTablesRequest method
+func (m *MockTransitGatewayRouteTableClient) DescribeTransitGatewayRouteTablesRequest(input *ec2.DescribeTransitGatewayRouteTablesInput) ec2.DescribeTransitGatewayRouteTablesRequest {
+	return m.MockDescribe(input)
+}
+
+// DeleteTransitGatewayRouteTableRequest mocks DeleteTransitGatewayRouteTableRequest method
+func (m *MockTransitGatewayRouteTableClient) DeleteTransitGatewayRouteTableRequest(input *ec2.DeleteTransitGatewayRouteTableInput) ec2.DeleteTransitGatewayRouteTableRequest {
+	return m.MockDelete(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockTransitGatewayRouteTableClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockTransitGatewayRouteTableClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
+
+// this ensures that the mock implements the client interface
+var _ clientset.TransitGatewayRouteTableAssociationClient = (*MockTransitGatewayRouteTableAssociationClient)(nil)
+
+// MockTransitGatewayRouteTableAssociationClient is a type that implements all the methods for TransitGatewayRouteTableAssociationClient interface
+type MockTransitGatewayRouteTableAssociationClient struct {
+	MockAssociate       func(*ec2.AssociateTransitGatewayRouteTableInput) ec2.AssociateTransitGatewayRouteTableRequest
+	MockGetAssociations func(*ec2.GetTransitGatewayRouteTableAssociationsInput) ec2.GetTransitGatewayRouteTableAssociationsRequest
+	MockDisassociate    func(*ec2.DisassociateTransitGatewayRouteTableInput) ec2.DisassociateTransitGatewayRouteTableRequest
+}
+
+// AssociateTransitGatewayRouteTableRequest mocks AssociateTransitGatewayRouteTableRequest method
+func (m *MockTransitGatewayRouteTableAssociationClient) AssociateTransitGatewayRouteTableRequest(input *ec2.AssociateTransitGatewayRouteTableInput) ec2.AssociateTransitGatewayRouteTableRequest {
+	return m.MockAssociate(input)
+}
+
+// GetTransitGatewayRouteTableAssociationsRequest mocks GetTransitGatewayRouteTableAssociationsRequest method
+func (m *MockTransitGatewayRouteTableAssociationClient) GetTransitGatewayRouteTableAssociationsRequest(input *ec2.GetTransitGatewayRouteTableAssociationsInput) ec2.GetTransitGatewayRouteTableAssociationsRequest {
+	return m.MockGetAssociations(input)
+}
+
+// DisassociateTransitGatewayRouteTableRequest mocks DisassociateTransitGatewayRouteTableRequest method
+func (m *MockTransitGatewayRouteTableAssociationClient) DisassociateTransitGatewayRouteTableRequest(input *ec2.DisassociateTransitGatewayRouteTableInput) ec2.DisassociateTransitGatewayRouteTableRequest {
+	return m.MockDisassociate(input)
+}
+
+// this ensures that the mock implements the client interface
+var _ clientset.TransitGatewayRouteTablePropagationClient = (*MockTransitGatewayRouteTablePropagationClient)(nil)
+
+// MockTransitGatewayRouteTablePropagationClient is a type that implements all the methods for TransitGatewayRouteTablePropagationClient interface
+type MockTransitGatewayRouteTablePropagationClient struct {
+	MockEnable          func(*ec2.EnableTransitGatewayRouteTablePropagationInput) ec2.EnableTransitGatewayRouteTablePropagationRequest
+	MockGetPropagations func(*ec2.GetTransitGatewayRouteTablePropagationsInput) ec2.GetTransitGatewayRouteTablePropagationsRequest
+	MockDisable         func(*ec2.DisableTransitGatewayRouteTablePropagationInput) ec2.DisableTransitGatewayRouteTablePropagationRequest
+}
+
+// EnableTransitGatewayRouteTablePropagationRequest mocks EnableTransitGatewayRouteTablePropagationRequest method
+func (m *MockTransitGatewayRouteTablePropagationClient) EnableTransitGatewayRouteTablePropagationRequest(input *ec2.EnableTransitGatewayRouteTablePropagationInput) ec2.EnableTransitGatewayRouteTablePropagationRequest {
+	return m.MockEnable(input)
+}
+
+// GetTransitGatewayRouteTablePropagationsRequest mocks GetTransitGatewayRouteTablePropagationsRequest method
+func (m *MockTransitGatewayRouteTablePropagationClient) GetTransitGatewayRouteTablePropagationsRequest(input *ec2.GetTransitGatewayRouteTablePropagationsInput) ec2.GetTransitGatewayRouteTablePropagationsRequest {
+	return m.MockGetPropagations(input)
+}
+
+// DisableTransitGatewayRouteTablePropagationRequest mocks DisableTransitGatewayRouteTablePropagationRequest method
+func (m *MockTransitGatewayRouteTablePropagationClient) DisableTransitGatewayRouteTablePropagationRequest(input *ec2.DisableTransitGatewayRouteTablePropagationInput) ec2.DisableTransitGatewayRouteTablePropagationRequest {
+	return m.MockDisable(input)
+}
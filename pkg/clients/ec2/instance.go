@@ -0,0 +1,281 @@
+package ec2
+
+import (
+	"encoding/base64"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// InstanceIDNotFound is the code that is returned by ec2 when the given InstanceID is not valid
+	InstanceIDNotFound = "InvalidInstanceID.NotFound"
+
+	// InstanceConnectionPrivateIPKey is the connection detail key of the
+	// private IPv4 address of an instance.
+	InstanceConnectionPrivateIPKey = "privateIp"
+	// InstanceConnectionPrivateDNSKey is the connection detail key of the
+	// private DNS name of an instance.
+	InstanceConnectionPrivateDNSKey = "privateDns"
+	// InstanceConnectionPublicIPKey is the connection detail key of the
+	// public IPv4 address of an instance.
+	InstanceConnectionPublicIPKey = "publicIp"
+	// InstanceConnectionPublicDNSKey is the connection detail key of the
+	// public DNS name of an instance.
+	InstanceConnectionPublicDNSKey = "publicDns"
+)
+
+// InstanceClient is the external client used for Instance Custom Resource
+type InstanceClient interface {
+	RunInstancesRequest(*ec2.RunInstancesInput) ec2.RunInstancesRequest
+	DescribeInstancesRequest(*ec2.DescribeInstancesInput) ec2.DescribeInstancesRequest
+	DescribeInstanceAttributeRequest(*ec2.DescribeInstanceAttributeInput) ec2.DescribeInstanceAttributeRequest
+	TerminateInstancesRequest(*ec2.TerminateInstancesInput) ec2.TerminateInstancesRequest
+	StopInstancesRequest(*ec2.StopInstancesInput) ec2.StopInstancesRequest
+	StartInstancesRequest(*ec2.StartInstancesInput) ec2.StartInstancesRequest
+	ModifyInstanceAttributeRequest(*ec2.ModifyInstanceAttributeInput) ec2.ModifyInstanceAttributeRequest
+	ModifyInstanceMetadataOptionsRequest(*ec2.ModifyInstanceMetadataOptionsInput) ec2.ModifyInstanceMetadataOptionsRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewInstanceClient returns a new client using AWS credentials as JSON encoded data.
+func NewInstanceClient(cfg aws.Config) InstanceClient {
+	return ec2.New(cfg)
+}
+
+// IsInstanceNotFoundErr returns true if the error is because the item doesn't exist
+func IsInstanceNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == InstanceIDNotFound {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateRunInstancesInput returns the input to launch a single instance
+// with the supplied parameters. The client token makes retries of the call
+// idempotent, and userData is the raw user data, if any.
+func GenerateRunInstancesInput(clientToken string, p v1alpha1.InstanceParameters, userData *string) *ec2.RunInstancesInput {
+	input := &ec2.RunInstancesInput{
+		ClientToken:         aws.String(clientToken),
+		ImageId:             aws.String(p.ImageID),
+		InstanceType:        ec2.InstanceType(p.InstanceType),
+		KeyName:             p.KeyName,
+		MinCount:            aws.Int64(1),
+		MaxCount:            aws.Int64(1),
+		SubnetId:            p.SubnetID,
+		SecurityGroupIds:    p.SecurityGroupIDs,
+		BlockDeviceMappings: GenerateBlockDeviceMappings(p.BlockDeviceMappings),
+	}
+	if userData != nil {
+		input.UserData = aws.String(base64.StdEncoding.EncodeToString([]byte(*userData)))
+	}
+	if p.IAMInstanceProfileARN != nil {
+		input.IamInstanceProfile = &ec2.IamInstanceProfileSpecification{Arn: p.IAMInstanceProfileARN}
+	}
+	if m := p.MetadataOptions; m != nil {
+		input.MetadataOptions = &ec2.InstanceMetadataOptionsRequest{
+			HttpEndpoint:            ec2.InstanceMetadataEndpointState(aws.StringValue(m.HTTPEndpoint)),
+			HttpTokens:              ec2.HttpTokensState(aws.StringValue(m.HTTPTokens)),
+			HttpPutResponseHopLimit: m.HTTPPutResponseHopLimit,
+		}
+	}
+	if len(p.Tags) != 0 {
+		input.TagSpecifications = []ec2.TagSpecification{
+			{
+				ResourceType: ec2.ResourceTypeInstance,
+				Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+			},
+		}
+	}
+	return input
+}
+
+// GenerateBlockDeviceMappings converts the supplied block device mappings to
+// the type that the EC2 client expects.
+func GenerateBlockDeviceMappings(mappings []v1alpha1.BlockDeviceMapping) []ec2.BlockDeviceMapping {
+	if len(mappings) == 0 {
+		return nil
+	}
+	res := make([]ec2.BlockDeviceMapping, len(mappings))
+	for i, m := range mappings {
+		res[i] = ec2.BlockDeviceMapping{
+			DeviceName:  aws.String(m.DeviceName),
+			NoDevice:    m.NoDevice,
+			VirtualName: m.VirtualName,
+		}
+		if m.EBS != nil {
+			res[i].Ebs = &ec2.EbsBlockDevice{
+				DeleteOnTermination: m.EBS.DeleteOnTermination,
+				Encrypted:           m.EBS.Encrypted,
+				Iops:                m.EBS.IOPS,
+				KmsKeyId:            m.EBS.KMSKeyID,
+				SnapshotId:          m.EBS.SnapshotID,
+				VolumeSize:          m.EBS.VolumeSize,
+				VolumeType:          ec2.VolumeType(aws.StringValue(m.EBS.VolumeType)),
+			}
+		}
+	}
+	return res
+}
+
+// GenerateInstanceObservation is used to produce v1alpha1.InstanceObservation
+// from ec2.Instance.
+func GenerateInstanceObservation(i ec2.Instance) v1alpha1.InstanceObservation {
+	o := v1alpha1.InstanceObservation{
+		InstanceID:       aws.StringValue(i.InstanceId),
+		VPCID:            aws.StringValue(i.VpcId),
+		PrivateIPAddress: aws.StringValue(i.PrivateIpAddress),
+		PrivateDNSName:   aws.StringValue(i.PrivateDnsName),
+		PublicIPAddress:  aws.StringValue(i.PublicIpAddress),
+		PublicDNSName:    aws.StringValue(i.PublicDnsName),
+	}
+	if i.State != nil {
+		o.State = string(i.State.Name)
+	}
+	if i.StateReason != nil {
+		o.StateReason = aws.StringValue(i.StateReason.Message)
+	}
+	if i.Placement != nil {
+		o.AvailabilityZone = aws.StringValue(i.Placement.AvailabilityZone)
+	}
+	if i.LaunchTime != nil {
+		o.LaunchTime = &metav1.Time{Time: *i.LaunchTime}
+	}
+	return o
+}
+
+// LateInitializeInstance fills the empty fields in *v1alpha1.InstanceParameters
+// with the values seen in ec2.Instance.
+func LateInitializeInstance(in *v1alpha1.InstanceParameters, i *ec2.Instance) {
+	if i == nil {
+		return
+	}
+
+	in.SubnetID = awsclients.LateInitializeStringPtr(in.SubnetID, i.SubnetId)
+	in.KeyName = awsclients.LateInitializeStringPtr(in.KeyName, i.KeyName)
+
+	if len(in.SecurityGroupIDs) == 0 && len(i.SecurityGroups) != 0 {
+		in.SecurityGroupIDs = make([]string, len(i.SecurityGroups))
+		for k, g := range i.SecurityGroups {
+			in.SecurityGroupIDs[k] = aws.StringValue(g.GroupId)
+		}
+	}
+
+	if i.MetadataOptions != nil {
+		if in.MetadataOptions == nil {
+			in.MetadataOptions = &v1alpha1.InstanceMetadataOptions{}
+		}
+		m := in.MetadataOptions
+		if m.HTTPEndpoint == nil && i.MetadataOptions.HttpEndpoint != "" {
+			m.HTTPEndpoint = aws.String(string(i.MetadataOptions.HttpEndpoint))
+		}
+		if m.HTTPTokens == nil && i.MetadataOptions.HttpTokens != "" {
+			m.HTTPTokens = aws.String(string(i.MetadataOptions.HttpTokens))
+		}
+		m.HTTPPutResponseHopLimit = awsclients.LateInitializeInt64Ptr(m.HTTPPutResponseHopLimit, i.MetadataOptions.HttpPutResponseHopLimit)
+	}
+}
+
+// IsInstanceUpToDate checks whether there is a change in any of the
+// modifiable fields. The supplied user data is the raw desired user data and
+// the base64 encoded user data of the instance.
+func IsInstanceUpToDate(p v1alpha1.InstanceParameters, i ec2.Instance, userData, currentUserData *string) bool {
+	if InstanceRequiresStop(p, i, userData, currentUserData) {
+		return false
+	}
+	if !AreSecurityGroupsUpToDate(p.SecurityGroupIDs, i.SecurityGroups) {
+		return false
+	}
+	if !IsMetadataOptionsUpToDate(p.MetadataOptions, i.MetadataOptions) {
+		return false
+	}
+	return v1beta1.CompareTags(p.Tags, i.Tags)
+}
+
+// InstanceRequiresStop returns true if a field that can only be changed
+// while the instance is stopped differs from the desired state.
+func InstanceRequiresStop(p v1alpha1.InstanceParameters, i ec2.Instance, userData, currentUserData *string) bool {
+	if p.InstanceType != string(i.InstanceType) {
+		return true
+	}
+	if userData != nil && base64.StdEncoding.EncodeToString([]byte(*userData)) != aws.StringValue(currentUserData) {
+		return true
+	}
+	return false
+}
+
+// AreSecurityGroupsUpToDate returns true if the supplied security group IDs
+// are the security groups of the instance, regardless of their order.
+func AreSecurityGroupsUpToDate(ids []string, groups []ec2.GroupIdentifier) bool {
+	if len(ids) == 0 {
+		return true
+	}
+	if len(ids) != len(groups) {
+		return false
+	}
+	desired := make([]string, len(ids))
+	copy(desired, ids)
+	current := make([]string, len(groups))
+	for k, g := range groups {
+		current[k] = aws.StringValue(g.GroupId)
+	}
+	sort.Strings(desired)
+	sort.Strings(current)
+	for k := range desired {
+		if desired[k] != current[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// IsMetadataOptionsUpToDate returns true if the specified metadata options
+// match the ones of the instance.
+func IsMetadataOptionsUpToDate(m *v1alpha1.InstanceMetadataOptions, o *ec2.InstanceMetadataOptionsResponse) bool {
+	if m == nil {
+		return true
+	}
+	if o == nil {
+		return false
+	}
+	if m.HTTPEndpoint != nil && *m.HTTPEndpoint != string(o.HttpEndpoint) {
+		return false
+	}
+	if m.HTTPTokens != nil && *m.HTTPTokens != string(o.HttpTokens) {
+		return false
+	}
+	if m.HTTPPutResponseHopLimit != nil && *m.HTTPPutResponseHopLimit != aws.Int64Value(o.HttpPutResponseHopLimit) {
+		return false
+	}
+	return true
+}
+
+// GenerateInstanceConnectionDetails returns the addresses of the supplied
+// instance as connection details.
+func GenerateInstanceConnectionDetails(i ec2.Instance) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	for k, v := range map[string]*string{
+		InstanceConnectionPrivateIPKey:  i.PrivateIpAddress,
+		InstanceConnectionPrivateDNSKey: i.PrivateDnsName,
+		InstanceConnectionPublicIPKey:   i.PublicIpAddress,
+		InstanceConnectionPublicDNSKey:  i.PublicDnsName,
+	} {
+		if aws.StringValue(v) != "" {
+			cd[k] = []byte(*v)
+		}
+	}
+	return cd
+}
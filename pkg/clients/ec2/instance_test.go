@@ -0,0 +1,292 @@
+package ec2
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	instID           = "i-0123456789abcdef0"
+	instanceType     = "t3.micro"
+	instanceImageID  = "ami-0123456789abcdef0"
+	instanceSGID     = "sg-1"
+	instanceSubnetID = "subnet-1"
+	instancePrivIP   = "10.0.0.10"
+	instanceUserData = "#!/bin/sh\necho hello"
+)
+
+func instanceParams(m ...func(*v1alpha1.InstanceParameters)) v1alpha1.InstanceParameters {
+	p := v1alpha1.InstanceParameters{
+		ImageID:          instanceImageID,
+		InstanceType:     instanceType,
+		SubnetID:         aws.String(instanceSubnetID),
+		SecurityGroupIDs: []string{instanceSGID},
+		MetadataOptions: &v1alpha1.InstanceMetadataOptions{
+			HTTPTokens: aws.String("required"),
+		},
+		Tags: []v1beta1.Tag{{Key: "k", Value: "v"}},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func instance(m ...func(*ec2.Instance)) ec2.Instance {
+	i := ec2.Instance{
+		InstanceId:       aws.String(instID),
+		ImageId:          aws.String(instanceImageID),
+		InstanceType:     ec2.InstanceType(instanceType),
+		SubnetId:         aws.String(instanceSubnetID),
+		SecurityGroups:   []ec2.GroupIdentifier{{GroupId: aws.String(instanceSGID)}},
+		PrivateIpAddress: aws.String(instancePrivIP),
+		State:            &ec2.InstanceState{Name: ec2.InstanceStateNameRunning},
+		MetadataOptions: &ec2.InstanceMetadataOptionsResponse{
+			HttpEndpoint:            ec2.InstanceMetadataEndpointStateEnabled,
+			HttpTokens:              ec2.HttpTokensStateRequired,
+			HttpPutResponseHopLimit: aws.Int64(1),
+		},
+		Tags: []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+	}
+	for _, f := range m {
+		f(&i)
+	}
+	return i
+}
+
+func TestGenerateRunInstancesInput(t *testing.T) {
+	cases := map[string]struct {
+		token    string
+		p        v1alpha1.InstanceParameters
+		userData *string
+		want     *ec2.RunInstancesInput
+	}{
+		"AllFilled": {
+			token: "uid",
+			p: instanceParams(func(p *v1alpha1.InstanceParameters) {
+				p.IAMInstanceProfileARN = aws.String("arn:aws:iam::123456789012:instance-profile/p")
+				p.BlockDeviceMappings = []v1alpha1.BlockDeviceMapping{{
+					DeviceName: "/dev/xvda",
+					EBS: &v1alpha1.EBSBlockDevice{
+						VolumeSize: aws.Int64(20),
+						VolumeType: aws.String("gp3"),
+						Encrypted:  aws.Bool(true),
+					},
+				}}
+			}),
+			userData: aws.String(instanceUserData),
+			want: &ec2.RunInstancesInput{
+				ClientToken:      aws.String("uid"),
+				ImageId:          aws.String(instanceImageID),
+				InstanceType:     ec2.InstanceType(instanceType),
+				MinCount:         aws.Int64(1),
+				MaxCount:         aws.Int64(1),
+				SubnetId:         aws.String(instanceSubnetID),
+				SecurityGroupIds: []string{instanceSGID},
+				UserData:         aws.String(base64.StdEncoding.EncodeToString([]byte(instanceUserData))),
+				IamInstanceProfile: &ec2.IamInstanceProfileSpecification{
+					Arn: aws.String("arn:aws:iam::123456789012:instance-profile/p"),
+				},
+				BlockDeviceMappings: []ec2.BlockDeviceMapping{{
+					DeviceName: aws.String("/dev/xvda"),
+					Ebs: &ec2.EbsBlockDevice{
+						VolumeSize: aws.Int64(20),
+						VolumeType: ec2.VolumeType("gp3"),
+						Encrypted:  aws.Bool(true),
+					},
+				}},
+				MetadataOptions: &ec2.InstanceMetadataOptionsRequest{
+					HttpTokens: ec2.HttpTokensStateRequired,
+				},
+				TagSpecifications: []ec2.TagSpecification{{
+					ResourceType: ec2.ResourceTypeInstance,
+					Tags:         []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+				}},
+			},
+		},
+		"Minimal": {
+			token: "uid",
+			p: v1alpha1.InstanceParameters{
+				ImageID:      instanceImageID,
+				InstanceType: instanceType,
+			},
+			want: &ec2.RunInstancesInput{
+				ClientToken:  aws.String("uid"),
+				ImageId:      aws.String(instanceImageID),
+				InstanceType: ec2.InstanceType(instanceType),
+				MinCount:     aws.Int64(1),
+				MaxCount:     aws.Int64(1),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateRunInstancesInput(tc.token, tc.p, tc.userData)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeInstance(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.InstanceParameters
+		i    ec2.Instance
+		want v1alpha1.InstanceParameters
+	}{
+		"FillsEmptyFields": {
+			in: v1alpha1.InstanceParameters{
+				ImageID:      instanceImageID,
+				InstanceType: instanceType,
+			},
+			i: instance(),
+			want: v1alpha1.InstanceParameters{
+				ImageID:          instanceImageID,
+				InstanceType:     instanceType,
+				SubnetID:         aws.String(instanceSubnetID),
+				SecurityGroupIDs: []string{instanceSGID},
+				MetadataOptions: &v1alpha1.InstanceMetadataOptions{
+					HTTPEndpoint:            aws.String("enabled"),
+					HTTPTokens:              aws.String("required"),
+					HTTPPutResponseHopLimit: aws.Int64(1),
+				},
+			},
+		},
+		"KeepsSetFields": {
+			in: instanceParams(func(p *v1alpha1.InstanceParameters) {
+				p.SecurityGroupIDs = []string{"sg-2"}
+				p.MetadataOptions.HTTPTokens = aws.String("optional")
+			}),
+			i: instance(),
+			want: instanceParams(func(p *v1alpha1.InstanceParameters) {
+				p.SecurityGroupIDs = []string{"sg-2"}
+				p.MetadataOptions.HTTPTokens = aws.String("optional")
+				p.MetadataOptions.HTTPEndpoint = aws.String("enabled")
+				p.MetadataOptions.HTTPPutResponseHopLimit = aws.Int64(1)
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeInstance(&tc.in, &tc.i)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsInstanceUpToDate(t *testing.T) {
+	encoded := aws.String(base64.StdEncoding.EncodeToString([]byte(instanceUserData)))
+
+	type want struct {
+		upToDate     bool
+		requiresStop bool
+	}
+
+	cases := map[string]struct {
+		p               v1alpha1.InstanceParameters
+		i               ec2.Instance
+		userData        *string
+		currentUserData *string
+		want            want
+	}{
+		"UpToDate": {
+			p:               instanceParams(),
+			i:               instance(),
+			userData:        aws.String(instanceUserData),
+			currentUserData: encoded,
+			want:            want{upToDate: true},
+		},
+		"InstanceTypeChanged": {
+			p: instanceParams(func(p *v1alpha1.InstanceParameters) { p.InstanceType = "t3.large" }),
+			i: instance(),
+			want: want{
+				requiresStop: true,
+			},
+		},
+		"UserDataChanged": {
+			p:               instanceParams(),
+			i:               instance(),
+			userData:        aws.String("#!/bin/sh"),
+			currentUserData: encoded,
+			want: want{
+				requiresStop: true,
+			},
+		},
+		"SecurityGroupsChanged": {
+			p:    instanceParams(func(p *v1alpha1.InstanceParameters) { p.SecurityGroupIDs = []string{"sg-1", "sg-2"} }),
+			i:    instance(),
+			want: want{},
+		},
+		"MetadataOptionsChanged": {
+			p:    instanceParams(func(p *v1alpha1.InstanceParameters) { p.MetadataOptions.HTTPTokens = aws.String("optional") }),
+			i:    instance(),
+			want: want{},
+		},
+		"TagsChanged": {
+			p:    instanceParams(func(p *v1alpha1.InstanceParameters) { p.Tags = nil }),
+			i:    instance(),
+			want: want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			upToDate := IsInstanceUpToDate(tc.p, tc.i, tc.userData, tc.currentUserData)
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("upToDate: -want, +got:\n%s", diff)
+			}
+			requiresStop := InstanceRequiresStop(tc.p, tc.i, tc.userData, tc.currentUserData)
+			if diff := cmp.Diff(tc.want.requiresStop, requiresStop); diff != "" {
+				t.Errorf("requiresStop: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateInstanceConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		i    ec2.Instance
+		want managed.ConnectionDetails
+	}{
+		"PrivateOnly": {
+			i: instance(),
+			want: managed.ConnectionDetails{
+				InstanceConnectionPrivateIPKey: []byte(instancePrivIP),
+			},
+		},
+		"Public": {
+			i: instance(func(i *ec2.Instance) {
+				i.PublicIpAddress = aws.String("203.0.113.10")
+				i.PublicDnsName = aws.String("ec2-203-0-113-10.compute-1.amazonaws.com")
+			}),
+			want: managed.ConnectionDetails{
+				InstanceConnectionPrivateIPKey: []byte(instancePrivIP),
+				InstanceConnectionPublicIPKey:  []byte("203.0.113.10"),
+				InstanceConnectionPublicDNSKey: []byte("ec2-203-0-113-10.compute-1.amazonaws.com"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateInstanceConnectionDetails(tc.i)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package ec2
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+const (
+	// LaunchTemplateNotFound is the code that is returned by ec2 when the
+	// given launch template name does not exist.
+	LaunchTemplateNotFound = "InvalidLaunchTemplateName.NotFoundException"
+)
+
+// LaunchTemplateClient is the external client used for LaunchTemplate Custom Resource
+type LaunchTemplateClient interface {
+	CreateLaunchTemplateRequest(*ec2.CreateLaunchTemplateInput) ec2.CreateLaunchTemplateRequest
+	DescribeLaunchTemplatesRequest(*ec2.DescribeLaunchTemplatesInput) ec2.DescribeLaunchTemplatesRequest
+	ModifyLaunchTemplateRequest(*ec2.ModifyLaunchTemplateInput) ec2.ModifyLaunchTemplateRequest
+	DeleteLaunchTemplateRequest(*ec2.DeleteLaunchTemplateInput) ec2.DeleteLaunchTemplateRequest
+	CreateLaunchTemplateVersionRequest(*ec2.CreateLaunchTemplateVersionInput) ec2.CreateLaunchTemplateVersionRequest
+	DescribeLaunchTemplateVersionsRequest(*ec2.DescribeLaunchTemplateVersionsInput) ec2.DescribeLaunchTemplateVersionsRequest
+	DeleteLaunchTemplateVersionsRequest(*ec2.DeleteLaunchTemplateVersionsInput) ec2.DeleteLaunchTemplateVersionsRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewLaunchTemplateClient returns a new client using AWS credentials as JSON encoded data.
+func NewLaunchTemplateClient(cfg aws.Config) LaunchTemplateClient {
+	return ec2.New(cfg)
+}
+
+// IsLaunchTemplateNotFoundErr returns true if the error is because the item doesn't exist
+func IsLaunchTemplateNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == LaunchTemplateNotFound {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateRequestLaunchTemplateData converts the supplied launch template data
+// to the type that the EC2 client expects.
+func GenerateRequestLaunchTemplateData(d v1alpha1.LaunchTemplateData) *ec2.RequestLaunchTemplateData {
+	r := &ec2.RequestLaunchTemplateData{
+		ImageId:          d.ImageID,
+		InstanceType:     ec2.InstanceType(aws.StringValue(d.InstanceType)),
+		KeyName:          d.KeyName,
+		SecurityGroupIds: d.SecurityGroupIDs,
+		UserData:         d.UserData,
+		EbsOptimized:     d.EBSOptimized,
+	}
+	if d.IAMInstanceProfileARN != nil {
+		r.IamInstanceProfile = &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{Arn: d.IAMInstanceProfileARN}
+	}
+	if len(d.BlockDeviceMappings) != 0 {
+		r.BlockDeviceMappings = make([]ec2.LaunchTemplateBlockDeviceMappingRequest, len(d.BlockDeviceMappings))
+		for i, m := range d.BlockDeviceMappings {
+			r.BlockDeviceMappings[i] = ec2.LaunchTemplateBlockDeviceMappingRequest{
+				DeviceName:  aws.String(m.DeviceName),
+				NoDevice:    m.NoDevice,
+				VirtualName: m.VirtualName,
+			}
+			if m.EBS != nil {
+				r.BlockDeviceMappings[i].Ebs = &ec2.LaunchTemplateEbsBlockDeviceRequest{
+					DeleteOnTermination: m.EBS.DeleteOnTermination,
+					Encrypted:           m.EBS.Encrypted,
+					Iops:                m.EBS.IOPS,
+					KmsKeyId:            m.EBS.KMSKeyID,
+					SnapshotId:          m.EBS.SnapshotID,
+					VolumeSize:          m.EBS.VolumeSize,
+					VolumeType:          ec2.VolumeType(aws.StringValue(m.EBS.VolumeType)),
+				}
+			}
+		}
+	}
+	if m := d.MetadataOptions; m != nil {
+		r.MetadataOptions = &ec2.LaunchTemplateInstanceMetadataOptionsRequest{
+			HttpEndpoint:            ec2.LaunchTemplateInstanceMetadataEndpointState(aws.StringValue(m.HTTPEndpoint)),
+			HttpTokens:              ec2.LaunchTemplateHttpTokensState(aws.StringValue(m.HTTPTokens)),
+			HttpPutResponseHopLimit: m.HTTPPutResponseHopLimit,
+		}
+	}
+	if len(d.InstanceTags) != 0 {
+		r.TagSpecifications = []ec2.LaunchTemplateTagSpecificationRequest{
+			{
+				ResourceType: ec2.ResourceTypeInstance,
+				Tags:         v1beta1.GenerateEC2Tags(d.InstanceTags),
+			},
+		}
+	}
+	return r
+}
+
+// GenerateLaunchTemplateData converts the data of a launch template version
+// to v1alpha1.LaunchTemplateData.
+func GenerateLaunchTemplateData(r *ec2.ResponseLaunchTemplateData) v1alpha1.LaunchTemplateData { // nolint:gocyclo
+	d := v1alpha1.LaunchTemplateData{}
+	if r == nil {
+		return d
+	}
+	d.ImageID = r.ImageId
+	d.KeyName = r.KeyName
+	d.SecurityGroupIDs = r.SecurityGroupIds
+	d.UserData = r.UserData
+	d.EBSOptimized = r.EbsOptimized
+	if r.InstanceType != "" {
+		d.InstanceType = aws.String(string(r.InstanceType))
+	}
+	if r.IamInstanceProfile != nil {
+		d.IAMInstanceProfileARN = r.IamInstanceProfile.Arn
+	}
+	if len(r.BlockDeviceMappings) != 0 {
+		d.BlockDeviceMappings = make([]v1alpha1.BlockDeviceMapping, len(r.BlockDeviceMappings))
+		for i, m := range r.BlockDeviceMappings {
+			d.BlockDeviceMappings[i] = v1alpha1.BlockDeviceMapping{
+				DeviceName:  aws.StringValue(m.DeviceName),
+				NoDevice:    m.NoDevice,
+				VirtualName: m.VirtualName,
+			}
+			if m.Ebs != nil {
+				d.BlockDeviceMappings[i].EBS = &v1alpha1.EBSBlockDevice{
+					DeleteOnTermination: m.Ebs.DeleteOnTermination,
+					Encrypted:           m.Ebs.Encrypted,
+					IOPS:                m.Ebs.Iops,
+					KMSKeyID:            m.Ebs.KmsKeyId,
+					SnapshotID:          m.Ebs.SnapshotId,
+					VolumeSize:          m.Ebs.VolumeSize,
+				}
+				if m.Ebs.VolumeType != "" {
+					d.BlockDeviceMappings[i].EBS.VolumeType = aws.String(string(m.Ebs.VolumeType))
+				}
+			}
+		}
+	}
+	if m := r.MetadataOptions; m != nil {
+		d.MetadataOptions = &v1alpha1.InstanceMetadataOptions{HTTPPutResponseHopLimit: m.HttpPutResponseHopLimit}
+		if m.HttpEndpoint != "" {
+			d.MetadataOptions.HTTPEndpoint = aws.String(string(m.HttpEndpoint))
+		}
+		if m.HttpTokens != "" {
+			d.MetadataOptions.HTTPTokens = aws.String(string(m.HttpTokens))
+		}
+	}
+	for _, s := range r.TagSpecifications {
+		if s.ResourceType != ec2.ResourceTypeInstance {
+			continue
+		}
+		d.InstanceTags = append(d.InstanceTags, v1beta1.BuildFromEC2Tags(s.Tags)...)
+	}
+	return d
+}
+
+// IsLaunchTemplateDataUpToDate returns true if the supplied launch template
+// data matches the data of the launch template version.
+func IsLaunchTemplateDataUpToDate(d v1alpha1.LaunchTemplateData, r *ec2.ResponseLaunchTemplateData) bool {
+	desired := d.DeepCopy()
+	desired.SecurityGroupIDRefs = nil
+	desired.SecurityGroupIDSelector = nil
+	current := GenerateLaunchTemplateData(r)
+
+	return cmp.Equal(*desired, current,
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+		cmpopts.SortSlices(func(a, b v1beta1.Tag) bool { return a.Key < b.Key }))
+}
+
+// GenerateLaunchTemplateObservation is used to produce
+// v1alpha1.LaunchTemplateObservation from ec2.LaunchTemplate.
+func GenerateLaunchTemplateObservation(t ec2.LaunchTemplate) v1alpha1.LaunchTemplateObservation {
+	o := v1alpha1.LaunchTemplateObservation{
+		LaunchTemplateID:     aws.StringValue(t.LaunchTemplateId),
+		LatestVersionNumber:  aws.Int64Value(t.LatestVersionNumber),
+		DefaultVersionNumber: aws.Int64Value(t.DefaultVersionNumber),
+	}
+	if t.CreateTime != nil {
+		o.CreateTime = &metav1.Time{Time: *t.CreateTime}
+	}
+	return o
+}
+
+// LaunchTemplateVersionsToPrune returns the numbers of the supplied versions
+// that exceed the retention count. The newest versions are retained and the
+// default version is never pruned. No version is pruned if retention is nil.
+func LaunchTemplateVersionsToPrune(versions []ec2.LaunchTemplateVersion, retention *int64) []string {
+	if retention == nil || int64(len(versions)) <= *retention {
+		return nil
+	}
+	sorted := make([]ec2.LaunchTemplateVersion, len(versions))
+	copy(sorted, versions)
+	sort.Slice(sorted, func(i, j int) bool {
+		return aws.Int64Value(sorted[i].VersionNumber) > aws.Int64Value(sorted[j].VersionNumber)
+	})
+
+	// The default version always counts towards the retained versions.
+	kept := int64(0)
+	for _, v := range sorted {
+		if aws.BoolValue(v.DefaultVersion) {
+			kept++
+		}
+	}
+	var prune []string
+	for _, v := range sorted {
+		if aws.BoolValue(v.DefaultVersion) {
+			continue
+		}
+		if kept < *retention {
+			kept++
+			continue
+		}
+		prune = append(prune, strconv.FormatInt(aws.Int64Value(v.VersionNumber), 10))
+	}
+	return prune
+}
+
+// LatestLaunchTemplateVersion returns the latest version of the launch
+// template among the supplied versions, or nil if it is not among them.
+func LatestLaunchTemplateVersion(t ec2.LaunchTemplate, versions []ec2.LaunchTemplateVersion) *ec2.LaunchTemplateVersion {
+	for i := range versions {
+		if aws.Int64Value(versions[i].VersionNumber) == aws.Int64Value(t.LatestVersionNumber) {
+			return &versions[i]
+		}
+	}
+	return nil
+}
+
+// IsLaunchTemplateUpToDate returns true if the latest version of the launch
+// template matches the supplied parameters and is the default version, the
+// tags of the launch template are up to date and no version needs to be
+// pruned.
+func IsLaunchTemplateUpToDate(p v1alpha1.LaunchTemplateParameters, t ec2.LaunchTemplate, versions []ec2.LaunchTemplateVersion) bool {
+	latest := LatestLaunchTemplateVersion(t, versions)
+	if latest == nil || !IsLaunchTemplateDataUpToDate(p.LaunchTemplateData, latest.LaunchTemplateData) {
+		return false
+	}
+	if aws.Int64Value(t.DefaultVersionNumber) != aws.Int64Value(t.LatestVersionNumber) {
+		return false
+	}
+	if len(LaunchTemplateVersionsToPrune(versions, p.VersionRetentionCount)) != 0 {
+		return false
+	}
+	return v1beta1.CompareTags(p.Tags, t.Tags)
+}
@@ -0,0 +1,253 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	launchTemplateID = "lt-0123456789abcdef0"
+	ltImageID        = "ami-0123456789abcdef0"
+	ltInstanceType   = "t3.micro"
+	ltSGID           = "sg-1"
+)
+
+func launchTemplateData(m ...func(*v1alpha1.LaunchTemplateData)) v1alpha1.LaunchTemplateData {
+	d := v1alpha1.LaunchTemplateData{
+		ImageID:          aws.String(ltImageID),
+		InstanceType:     aws.String(ltInstanceType),
+		SecurityGroupIDs: []string{ltSGID},
+		BlockDeviceMappings: []v1alpha1.BlockDeviceMapping{{
+			DeviceName: "/dev/xvda",
+			EBS: &v1alpha1.EBSBlockDevice{
+				VolumeSize: aws.Int64(20),
+				VolumeType: aws.String("gp3"),
+			},
+		}},
+		MetadataOptions: &v1alpha1.InstanceMetadataOptions{
+			HTTPTokens: aws.String("required"),
+		},
+		InstanceTags: []v1beta1.Tag{{Key: "k", Value: "v"}},
+	}
+	for _, f := range m {
+		f(&d)
+	}
+	return d
+}
+
+func responseLaunchTemplateData(m ...func(*ec2.ResponseLaunchTemplateData)) *ec2.ResponseLaunchTemplateData {
+	r := &ec2.ResponseLaunchTemplateData{
+		ImageId:          aws.String(ltImageID),
+		InstanceType:     ec2.InstanceType(ltInstanceType),
+		SecurityGroupIds: []string{ltSGID},
+		BlockDeviceMappings: []ec2.LaunchTemplateBlockDeviceMapping{{
+			DeviceName: aws.String("/dev/xvda"),
+			Ebs: &ec2.LaunchTemplateEbsBlockDevice{
+				VolumeSize: aws.Int64(20),
+				VolumeType: ec2.VolumeType("gp3"),
+			},
+		}},
+		MetadataOptions: &ec2.LaunchTemplateInstanceMetadataOptions{
+			HttpTokens: ec2.LaunchTemplateHttpTokensStateRequired,
+			State:      ec2.LaunchTemplateInstanceMetadataOptionsStateApplied,
+		},
+		TagSpecifications: []ec2.LaunchTemplateTagSpecification{{
+			ResourceType: ec2.ResourceTypeInstance,
+			Tags:         []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+		}},
+	}
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func version(n int, def bool) ec2.LaunchTemplateVersion {
+	return ec2.LaunchTemplateVersion{
+		VersionNumber:      aws.Int64(n),
+		DefaultVersion:     aws.Bool(def),
+		LaunchTemplateData: responseLaunchTemplateData(),
+	}
+}
+
+func TestGenerateRequestLaunchTemplateData(t *testing.T) {
+	cases := map[string]struct {
+		d    v1alpha1.LaunchTemplateData
+		want *ec2.RequestLaunchTemplateData
+	}{
+		"AllFilled": {
+			d: launchTemplateData(func(d *v1alpha1.LaunchTemplateData) {
+				d.IAMInstanceProfileARN = aws.String("arn:aws:iam::123456789012:instance-profile/p")
+				d.UserData = aws.String("IyEvYmluL3No")
+			}),
+			want: &ec2.RequestLaunchTemplateData{
+				ImageId:          aws.String(ltImageID),
+				InstanceType:     ec2.InstanceType(ltInstanceType),
+				SecurityGroupIds: []string{ltSGID},
+				UserData:         aws.String("IyEvYmluL3No"),
+				IamInstanceProfile: &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{
+					Arn: aws.String("arn:aws:iam::123456789012:instance-profile/p"),
+				},
+				BlockDeviceMappings: []ec2.LaunchTemplateBlockDeviceMappingRequest{{
+					DeviceName: aws.String("/dev/xvda"),
+					Ebs: &ec2.LaunchTemplateEbsBlockDeviceRequest{
+						VolumeSize: aws.Int64(20),
+						VolumeType: ec2.VolumeType("gp3"),
+					},
+				}},
+				MetadataOptions: &ec2.LaunchTemplateInstanceMetadataOptionsRequest{
+					HttpTokens: ec2.LaunchTemplateHttpTokensStateRequired,
+				},
+				TagSpecifications: []ec2.LaunchTemplateTagSpecificationRequest{{
+					ResourceType: ec2.ResourceTypeInstance,
+					Tags:         []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+				}},
+			},
+		},
+		"Empty": {
+			d:    v1alpha1.LaunchTemplateData{},
+			want: &ec2.RequestLaunchTemplateData{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateRequestLaunchTemplateData(tc.d)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsLaunchTemplateUpToDate(t *testing.T) {
+	type args struct {
+		p        v1alpha1.LaunchTemplateParameters
+		t        ec2.LaunchTemplate
+		versions []ec2.LaunchTemplateVersion
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p: v1alpha1.LaunchTemplateParameters{
+					LaunchTemplateData: launchTemplateData(func(d *v1alpha1.LaunchTemplateData) {
+						d.SecurityGroupIDRefs = []xpv1.Reference{{Name: "sample-sg"}}
+					}),
+				},
+				t:        ec2.LaunchTemplate{LatestVersionNumber: aws.Int64(2), DefaultVersionNumber: aws.Int64(2)},
+				versions: []ec2.LaunchTemplateVersion{version(1, false), version(2, true)},
+			},
+			want: true,
+		},
+		"DataChanged": {
+			args: args{
+				p: v1alpha1.LaunchTemplateParameters{
+					LaunchTemplateData: launchTemplateData(func(d *v1alpha1.LaunchTemplateData) {
+						d.InstanceType = aws.String("t3.large")
+					}),
+				},
+				t:        ec2.LaunchTemplate{LatestVersionNumber: aws.Int64(1), DefaultVersionNumber: aws.Int64(1)},
+				versions: []ec2.LaunchTemplateVersion{version(1, true)},
+			},
+			want: false,
+		},
+		"DefaultNotLatest": {
+			args: args{
+				p:        v1alpha1.LaunchTemplateParameters{LaunchTemplateData: launchTemplateData()},
+				t:        ec2.LaunchTemplate{LatestVersionNumber: aws.Int64(2), DefaultVersionNumber: aws.Int64(1)},
+				versions: []ec2.LaunchTemplateVersion{version(1, true), version(2, false)},
+			},
+			want: false,
+		},
+		"VersionsToPrune": {
+			args: args{
+				p: v1alpha1.LaunchTemplateParameters{
+					LaunchTemplateData:    launchTemplateData(),
+					VersionRetentionCount: aws.Int64(1),
+				},
+				t:        ec2.LaunchTemplate{LatestVersionNumber: aws.Int64(2), DefaultVersionNumber: aws.Int64(2)},
+				versions: []ec2.LaunchTemplateVersion{version(1, false), version(2, true)},
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			args: args{
+				p: v1alpha1.LaunchTemplateParameters{
+					LaunchTemplateData: launchTemplateData(),
+					Tags:               []v1beta1.Tag{{Key: "k", Value: "v"}},
+				},
+				t:        ec2.LaunchTemplate{LatestVersionNumber: aws.Int64(1), DefaultVersionNumber: aws.Int64(1)},
+				versions: []ec2.LaunchTemplateVersion{version(1, true)},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsLaunchTemplateUpToDate(tc.args.p, tc.args.t, tc.args.versions)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLaunchTemplateVersionsToPrune(t *testing.T) {
+	type args struct {
+		versions  []ec2.LaunchTemplateVersion
+		retention *int64
+	}
+
+	cases := map[string]struct {
+		args args
+		want []string
+	}{
+		"NoRetention": {
+			args: args{
+				versions: []ec2.LaunchTemplateVersion{version(1, false), version(2, true)},
+			},
+		},
+		"WithinRetention": {
+			args: args{
+				versions:  []ec2.LaunchTemplateVersion{version(1, false), version(2, true)},
+				retention: aws.Int64(2),
+			},
+		},
+		"PruneOldest": {
+			args: args{
+				versions:  []ec2.LaunchTemplateVersion{version(1, false), version(2, false), version(3, false), version(4, true)},
+				retention: aws.Int64(2),
+			},
+			want: []string{"2", "1"},
+		},
+		"KeepDefault": {
+			args: args{
+				versions:  []ec2.LaunchTemplateVersion{version(1, true), version(2, false), version(3, false)},
+				retention: aws.Int64(2),
+			},
+			want: []string{"2"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := LaunchTemplateVersionsToPrune(tc.args.versions, tc.args.retention)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
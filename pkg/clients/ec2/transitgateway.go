@@ -0,0 +1,115 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// TransitGatewayIDNotFound is the code that is returned by ec2 when the
+	// given transit gateway ID is not valid.
+	TransitGatewayIDNotFound = "InvalidTransitGatewayID.NotFound"
+)
+
+// TransitGatewayClient is the external client used for TransitGateway Custom Resource
+type TransitGatewayClient interface {
+	CreateTransitGatewayRequest(*ec2.CreateTransitGatewayInput) ec2.CreateTransitGatewayRequest
+	DescribeTransitGatewaysRequest(*ec2.DescribeTransitGatewaysInput) ec2.DescribeTransitGatewaysRequest
+	DeleteTransitGatewayRequest(*ec2.DeleteTransitGatewayInput) ec2.DeleteTransitGatewayRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewTransitGatewayClient returns a new client using AWS credentials as JSON encoded data.
+func NewTransitGatewayClient(cfg aws.Config) TransitGatewayClient {
+	return ec2.New(cfg)
+}
+
+// IsTransitGatewayNotFoundErr returns true if the error is because the item doesn't exist
+func IsTransitGatewayNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == TransitGatewayIDNotFound {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateCreateTransitGatewayInput returns the input to create a transit
+// gateway with the supplied parameters.
+func GenerateCreateTransitGatewayInput(p v1beta1.TransitGatewayParameters) *ec2.CreateTransitGatewayInput {
+	input := &ec2.CreateTransitGatewayInput{
+		Description: p.Description,
+		Options: &ec2.TransitGatewayRequestOptions{
+			AmazonSideAsn:                p.AmazonSideASN,
+			AutoAcceptSharedAttachments:  ec2.AutoAcceptSharedAttachmentsValue(aws.StringValue(p.AutoAcceptSharedAttachments)),
+			DefaultRouteTableAssociation: ec2.DefaultRouteTableAssociationValue(aws.StringValue(p.DefaultRouteTableAssociation)),
+			DefaultRouteTablePropagation: ec2.DefaultRouteTablePropagationValue(aws.StringValue(p.DefaultRouteTablePropagation)),
+			DnsSupport:                   ec2.DnsSupportValue(aws.StringValue(p.DNSSupport)),
+			VpnEcmpSupport:               ec2.VpnEcmpSupportValue(aws.StringValue(p.VPNECMPSupport)),
+		},
+	}
+	if len(p.Tags) != 0 {
+		input.TagSpecifications = []ec2.TagSpecification{
+			{
+				ResourceType: ec2.ResourceTypeTransitGateway,
+				Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+			},
+		}
+	}
+	return input
+}
+
+// GenerateTransitGatewayObservation is used to produce
+// v1beta1.TransitGatewayObservation from ec2.TransitGateway.
+func GenerateTransitGatewayObservation(tgw ec2.TransitGateway) v1beta1.TransitGatewayObservation {
+	o := v1beta1.TransitGatewayObservation{
+		TransitGatewayID:  aws.StringValue(tgw.TransitGatewayId),
+		TransitGatewayARN: aws.StringValue(tgw.TransitGatewayArn),
+		State:             string(tgw.State),
+		OwnerID:           aws.StringValue(tgw.OwnerId),
+	}
+	if tgw.Options != nil {
+		o.AssociationDefaultRouteTableID = aws.StringValue(tgw.Options.AssociationDefaultRouteTableId)
+		o.PropagationDefaultRouteTableID = aws.StringValue(tgw.Options.PropagationDefaultRouteTableId)
+	}
+	return o
+}
+
+// LateInitializeTransitGateway fills the empty fields in
+// *v1beta1.TransitGatewayParameters with the values seen in
+// ec2.TransitGateway.
+func LateInitializeTransitGateway(in *v1beta1.TransitGatewayParameters, tgw *ec2.TransitGateway) {
+	if tgw == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, tgw.Description)
+	if o := tgw.Options; o != nil {
+		in.AmazonSideASN = awsclients.LateInitializeInt64Ptr(in.AmazonSideASN, o.AmazonSideAsn)
+		in.AutoAcceptSharedAttachments = lateInitializeEnum(in.AutoAcceptSharedAttachments, string(o.AutoAcceptSharedAttachments))
+		in.DefaultRouteTableAssociation = lateInitializeEnum(in.DefaultRouteTableAssociation, string(o.DefaultRouteTableAssociation))
+		in.DefaultRouteTablePropagation = lateInitializeEnum(in.DefaultRouteTablePropagation, string(o.DefaultRouteTablePropagation))
+		in.DNSSupport = lateInitializeEnum(in.DNSSupport, string(o.DnsSupport))
+		in.VPNECMPSupport = lateInitializeEnum(in.VPNECMPSupport, string(o.VpnEcmpSupport))
+	}
+}
+
+// IsTransitGatewayUpToDate checks whether the tags of the transit gateway are
+// up to date. The other parameters cannot be modified.
+func IsTransitGatewayUpToDate(p v1beta1.TransitGatewayParameters, tgw ec2.TransitGateway) bool {
+	return v1beta1.CompareTags(p.Tags, tgw.Tags)
+}
+
+// lateInitializeEnum returns in if it is set, or the supplied enum value
+// otherwise. Unset enum values are empty strings in the SDK.
+func lateInitializeEnum(in *string, from string) *string {
+	if in != nil || from == "" {
+		return in
+	}
+	return aws.String(from)
+}
@@ -0,0 +1,129 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	transitGatewayID = "tgw-0123456789abcdef0"
+)
+
+func TestGenerateTransitGatewayObservation(t *testing.T) {
+	cases := map[string]struct {
+		tgw  ec2.TransitGateway
+		want v1beta1.TransitGatewayObservation
+	}{
+		"AllFilled": {
+			tgw: ec2.TransitGateway{
+				TransitGatewayId:  aws.String(transitGatewayID),
+				TransitGatewayArn: aws.String("arn"),
+				OwnerId:           aws.String("123456789012"),
+				State:             ec2.TransitGatewayStateAvailable,
+				Options: &ec2.TransitGatewayOptions{
+					AssociationDefaultRouteTableId: aws.String("tgw-rtb-1"),
+					PropagationDefaultRouteTableId: aws.String("tgw-rtb-2"),
+				},
+			},
+			want: v1beta1.TransitGatewayObservation{
+				TransitGatewayID:               transitGatewayID,
+				TransitGatewayARN:              "arn",
+				OwnerID:                        "123456789012",
+				State:                          v1beta1.TransitGatewayStateAvailable,
+				AssociationDefaultRouteTableID: "tgw-rtb-1",
+				PropagationDefaultRouteTableID: "tgw-rtb-2",
+			},
+		},
+		"NoOptions": {
+			tgw: ec2.TransitGateway{
+				TransitGatewayId: aws.String(transitGatewayID),
+				State:            ec2.TransitGatewayStatePending,
+			},
+			want: v1beta1.TransitGatewayObservation{
+				TransitGatewayID: transitGatewayID,
+				State:            v1beta1.TransitGatewayStatePending,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateTransitGatewayObservation(tc.tgw)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeTransitGateway(t *testing.T) {
+	type args struct {
+		spec *v1beta1.TransitGatewayParameters
+		tgw  *ec2.TransitGateway
+	}
+	cases := map[string]struct {
+		args args
+		want *v1beta1.TransitGatewayParameters
+	}{
+		"AllOptionsEmpty": {
+			args: args{
+				spec: &v1beta1.TransitGatewayParameters{},
+				tgw: &ec2.TransitGateway{
+					Description: aws.String("desc"),
+					Options: &ec2.TransitGatewayOptions{
+						AmazonSideAsn:                aws.Int64(64512),
+						AutoAcceptSharedAttachments:  ec2.AutoAcceptSharedAttachmentsValueDisable,
+						DefaultRouteTableAssociation: ec2.DefaultRouteTableAssociationValueEnable,
+						DefaultRouteTablePropagation: ec2.DefaultRouteTablePropagationValueEnable,
+						DnsSupport:                   ec2.DnsSupportValueEnable,
+						VpnEcmpSupport:               ec2.VpnEcmpSupportValueEnable,
+					},
+				},
+			},
+			want: &v1beta1.TransitGatewayParameters{
+				Description:                  aws.String("desc"),
+				AmazonSideASN:                aws.Int64(64512),
+				AutoAcceptSharedAttachments:  aws.String("disable"),
+				DefaultRouteTableAssociation: aws.String("enable"),
+				DefaultRouteTablePropagation: aws.String("enable"),
+				DNSSupport:                   aws.String("enable"),
+				VPNECMPSupport:               aws.String("enable"),
+			},
+		},
+		"DoNotOverride": {
+			args: args{
+				spec: &v1beta1.TransitGatewayParameters{
+					DNSSupport: aws.String("disable"),
+				},
+				tgw: &ec2.TransitGateway{
+					Options: &ec2.TransitGatewayOptions{
+						DnsSupport: ec2.DnsSupportValueEnable,
+					},
+				},
+			},
+			want: &v1beta1.TransitGatewayParameters{
+				DNSSupport: aws.String("disable"),
+			},
+		},
+		"NilTransitGateway": {
+			args: args{
+				spec: &v1beta1.TransitGatewayParameters{},
+			},
+			want: &v1beta1.TransitGatewayParameters{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeTransitGateway(tc.args.spec, tc.args.tgw)
+			if diff := cmp.Diff(tc.want, tc.args.spec); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
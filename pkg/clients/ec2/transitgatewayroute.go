@@ -0,0 +1,99 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// TransitGatewayRouteClient is the external client used for
+// TransitGatewayRoute Custom Resource
+type TransitGatewayRouteClient interface {
+	CreateTransitGatewayRouteRequest(*ec2.CreateTransitGatewayRouteInput) ec2.CreateTransitGatewayRouteRequest
+	SearchTransitGatewayRoutesRequest(*ec2.SearchTransitGatewayRoutesInput) ec2.SearchTransitGatewayRoutesRequest
+	ReplaceTransitGatewayRouteRequest(*ec2.ReplaceTransitGatewayRouteInput) ec2.ReplaceTransitGatewayRouteRequest
+	DeleteTransitGatewayRouteRequest(*ec2.DeleteTransitGatewayRouteInput) ec2.DeleteTransitGatewayRouteRequest
+}
+
+// NewTransitGatewayRouteClient returns a new client using AWS credentials as JSON encoded data.
+func NewTransitGatewayRouteClient(cfg aws.Config) TransitGatewayRouteClient {
+	return ec2.New(cfg)
+}
+
+// IsTransitGatewayRouteNotFoundErr returns true if the error is because the
+// route or its route table doesn't exist
+func IsTransitGatewayRouteNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch awsErr.Code() {
+		case RouteNotFound, RouteTableIDNotFound:
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateSearchTransitGatewayRoutesInput returns the input to find the static
+// route with the destination of the supplied parameters.
+func GenerateSearchTransitGatewayRoutesInput(p v1beta1.TransitGatewayRouteParameters) *ec2.SearchTransitGatewayRoutesInput {
+	return &ec2.SearchTransitGatewayRoutesInput{
+		TransitGatewayRouteTableId: p.TransitGatewayRouteTableID,
+		Filters: []ec2.Filter{
+			{
+				Name:   aws.String("type"),
+				Values: []string{string(ec2.TransitGatewayRouteTypeStatic)},
+			},
+			{
+				Name:   aws.String("route-search.exact-match"),
+				Values: []string{p.DestinationCIDRBlock},
+			},
+		},
+	}
+}
+
+// GenerateCreateTransitGatewayRouteInput returns the input to create the
+// route with the supplied parameters.
+func GenerateCreateTransitGatewayRouteInput(p v1beta1.TransitGatewayRouteParameters) *ec2.CreateTransitGatewayRouteInput {
+	return &ec2.CreateTransitGatewayRouteInput{
+		TransitGatewayRouteTableId: p.TransitGatewayRouteTableID,
+		DestinationCidrBlock:       aws.String(p.DestinationCIDRBlock),
+		Blackhole:                  p.Blackhole,
+		TransitGatewayAttachmentId: awsclients.String(aws.StringValue(p.TransitGatewayAttachmentID)),
+	}
+}
+
+// GenerateReplaceTransitGatewayRouteInput returns the input to replace the
+// target of the route with the supplied parameters.
+func GenerateReplaceTransitGatewayRouteInput(p v1beta1.TransitGatewayRouteParameters) *ec2.ReplaceTransitGatewayRouteInput {
+	return &ec2.ReplaceTransitGatewayRouteInput{
+		TransitGatewayRouteTableId: p.TransitGatewayRouteTableID,
+		DestinationCidrBlock:       aws.String(p.DestinationCIDRBlock),
+		Blackhole:                  p.Blackhole,
+		TransitGatewayAttachmentId: awsclients.String(aws.StringValue(p.TransitGatewayAttachmentID)),
+	}
+}
+
+// GenerateTransitGatewayRouteObservation is used to produce
+// v1beta1.TransitGatewayRouteObservation from ec2.TransitGatewayRoute.
+func GenerateTransitGatewayRouteObservation(r ec2.TransitGatewayRoute) v1beta1.TransitGatewayRouteObservation {
+	return v1beta1.TransitGatewayRouteObservation{
+		State: string(r.State),
+		Type:  string(r.Type),
+	}
+}
+
+// IsTransitGatewayRouteUpToDate returns true if the route targets the desired
+// attachment, or no attachment if it should be a blackhole route.
+func IsTransitGatewayRouteUpToDate(p v1beta1.TransitGatewayRouteParameters, r ec2.TransitGatewayRoute) bool {
+	var current string
+	if len(r.TransitGatewayAttachments) != 0 {
+		current = aws.StringValue(r.TransitGatewayAttachments[0].TransitGatewayAttachmentId)
+	}
+	if aws.BoolValue(p.Blackhole) {
+		return current == ""
+	}
+	return current == aws.StringValue(p.TransitGatewayAttachmentID)
+}
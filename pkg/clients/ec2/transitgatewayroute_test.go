@@ -0,0 +1,65 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestIsTransitGatewayRouteUpToDate(t *testing.T) {
+	attached := ec2.TransitGatewayRoute{
+		TransitGatewayAttachments: []ec2.TransitGatewayRouteAttachment{
+			{TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID)},
+		},
+	}
+	type args struct {
+		p v1beta1.TransitGatewayRouteParameters
+		r ec2.TransitGatewayRoute
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameAttachment": {
+			args: args{
+				p: v1beta1.TransitGatewayRouteParameters{TransitGatewayAttachmentID: aws.String(transitGatewayAttachmentID)},
+				r: attached,
+			},
+			want: true,
+		},
+		"DifferentAttachment": {
+			args: args{
+				p: v1beta1.TransitGatewayRouteParameters{TransitGatewayAttachmentID: aws.String("tgw-attach-other")},
+				r: attached,
+			},
+			want: false,
+		},
+		"BlackholeWithAttachment": {
+			args: args{
+				p: v1beta1.TransitGatewayRouteParameters{Blackhole: aws.Bool(true)},
+				r: attached,
+			},
+			want: false,
+		},
+		"Blackhole": {
+			args: args{
+				p: v1beta1.TransitGatewayRouteParameters{Blackhole: aws.Bool(true)},
+				r: ec2.TransitGatewayRoute{State: ec2.TransitGatewayRouteStateBlackhole},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsTransitGatewayRouteUpToDate(tc.args.p, tc.args.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// TransitGatewayRouteTableClient is the external client used for
+// TransitGatewayRouteTable Custom Resource
+type TransitGatewayRouteTableClient interface {
+	CreateTransitGatewayRouteTableRequest(*ec2.CreateTransitGatewayRouteTableInput) ec2.CreateTransitGatewayRouteTableRequest
+	DescribeTransitGatewayRouteTablesRequest(*ec2.DescribeTransitGatewayRouteTablesInput) ec2.DescribeTransitGatewayRouteTablesRequest
+	DeleteTransitGatewayRouteTableRequest(*ec2.DeleteTransitGatewayRouteTableInput) ec2.DeleteTransitGatewayRouteTableRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewTransitGatewayRouteTableClient returns a new client using AWS credentials as JSON encoded data.
+func NewTransitGatewayRouteTableClient(cfg aws.Config) TransitGatewayRouteTableClient {
+	return ec2.New(cfg)
+}
+
+// TransitGatewayRouteTableAssociationClient is the external client used for
+// TransitGatewayRouteTableAssociation Custom Resource
+type TransitGatewayRouteTableAssociationClient interface {
+	AssociateTransitGatewayRouteTableRequest(*ec2.AssociateTransitGatewayRouteTableInput) ec2.AssociateTransitGatewayRouteTableRequest
+	GetTransitGatewayRouteTableAssociationsRequest(*ec2.GetTransitGatewayRouteTableAssociationsInput) ec2.GetTransitGatewayRouteTableAssociationsRequest
+	DisassociateTransitGatewayRouteTableRequest(*ec2.DisassociateTransitGatewayRouteTableInput) ec2.DisassociateTransitGatewayRouteTableRequest
+}
+
+// NewTransitGatewayRouteTableAssociationClient returns a new client using AWS credentials as JSON encoded data.
+func NewTransitGatewayRouteTableAssociationClient(cfg aws.Config) TransitGatewayRouteTableAssociationClient {
+	return ec2.New(cfg)
+}
+
+// TransitGatewayRouteTablePropagationClient is the external client used for
+// TransitGatewayRouteTablePropagation Custom Resource
+type TransitGatewayRouteTablePropagationClient interface {
+	EnableTransitGatewayRouteTablePropagationRequest(*ec2.EnableTransitGatewayRouteTablePropagationInput) ec2.EnableTransitGatewayRouteTablePropagationRequest
+	GetTransitGatewayRouteTablePropagationsRequest(*ec2.GetTransitGatewayRouteTablePropagationsInput) ec2.GetTransitGatewayRouteTablePropagationsRequest
+	DisableTransitGatewayRouteTablePropagationRequest(*ec2.DisableTransitGatewayRouteTablePropagationInput) ec2.DisableTransitGatewayRouteTablePropagationRequest
+}
+
+// NewTransitGatewayRouteTablePropagationClient returns a new client using AWS credentials as JSON encoded data.
+func NewTransitGatewayRouteTablePropagationClient(cfg aws.Config) TransitGatewayRouteTablePropagationClient {
+	return ec2.New(cfg)
+}
+
+// IsTransitGatewayRouteTableNotFoundErr returns true if the error is because
+// the transit gateway route table doesn't exist
+func IsTransitGatewayRouteTableNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == RouteTableIDNotFound {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateCreateTransitGatewayRouteTableInput returns the input to create a
+// transit gateway route table with the supplied parameters.
+func GenerateCreateTransitGatewayRouteTableInput(p v1beta1.TransitGatewayRouteTableParameters) *ec2.CreateTransitGatewayRouteTableInput {
+	input := &ec2.CreateTransitGatewayRouteTableInput{
+		TransitGatewayId: p.TransitGatewayID,
+	}
+	if len(p.Tags) != 0 {
+		input.TagSpecifications = []ec2.TagSpecification{
+			{
+				ResourceType: ec2.ResourceTypeTransitGatewayRouteTable,
+				Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+			},
+		}
+	}
+	return input
+}
+
+// IsTransitGatewayRouteTableUpToDate checks whether the tags of the transit
+// gateway route table are up to date. The other parameters cannot be
+// modified.
+func IsTransitGatewayRouteTableUpToDate(p v1beta1.TransitGatewayRouteTableParameters, rt ec2.TransitGatewayRouteTable) bool {
+	return v1beta1.CompareTags(p.Tags, rt.Tags)
+}
+
+// GenerateTransitGatewayRouteTableObservation is used to produce
+// v1beta1.TransitGatewayRouteTableObservation from
+// ec2.TransitGatewayRouteTable.
+func GenerateTransitGatewayRouteTableObservation(rt ec2.TransitGatewayRouteTable) v1beta1.TransitGatewayRouteTableObservation {
+	return v1beta1.TransitGatewayRouteTableObservation{
+		TransitGatewayRouteTableID:   aws.StringValue(rt.TransitGatewayRouteTableId),
+		State:                        string(rt.State),
+		DefaultAssociationRouteTable: aws.BoolValue(rt.DefaultAssociationRouteTable),
+		DefaultPropagationRouteTable: aws.BoolValue(rt.DefaultPropagationRouteTable),
+	}
+}
+
+// TransitGatewayAttachmentFilter returns the filter that matches the
+// associations and propagations of the supplied attachment.
+func TransitGatewayAttachmentFilter(attachmentID string) []ec2.Filter {
+	return []ec2.Filter{
+		{
+			Name:   aws.String("transit-gateway-attachment-id"),
+			Values: []string{attachmentID},
+		},
+	}
+}
+
+// GenerateTransitGatewayRouteTableAssociationObservation is used to produce
+// v1beta1.TransitGatewayRouteTableAttachmentObservation from
+// ec2.TransitGatewayRouteTableAssociation.
+func GenerateTransitGatewayRouteTableAssociationObservation(a ec2.TransitGatewayRouteTableAssociation) v1beta1.TransitGatewayRouteTableAttachmentObservation {
+	return v1beta1.TransitGatewayRouteTableAttachmentObservation{
+		State:        string(a.State),
+		ResourceID:   aws.StringValue(a.ResourceId),
+		ResourceType: string(a.ResourceType),
+	}
+}
+
+// GenerateTransitGatewayRouteTablePropagationObservation is used to produce
+// v1beta1.TransitGatewayRouteTableAttachmentObservation from
+// ec2.TransitGatewayRouteTablePropagation.
+func GenerateTransitGatewayRouteTablePropagationObservation(p ec2.TransitGatewayRouteTablePropagation) v1beta1.TransitGatewayRouteTableAttachmentObservation {
+	return v1beta1.TransitGatewayRouteTableAttachmentObservation{
+		State:        string(p.State),
+		ResourceID:   aws.StringValue(p.ResourceId),
+		ResourceType: string(p.ResourceType),
+	}
+}
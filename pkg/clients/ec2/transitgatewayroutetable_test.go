@@ -0,0 +1,197 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	transitGatewayRouteTableID = "tgw-rtb-0123456789abcdef0"
+)
+
+func TestIsTransitGatewayRouteTableNotFoundErr(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"NotFound": {
+			err:  awserr.New(RouteTableIDNotFound, "", nil),
+			want: true,
+		},
+		"OtherAWSError": {
+			err:  awserr.New("InvalidParameterValue", "", nil),
+			want: false,
+		},
+		"NotAWSError": {
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsTransitGatewayRouteTableNotFoundErr(tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCreateTransitGatewayRouteTableInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1beta1.TransitGatewayRouteTableParameters
+		want *ec2.CreateTransitGatewayRouteTableInput
+	}{
+		"NoTags": {
+			p: v1beta1.TransitGatewayRouteTableParameters{
+				TransitGatewayID: aws.String(transitGatewayID),
+			},
+			want: &ec2.CreateTransitGatewayRouteTableInput{
+				TransitGatewayId: aws.String(transitGatewayID),
+			},
+		},
+		"WithTags": {
+			p: v1beta1.TransitGatewayRouteTableParameters{
+				TransitGatewayID: aws.String(transitGatewayID),
+				Tags:             []v1beta1.Tag{{Key: "k", Value: "v"}},
+			},
+			want: &ec2.CreateTransitGatewayRouteTableInput{
+				TransitGatewayId: aws.String(transitGatewayID),
+				TagSpecifications: []ec2.TagSpecification{{
+					ResourceType: ec2.ResourceTypeTransitGatewayRouteTable,
+					Tags:         []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+				}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateTransitGatewayRouteTableInput(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsTransitGatewayRouteTableUpToDate(t *testing.T) {
+	type args struct {
+		p  v1beta1.TransitGatewayRouteTableParameters
+		rt ec2.TransitGatewayRouteTable
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p:  v1beta1.TransitGatewayRouteTableParameters{Tags: []v1beta1.Tag{{Key: "k", Value: "v"}}},
+				rt: ec2.TransitGatewayRouteTable{Tags: []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}}},
+			},
+			want: true,
+		},
+		"TagsChanged": {
+			args: args{
+				p:  v1beta1.TransitGatewayRouteTableParameters{Tags: []v1beta1.Tag{{Key: "k", Value: "new"}}},
+				rt: ec2.TransitGatewayRouteTable{Tags: []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsTransitGatewayRouteTableUpToDate(tc.args.p, tc.args.rt)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateTransitGatewayRouteTableObservation(t *testing.T) {
+	cases := map[string]struct {
+		rt   ec2.TransitGatewayRouteTable
+		want v1beta1.TransitGatewayRouteTableObservation
+	}{
+		"AllFilled": {
+			rt: ec2.TransitGatewayRouteTable{
+				TransitGatewayRouteTableId:   aws.String(transitGatewayRouteTableID),
+				State:                        ec2.TransitGatewayRouteTableStateAvailable,
+				DefaultAssociationRouteTable: aws.Bool(true),
+				DefaultPropagationRouteTable: aws.Bool(false),
+			},
+			want: v1beta1.TransitGatewayRouteTableObservation{
+				TransitGatewayRouteTableID:   transitGatewayRouteTableID,
+				State:                        v1beta1.TransitGatewayRouteTableStateAvailable,
+				DefaultAssociationRouteTable: true,
+			},
+		},
+		"Empty": {
+			rt:   ec2.TransitGatewayRouteTable{},
+			want: v1beta1.TransitGatewayRouteTableObservation{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateTransitGatewayRouteTableObservation(tc.rt)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayAttachmentFilter(t *testing.T) {
+	want := []ec2.Filter{{
+		Name:   aws.String("transit-gateway-attachment-id"),
+		Values: []string{transitGatewayAttachmentID},
+	}}
+	if diff := cmp.Diff(want, TransitGatewayAttachmentFilter(transitGatewayAttachmentID)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateTransitGatewayRouteTableAssociationObservation(t *testing.T) {
+	a := ec2.TransitGatewayRouteTableAssociation{
+		TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+		ResourceId:                 aws.String("vpc-1"),
+		ResourceType:               ec2.TransitGatewayAttachmentResourceTypeVpc,
+		State:                      ec2.TransitGatewayAssociationStateAssociated,
+	}
+	want := v1beta1.TransitGatewayRouteTableAttachmentObservation{
+		State:        v1beta1.TransitGatewayAssociationStateAssociated,
+		ResourceID:   "vpc-1",
+		ResourceType: "vpc",
+	}
+	if diff := cmp.Diff(want, GenerateTransitGatewayRouteTableAssociationObservation(a)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateTransitGatewayRouteTablePropagationObservation(t *testing.T) {
+	p := ec2.TransitGatewayRouteTablePropagation{
+		TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+		ResourceId:                 aws.String("vpc-1"),
+		ResourceType:               ec2.TransitGatewayAttachmentResourceTypeVpc,
+		State:                      ec2.TransitGatewayPropagationStateEnabled,
+	}
+	want := v1beta1.TransitGatewayRouteTableAttachmentObservation{
+		State:        v1beta1.TransitGatewayPropagationStateEnabled,
+		ResourceID:   "vpc-1",
+		ResourceType: "vpc",
+	}
+	if diff := cmp.Diff(want, GenerateTransitGatewayRouteTablePropagationObservation(p)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,124 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+const (
+	// TransitGatewayAttachmentIDNotFound is the code that is returned by ec2
+	// when the given transit gateway attachment ID is not valid.
+	TransitGatewayAttachmentIDNotFound = "InvalidTransitGatewayAttachmentID.NotFound"
+)
+
+// TransitGatewayVPCAttachmentClient is the external client used for
+// TransitGatewayVPCAttachment Custom Resource
+type TransitGatewayVPCAttachmentClient interface {
+	CreateTransitGatewayVpcAttachmentRequest(*ec2.CreateTransitGatewayVpcAttachmentInput) ec2.CreateTransitGatewayVpcAttachmentRequest
+	DescribeTransitGatewayVpcAttachmentsRequest(*ec2.DescribeTransitGatewayVpcAttachmentsInput) ec2.DescribeTransitGatewayVpcAttachmentsRequest
+	ModifyTransitGatewayVpcAttachmentRequest(*ec2.ModifyTransitGatewayVpcAttachmentInput) ec2.ModifyTransitGatewayVpcAttachmentRequest
+	DeleteTransitGatewayVpcAttachmentRequest(*ec2.DeleteTransitGatewayVpcAttachmentInput) ec2.DeleteTransitGatewayVpcAttachmentRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewTransitGatewayVPCAttachmentClient returns a new client using AWS credentials as JSON encoded data.
+func NewTransitGatewayVPCAttachmentClient(cfg aws.Config) TransitGatewayVPCAttachmentClient {
+	return ec2.New(cfg)
+}
+
+// IsTransitGatewayAttachmentNotFoundErr returns true if the error is because the item doesn't exist
+func IsTransitGatewayAttachmentNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == TransitGatewayAttachmentIDNotFound {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateCreateTransitGatewayVPCAttachmentInput returns the input to create
+// a transit gateway VPC attachment with the supplied parameters.
+func GenerateCreateTransitGatewayVPCAttachmentInput(p v1beta1.TransitGatewayVPCAttachmentParameters) *ec2.CreateTransitGatewayVpcAttachmentInput {
+	input := &ec2.CreateTransitGatewayVpcAttachmentInput{
+		TransitGatewayId: p.TransitGatewayID,
+		VpcId:            p.VPCID,
+		SubnetIds:        p.SubnetIDs,
+		Options: &ec2.CreateTransitGatewayVpcAttachmentRequestOptions{
+			DnsSupport:  ec2.DnsSupportValue(aws.StringValue(p.DNSSupport)),
+			Ipv6Support: ec2.Ipv6SupportValue(aws.StringValue(p.IPv6Support)),
+		},
+	}
+	if len(p.Tags) != 0 {
+		input.TagSpecifications = []ec2.TagSpecification{
+			{
+				ResourceType: ec2.ResourceTypeTransitGatewayAttachment,
+				Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+			},
+		}
+	}
+	return input
+}
+
+// GenerateTransitGatewayVPCAttachmentObservation is used to produce
+// v1beta1.TransitGatewayVPCAttachmentObservation from
+// ec2.TransitGatewayVpcAttachment.
+func GenerateTransitGatewayVPCAttachmentObservation(a ec2.TransitGatewayVpcAttachment) v1beta1.TransitGatewayVPCAttachmentObservation {
+	return v1beta1.TransitGatewayVPCAttachmentObservation{
+		TransitGatewayAttachmentID: aws.StringValue(a.TransitGatewayAttachmentId),
+		State:                      string(a.State),
+		VPCOwnerID:                 aws.StringValue(a.VpcOwnerId),
+	}
+}
+
+// LateInitializeTransitGatewayVPCAttachment fills the empty fields in
+// *v1beta1.TransitGatewayVPCAttachmentParameters with the values seen in
+// ec2.TransitGatewayVpcAttachment.
+func LateInitializeTransitGatewayVPCAttachment(in *v1beta1.TransitGatewayVPCAttachmentParameters, a *ec2.TransitGatewayVpcAttachment) {
+	if a == nil || a.Options == nil {
+		return
+	}
+	in.DNSSupport = lateInitializeEnum(in.DNSSupport, string(a.Options.DnsSupport))
+	in.IPv6Support = lateInitializeEnum(in.IPv6Support, string(a.Options.Ipv6Support))
+}
+
+// IsTransitGatewayVPCAttachmentUpToDate checks whether there is a change in
+// any of the modifiable fields.
+func IsTransitGatewayVPCAttachmentUpToDate(p v1beta1.TransitGatewayVPCAttachmentParameters, a ec2.TransitGatewayVpcAttachment) bool {
+	return GenerateModifyTransitGatewayVPCAttachmentInput(p, a) == nil && v1beta1.CompareTags(p.Tags, a.Tags)
+}
+
+// GenerateModifyTransitGatewayVPCAttachmentInput returns the input to bring
+// the subnets and options of the attachment to the desired state, or nil if
+// no modification is needed.
+func GenerateModifyTransitGatewayVPCAttachmentInput(p v1beta1.TransitGatewayVPCAttachmentParameters, a ec2.TransitGatewayVpcAttachment) *ec2.ModifyTransitGatewayVpcAttachmentInput {
+	input := &ec2.ModifyTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: a.TransitGatewayAttachmentId,
+		Options:                    &ec2.ModifyTransitGatewayVpcAttachmentRequestOptions{},
+	}
+	changed := false
+	input.AddSubnetIds, input.RemoveSubnetIds = DiffVPCEndpointIDs(p.SubnetIDs, a.SubnetIds)
+	if len(input.AddSubnetIds) != 0 || len(input.RemoveSubnetIds) != 0 {
+		changed = true
+	}
+	var current ec2.TransitGatewayVpcAttachmentOptions
+	if a.Options != nil {
+		current = *a.Options
+	}
+	if p.DNSSupport != nil && *p.DNSSupport != string(current.DnsSupport) {
+		input.Options.DnsSupport = ec2.DnsSupportValue(*p.DNSSupport)
+		changed = true
+	}
+	if p.IPv6Support != nil && *p.IPv6Support != string(current.Ipv6Support) {
+		input.Options.Ipv6Support = ec2.Ipv6SupportValue(*p.IPv6Support)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return input
+}
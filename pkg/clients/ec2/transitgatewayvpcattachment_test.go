@@ -0,0 +1,90 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	transitGatewayAttachmentID = "tgw-attach-0123456789abcdef0"
+)
+
+func TestGenerateModifyTransitGatewayVPCAttachmentInput(t *testing.T) {
+	type args struct {
+		p v1beta1.TransitGatewayVPCAttachmentParameters
+		a ec2.TransitGatewayVpcAttachment
+	}
+	cases := map[string]struct {
+		args args
+		want *ec2.ModifyTransitGatewayVpcAttachmentInput
+	}{
+		"UpToDate": {
+			args: args{
+				p: v1beta1.TransitGatewayVPCAttachmentParameters{
+					SubnetIDs:  []string{"subnet-1", "subnet-2"},
+					DNSSupport: aws.String("enable"),
+				},
+				a: ec2.TransitGatewayVpcAttachment{
+					TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+					SubnetIds:                  []string{"subnet-2", "subnet-1"},
+					Options: &ec2.TransitGatewayVpcAttachmentOptions{
+						DnsSupport: ec2.DnsSupportValueEnable,
+					},
+				},
+			},
+			want: nil,
+		},
+		"SubnetsChanged": {
+			args: args{
+				p: v1beta1.TransitGatewayVPCAttachmentParameters{
+					SubnetIDs: []string{"subnet-1", "subnet-3"},
+				},
+				a: ec2.TransitGatewayVpcAttachment{
+					TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+					SubnetIds:                  []string{"subnet-1", "subnet-2"},
+				},
+			},
+			want: &ec2.ModifyTransitGatewayVpcAttachmentInput{
+				TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+				AddSubnetIds:               []string{"subnet-3"},
+				RemoveSubnetIds:            []string{"subnet-2"},
+				Options:                    &ec2.ModifyTransitGatewayVpcAttachmentRequestOptions{},
+			},
+		},
+		"OptionsChanged": {
+			args: args{
+				p: v1beta1.TransitGatewayVPCAttachmentParameters{
+					SubnetIDs:   []string{"subnet-1"},
+					IPv6Support: aws.String("enable"),
+				},
+				a: ec2.TransitGatewayVpcAttachment{
+					TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+					SubnetIds:                  []string{"subnet-1"},
+					Options: &ec2.TransitGatewayVpcAttachmentOptions{
+						Ipv6Support: ec2.Ipv6SupportValueDisable,
+					},
+				},
+			},
+			want: &ec2.ModifyTransitGatewayVpcAttachmentInput{
+				TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+				Options: &ec2.ModifyTransitGatewayVpcAttachmentRequestOptions{
+					Ipv6Support: ec2.Ipv6SupportValueEnable,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyTransitGatewayVPCAttachmentInput(tc.args.p, tc.args.a)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package ec2
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// VPCEndpointIDNotFound is the code that is returned by ec2 when the
+	// given VPC endpoint ID is not valid.
+	VPCEndpointIDNotFound = "InvalidVpcEndpointId.NotFound"
+
+	// resourceTypeVPCEndpoint is the resource type of VPC endpoints in tag
+	// specifications, which the SDK does not define.
+	resourceTypeVPCEndpoint ec2.ResourceType = "vpc-endpoint"
+)
+
+// VPCEndpointClient is the external client used for VPCEndpoint Custom Resource
+type VPCEndpointClient interface {
+	CreateVpcEndpointRequest(*ec2.CreateVpcEndpointInput) ec2.CreateVpcEndpointRequest
+	DescribeVpcEndpointsRequest(*ec2.DescribeVpcEndpointsInput) ec2.DescribeVpcEndpointsRequest
+	ModifyVpcEndpointRequest(*ec2.ModifyVpcEndpointInput) ec2.ModifyVpcEndpointRequest
+	DeleteVpcEndpointsRequest(*ec2.DeleteVpcEndpointsInput) ec2.DeleteVpcEndpointsRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewVPCEndpointClient returns a new client using AWS credentials as JSON encoded data.
+func NewVPCEndpointClient(cfg aws.Config) VPCEndpointClient {
+	return ec2.New(cfg)
+}
+
+// IsVPCEndpointNotFoundErr returns true if the error is because the item doesn't exist
+func IsVPCEndpointNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == VPCEndpointIDNotFound {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateCreateVPCEndpointInput returns the input to create a VPC endpoint
+// with the supplied parameters. The client token makes retries of the call
+// idempotent.
+func GenerateCreateVPCEndpointInput(clientToken string, p v1alpha1.VPCEndpointParameters) *ec2.CreateVpcEndpointInput {
+	input := &ec2.CreateVpcEndpointInput{
+		ClientToken:       aws.String(clientToken),
+		VpcId:             p.VPCID,
+		ServiceName:       aws.String(p.ServiceName),
+		VpcEndpointType:   ec2.VpcEndpointType(aws.StringValue(p.VPCEndpointType)),
+		PolicyDocument:    p.PolicyDocument,
+		PrivateDnsEnabled: p.PrivateDNSEnabled,
+		SubnetIds:         p.SubnetIDs,
+		SecurityGroupIds:  p.SecurityGroupIDs,
+		RouteTableIds:     p.RouteTableIDs,
+	}
+	if len(p.Tags) != 0 {
+		input.TagSpecifications = []ec2.TagSpecification{
+			{
+				ResourceType: resourceTypeVPCEndpoint,
+				Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+			},
+		}
+	}
+	return input
+}
+
+// GenerateVPCEndpointObservation is used to produce
+// v1alpha1.VPCEndpointObservation from ec2.VpcEndpoint.
+func GenerateVPCEndpointObservation(e ec2.VpcEndpoint) v1alpha1.VPCEndpointObservation {
+	o := v1alpha1.VPCEndpointObservation{
+		VPCEndpointID:       aws.StringValue(e.VpcEndpointId),
+		State:               string(e.State),
+		OwnerID:             aws.StringValue(e.OwnerId),
+		NetworkInterfaceIDs: e.NetworkInterfaceIds,
+	}
+	if len(e.DnsEntries) != 0 {
+		o.DNSEntries = make([]v1alpha1.DNSEntry, len(e.DnsEntries))
+		for i, d := range e.DnsEntries {
+			o.DNSEntries[i] = v1alpha1.DNSEntry{
+				DNSName:      aws.StringValue(d.DnsName),
+				HostedZoneID: aws.StringValue(d.HostedZoneId),
+			}
+		}
+	}
+	if e.CreationTimestamp != nil {
+		o.CreationTimestamp = &metav1.Time{Time: *e.CreationTimestamp}
+	}
+	return o
+}
+
+// LateInitializeVPCEndpoint fills the empty fields in
+// *v1alpha1.VPCEndpointParameters with the values seen in ec2.VpcEndpoint.
+func LateInitializeVPCEndpoint(in *v1alpha1.VPCEndpointParameters, e *ec2.VpcEndpoint) {
+	if e == nil {
+		return
+	}
+
+	in.PolicyDocument = awsclients.LateInitializeStringPtr(in.PolicyDocument, e.PolicyDocument)
+	in.PrivateDNSEnabled = awsclients.LateInitializeBoolPtr(in.PrivateDNSEnabled, e.PrivateDnsEnabled)
+	if in.VPCEndpointType == nil && e.VpcEndpointType != "" {
+		in.VPCEndpointType = aws.String(string(e.VpcEndpointType))
+	}
+	if len(in.SecurityGroupIDs) == 0 && len(e.Groups) != 0 {
+		in.SecurityGroupIDs = make([]string, len(e.Groups))
+		for k, g := range e.Groups {
+			in.SecurityGroupIDs[k] = aws.StringValue(g.GroupId)
+		}
+	}
+}
+
+// IsVPCEndpointUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsVPCEndpointUpToDate(p v1alpha1.VPCEndpointParameters, e ec2.VpcEndpoint) bool {
+	return isVPCEndpointConfigUpToDate(p, e) && v1beta1.CompareTags(p.Tags, e.Tags)
+}
+
+// isVPCEndpointConfigUpToDate checks whether there is a change in any of the
+// fields that are modified with ModifyVpcEndpoint.
+func isVPCEndpointConfigUpToDate(p v1alpha1.VPCEndpointParameters, e ec2.VpcEndpoint) bool {
+	if add, remove := DiffVPCEndpointIDs(p.SubnetIDs, e.SubnetIds); len(add) != 0 || len(remove) != 0 {
+		return false
+	}
+	if add, remove := DiffVPCEndpointIDs(p.SecurityGroupIDs, vpcEndpointSecurityGroupIDs(e)); len(add) != 0 || len(remove) != 0 {
+		return false
+	}
+	if add, remove := DiffVPCEndpointIDs(p.RouteTableIDs, e.RouteTableIds); len(add) != 0 || len(remove) != 0 {
+		return false
+	}
+	if p.PrivateDNSEnabled != nil && aws.BoolValue(p.PrivateDNSEnabled) != aws.BoolValue(e.PrivateDnsEnabled) {
+		return false
+	}
+	return IsVPCEndpointPolicyUpToDate(p.PolicyDocument, e.PolicyDocument)
+}
+
+// GenerateModifyVPCEndpointInput returns the input to bring the VPC endpoint
+// to the desired state, or nil if no modification is needed.
+func GenerateModifyVPCEndpointInput(p v1alpha1.VPCEndpointParameters, e ec2.VpcEndpoint) *ec2.ModifyVpcEndpointInput {
+	if isVPCEndpointConfigUpToDate(p, e) {
+		return nil
+	}
+	input := &ec2.ModifyVpcEndpointInput{VpcEndpointId: e.VpcEndpointId}
+	input.AddSubnetIds, input.RemoveSubnetIds = DiffVPCEndpointIDs(p.SubnetIDs, e.SubnetIds)
+	input.AddSecurityGroupIds, input.RemoveSecurityGroupIds = DiffVPCEndpointIDs(p.SecurityGroupIDs, vpcEndpointSecurityGroupIDs(e))
+	input.AddRouteTableIds, input.RemoveRouteTableIds = DiffVPCEndpointIDs(p.RouteTableIDs, e.RouteTableIds)
+	if p.PrivateDNSEnabled != nil && aws.BoolValue(p.PrivateDNSEnabled) != aws.BoolValue(e.PrivateDnsEnabled) {
+		input.PrivateDnsEnabled = p.PrivateDNSEnabled
+	}
+	if !IsVPCEndpointPolicyUpToDate(p.PolicyDocument, e.PolicyDocument) {
+		input.PolicyDocument = p.PolicyDocument
+	}
+	return input
+}
+
+// IsVPCEndpointPolicyUpToDate returns true if the desired policy document is
+// not set or semantically equal to the current policy document.
+func IsVPCEndpointPolicyUpToDate(desired, current *string) bool {
+	if desired == nil {
+		return true
+	}
+	if current == nil {
+		return false
+	}
+	var d, c interface{}
+	if err := json.Unmarshal([]byte(*desired), &d); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(*current), &c); err != nil {
+		return false
+	}
+	return cmp.Equal(d, c)
+}
+
+// DiffVPCEndpointIDs returns the IDs that need to be added to and removed
+// from the current IDs to match the desired IDs. Nothing is removed if no ID
+// is desired, so that the IDs that AWS assigns by default are kept.
+func DiffVPCEndpointIDs(desired, current []string) (add []string, remove []string) {
+	if len(desired) == 0 {
+		return nil, nil
+	}
+	c := make(map[string]bool, len(current))
+	for _, id := range current {
+		c[id] = true
+	}
+	d := make(map[string]bool, len(desired))
+	for _, id := range desired {
+		d[id] = true
+		if !c[id] {
+			add = append(add, id)
+		}
+	}
+	for _, id := range current {
+		if !d[id] {
+			remove = append(remove, id)
+		}
+	}
+	sort.Strings(add)
+	sort.Strings(remove)
+	return add, remove
+}
+
+func vpcEndpointSecurityGroupIDs(e ec2.VpcEndpoint) []string {
+	if len(e.Groups) == 0 {
+		return nil
+	}
+	ids := make([]string, len(e.Groups))
+	for i, g := range e.Groups {
+		ids[i] = aws.StringValue(g.GroupId)
+	}
+	return ids
+}
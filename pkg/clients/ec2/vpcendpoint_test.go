@@ -0,0 +1,211 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	vpcEndpointID  = "vpce-0123456789abcdef0"
+	endpointPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"*","Resource":"*"}]}`
+	// The policy as returned by ec2, with different formatting.
+	endpointPolicyFormatted = `{
+  "Version" : "2012-10-17",
+  "Statement" : [ { "Effect" : "Allow", "Principal" : "*", "Action" : "*", "Resource" : "*" } ]
+}`
+)
+
+func vpcEndpointParams(m ...func(*v1alpha1.VPCEndpointParameters)) v1alpha1.VPCEndpointParameters {
+	p := v1alpha1.VPCEndpointParameters{
+		ServiceName:       "com.amazonaws.us-east-1.sts",
+		VPCEndpointType:   aws.String(v1alpha1.VPCEndpointTypeInterface),
+		PrivateDNSEnabled: aws.Bool(true),
+		PolicyDocument:    aws.String(endpointPolicy),
+		SubnetIDs:         []string{"subnet-1", "subnet-2"},
+		SecurityGroupIDs:  []string{"sg-1"},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func vpcEndpoint(m ...func(*ec2.VpcEndpoint)) ec2.VpcEndpoint {
+	e := ec2.VpcEndpoint{
+		VpcEndpointId:     aws.String(vpcEndpointID),
+		VpcEndpointType:   ec2.VpcEndpointTypeInterface,
+		PrivateDnsEnabled: aws.Bool(true),
+		PolicyDocument:    aws.String(endpointPolicyFormatted),
+		SubnetIds:         []string{"subnet-2", "subnet-1"},
+		Groups:            []ec2.SecurityGroupIdentifier{{GroupId: aws.String("sg-1")}},
+	}
+	for _, f := range m {
+		f(&e)
+	}
+	return e
+}
+
+func TestLateInitializeVPCEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.VPCEndpointParameters
+		e    *ec2.VpcEndpoint
+		want v1alpha1.VPCEndpointParameters
+	}{
+		"AllFilledExternalDifferent": {
+			p: vpcEndpointParams(),
+			e: &ec2.VpcEndpoint{
+				VpcEndpointType:   ec2.VpcEndpointTypeGateway,
+				PrivateDnsEnabled: aws.Bool(false, aws.FieldRequired),
+				PolicyDocument:    aws.String("{}"),
+				Groups:            []ec2.SecurityGroupIdentifier{{GroupId: aws.String("sg-2")}},
+			},
+			want: vpcEndpointParams(),
+		},
+		"EmptyFilledByExternal": {
+			p: v1alpha1.VPCEndpointParameters{},
+			e: &ec2.VpcEndpoint{
+				VpcEndpointType:   ec2.VpcEndpointTypeInterface,
+				PrivateDnsEnabled: aws.Bool(true),
+				PolicyDocument:    aws.String(endpointPolicy),
+				Groups:            []ec2.SecurityGroupIdentifier{{GroupId: aws.String("sg-1")}},
+			},
+			want: v1alpha1.VPCEndpointParameters{
+				VPCEndpointType:   aws.String(v1alpha1.VPCEndpointTypeInterface),
+				PrivateDNSEnabled: aws.Bool(true),
+				PolicyDocument:    aws.String(endpointPolicy),
+				SecurityGroupIDs:  []string{"sg-1"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeVPCEndpoint(&tc.p, tc.e)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsVPCEndpointUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.VPCEndpointParameters
+		e    ec2.VpcEndpoint
+		want bool
+	}{
+		"UpToDate": {
+			p:    vpcEndpointParams(),
+			e:    vpcEndpoint(),
+			want: true,
+		},
+		"SubnetAdded": {
+			p: vpcEndpointParams(func(p *v1alpha1.VPCEndpointParameters) {
+				p.SubnetIDs = append(p.SubnetIDs, "subnet-3")
+			}),
+			e: vpcEndpoint(),
+		},
+		"SecurityGroupChanged": {
+			p: vpcEndpointParams(func(p *v1alpha1.VPCEndpointParameters) {
+				p.SecurityGroupIDs = []string{"sg-2"}
+			}),
+			e: vpcEndpoint(),
+		},
+		"PrivateDNSChanged": {
+			p: vpcEndpointParams(func(p *v1alpha1.VPCEndpointParameters) {
+				p.PrivateDNSEnabled = aws.Bool(false, aws.FieldRequired)
+			}),
+			e: vpcEndpoint(),
+		},
+		"PolicyChanged": {
+			p: vpcEndpointParams(func(p *v1alpha1.VPCEndpointParameters) {
+				p.PolicyDocument = aws.String(`{"Version":"2012-10-17","Statement":[]}`)
+			}),
+			e: vpcEndpoint(),
+		},
+		"TagsChanged": {
+			p: vpcEndpointParams(func(p *v1alpha1.VPCEndpointParameters) {
+				p.Tags = []v1beta1.Tag{{Key: "k", Value: "v"}}
+			}),
+			e: vpcEndpoint(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsVPCEndpointUpToDate(tc.p, tc.e)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateModifyVPCEndpointInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.VPCEndpointParameters
+		e    ec2.VpcEndpoint
+		want *ec2.ModifyVpcEndpointInput
+	}{
+		"UpToDate": {
+			p: vpcEndpointParams(),
+			e: vpcEndpoint(),
+		},
+		"TagsOnly": {
+			p: vpcEndpointParams(func(p *v1alpha1.VPCEndpointParameters) {
+				p.Tags = []v1beta1.Tag{{Key: "k", Value: "v"}}
+			}),
+			e: vpcEndpoint(),
+		},
+		"Changed": {
+			p: vpcEndpointParams(func(p *v1alpha1.VPCEndpointParameters) {
+				p.SubnetIDs = []string{"subnet-1", "subnet-3"}
+				p.SecurityGroupIDs = []string{"sg-2"}
+				p.PrivateDNSEnabled = aws.Bool(false, aws.FieldRequired)
+			}),
+			e: vpcEndpoint(),
+			want: &ec2.ModifyVpcEndpointInput{
+				VpcEndpointId:          aws.String(vpcEndpointID),
+				AddSubnetIds:           []string{"subnet-3"},
+				RemoveSubnetIds:        []string{"subnet-2"},
+				AddSecurityGroupIds:    []string{"sg-2"},
+				RemoveSecurityGroupIds: []string{"sg-1"},
+				PrivateDnsEnabled:      aws.Bool(false, aws.FieldRequired),
+			},
+		},
+		"RouteTables": {
+			p: v1alpha1.VPCEndpointParameters{
+				VPCEndpointType: aws.String(v1alpha1.VPCEndpointTypeGateway),
+				RouteTableIDs:   []string{"rtb-1", "rtb-2"},
+				PolicyDocument:  aws.String(endpointPolicy),
+			},
+			e: ec2.VpcEndpoint{
+				VpcEndpointId:   aws.String(vpcEndpointID),
+				VpcEndpointType: ec2.VpcEndpointTypeGateway,
+				RouteTableIds:   []string{"rtb-1"},
+				PolicyDocument:  aws.String("{}"),
+			},
+			want: &ec2.ModifyVpcEndpointInput{
+				VpcEndpointId:    aws.String(vpcEndpointID),
+				AddRouteTableIds: []string{"rtb-2"},
+				PolicyDocument:   aws.String(endpointPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyVPCEndpointInput(tc.p, tc.e)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(ec2.ModifyVpcEndpointInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// VPCPeeringConnectionIDNotFound is the code that is returned by ec2 when
+	// the given VPC peering connection ID is not valid.
+	VPCPeeringConnectionIDNotFound = "InvalidVpcPeeringConnectionID.NotFound"
+)
+
+// VPCPeeringConnectionClient is the external client used for
+// VPCPeeringConnection and VPCPeeringConnectionAccepter Custom Resources
+type VPCPeeringConnectionClient interface {
+	CreateVpcPeeringConnectionRequest(*ec2.CreateVpcPeeringConnectionInput) ec2.CreateVpcPeeringConnectionRequest
+	DescribeVpcPeeringConnectionsRequest(*ec2.DescribeVpcPeeringConnectionsInput) ec2.DescribeVpcPeeringConnectionsRequest
+	AcceptVpcPeeringConnectionRequest(*ec2.AcceptVpcPeeringConnectionInput) ec2.AcceptVpcPeeringConnectionRequest
+	ModifyVpcPeeringConnectionOptionsRequest(*ec2.ModifyVpcPeeringConnectionOptionsInput) ec2.ModifyVpcPeeringConnectionOptionsRequest
+	DeleteVpcPeeringConnectionRequest(*ec2.DeleteVpcPeeringConnectionInput) ec2.DeleteVpcPeeringConnectionRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewVPCPeeringConnectionClient returns a new client using AWS credentials as JSON encoded data.
+func NewVPCPeeringConnectionClient(cfg aws.Config) VPCPeeringConnectionClient {
+	return ec2.New(cfg)
+}
+
+// IsVPCPeeringConnectionNotFoundErr returns true if the error is because the item doesn't exist
+func IsVPCPeeringConnectionNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == VPCPeeringConnectionIDNotFound {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateCreateVPCPeeringConnectionInput returns the input to request a VPC
+// peering connection with the supplied parameters.
+func GenerateCreateVPCPeeringConnectionInput(p v1beta1.VPCPeeringConnectionParameters) *ec2.CreateVpcPeeringConnectionInput {
+	return &ec2.CreateVpcPeeringConnectionInput{
+		VpcId:       p.VPCID,
+		PeerVpcId:   p.PeerVPCID,
+		PeerOwnerId: p.PeerOwnerID,
+		PeerRegion:  p.PeerRegion,
+	}
+}
+
+// GenerateVPCPeeringConnectionObservation is used to produce
+// v1beta1.VPCPeeringConnectionObservation from ec2.VpcPeeringConnection.
+func GenerateVPCPeeringConnectionObservation(c ec2.VpcPeeringConnection) v1beta1.VPCPeeringConnectionObservation {
+	o := v1beta1.VPCPeeringConnectionObservation{
+		VPCPeeringConnectionID: aws.StringValue(c.VpcPeeringConnectionId),
+	}
+	if c.Status != nil {
+		o.Status = string(c.Status.Code)
+		o.StatusMessage = aws.StringValue(c.Status.Message)
+	}
+	if c.ExpirationTime != nil {
+		o.ExpirationTime = &metav1.Time{Time: *c.ExpirationTime}
+	}
+	if c.RequesterVpcInfo != nil {
+		o.RequesterCIDRBlock = aws.StringValue(c.RequesterVpcInfo.CidrBlock)
+	}
+	if c.AccepterVpcInfo != nil {
+		o.AccepterCIDRBlock = aws.StringValue(c.AccepterVpcInfo.CidrBlock)
+	}
+	return o
+}
+
+// LateInitializeVPCPeeringConnection fills the empty fields in
+// *v1beta1.VPCPeeringConnectionParameters with the values seen in
+// ec2.VpcPeeringConnection.
+func LateInitializeVPCPeeringConnection(in *v1beta1.VPCPeeringConnectionParameters, c *ec2.VpcPeeringConnection) {
+	if c == nil || c.AccepterVpcInfo == nil {
+		return
+	}
+	in.PeerOwnerID = awsclients.LateInitializeStringPtr(in.PeerOwnerID, c.AccepterVpcInfo.OwnerId)
+	in.PeerRegion = awsclients.LateInitializeStringPtr(in.PeerRegion, c.AccepterVpcInfo.Region)
+}
+
+// IsVPCPeeringConnectionOptionsUpToDate returns true if the desired options
+// are not set or match the options of the supplied side of the connection.
+func IsVPCPeeringConnectionOptionsUpToDate(desired *v1beta1.VPCPeeringConnectionOptions, current *ec2.VpcPeeringConnectionVpcInfo) bool {
+	if desired == nil || desired.AllowDNSResolutionFromRemoteVPC == nil {
+		return true
+	}
+	var allow bool
+	if current != nil && current.PeeringOptions != nil {
+		allow = aws.BoolValue(current.PeeringOptions.AllowDnsResolutionFromRemoteVpc)
+	}
+	return aws.BoolValue(desired.AllowDNSResolutionFromRemoteVPC) == allow
+}
+
+// GeneratePeeringConnectionOptionsRequest converts the supplied options to
+// the type that the EC2 client expects.
+func GeneratePeeringConnectionOptionsRequest(o *v1beta1.VPCPeeringConnectionOptions) *ec2.PeeringConnectionOptionsRequest {
+	if o == nil {
+		return nil
+	}
+	return &ec2.PeeringConnectionOptionsRequest{
+		AllowDnsResolutionFromRemoteVpc: o.AllowDNSResolutionFromRemoteVPC,
+	}
+}
+
+// IsVPCPeeringConnectionUpToDate checks whether the requester options and the
+// tags of the VPC peering connection are up to date. The options can only be
+// modified once the connection is active, so they are ignored before that.
+func IsVPCPeeringConnectionUpToDate(p v1beta1.VPCPeeringConnectionParameters, c ec2.VpcPeeringConnection) bool {
+	if IsVPCPeeringConnectionActive(c) && !IsVPCPeeringConnectionOptionsUpToDate(p.RequesterOptions, c.RequesterVpcInfo) {
+		return false
+	}
+	return v1beta1.CompareTags(p.Tags, c.Tags)
+}
+
+// IsVPCPeeringConnectionAccepterUpToDate checks whether the accepter options
+// and the tags of the VPC peering connection are up to date. The tags are
+// only considered if any are set, so that an accepter in the same account as
+// the requester does not remove the tags of the requester.
+func IsVPCPeeringConnectionAccepterUpToDate(p v1beta1.VPCPeeringConnectionAccepterParameters, c ec2.VpcPeeringConnection) bool {
+	if IsVPCPeeringConnectionActive(c) && !IsVPCPeeringConnectionOptionsUpToDate(p.AccepterOptions, c.AccepterVpcInfo) {
+		return false
+	}
+	return len(p.Tags) == 0 || v1beta1.CompareTags(p.Tags, c.Tags)
+}
+
+// IsVPCPeeringConnectionActive returns true if the VPC peering connection has
+// been accepted and is active.
+func IsVPCPeeringConnectionActive(c ec2.VpcPeeringConnection) bool {
+	return c.Status != nil && c.Status.Code == ec2.VpcPeeringConnectionStateReasonCodeActive
+}
@@ -0,0 +1,200 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	peeringConnectionID = "pcx-0123456789abcdef0"
+)
+
+func peeringConnection(code ec2.VpcPeeringConnectionStateReasonCode, m ...func(*ec2.VpcPeeringConnection)) ec2.VpcPeeringConnection {
+	c := ec2.VpcPeeringConnection{
+		VpcPeeringConnectionId: aws.String(peeringConnectionID),
+		Status:                 &ec2.VpcPeeringConnectionStateReason{Code: code},
+		RequesterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+			VpcId:     aws.String("vpc-1"),
+			CidrBlock: aws.String("10.0.0.0/16"),
+		},
+		AccepterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+			VpcId:     aws.String("vpc-2"),
+			CidrBlock: aws.String("10.1.0.0/16"),
+			OwnerId:   aws.String("123456789012"),
+			Region:    aws.String("eu-west-1"),
+		},
+	}
+	for _, f := range m {
+		f(&c)
+	}
+	return c
+}
+
+func withRequesterDNSResolution(allow bool) func(*ec2.VpcPeeringConnection) {
+	return func(c *ec2.VpcPeeringConnection) {
+		c.RequesterVpcInfo.PeeringOptions = &ec2.VpcPeeringConnectionOptionsDescription{
+			AllowDnsResolutionFromRemoteVpc: aws.Bool(allow, aws.FieldRequired),
+		}
+	}
+}
+
+func TestGenerateVPCPeeringConnectionObservation(t *testing.T) {
+	cases := map[string]struct {
+		c    ec2.VpcPeeringConnection
+		want v1beta1.VPCPeeringConnectionObservation
+	}{
+		"AllFilled": {
+			c: peeringConnection(ec2.VpcPeeringConnectionStateReasonCodeActive, func(c *ec2.VpcPeeringConnection) {
+				c.Status.Message = aws.String("Active")
+			}),
+			want: v1beta1.VPCPeeringConnectionObservation{
+				VPCPeeringConnectionID: peeringConnectionID,
+				Status:                 v1beta1.VPCPeeringConnectionStatusActive,
+				StatusMessage:          "Active",
+				RequesterCIDRBlock:     "10.0.0.0/16",
+				AccepterCIDRBlock:      "10.1.0.0/16",
+			},
+		},
+		"NoInfo": {
+			c: ec2.VpcPeeringConnection{VpcPeeringConnectionId: aws.String(peeringConnectionID)},
+			want: v1beta1.VPCPeeringConnectionObservation{
+				VPCPeeringConnectionID: peeringConnectionID,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateVPCPeeringConnectionObservation(tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeVPCPeeringConnection(t *testing.T) {
+	cases := map[string]struct {
+		p    v1beta1.VPCPeeringConnectionParameters
+		c    *ec2.VpcPeeringConnection
+		want v1beta1.VPCPeeringConnectionParameters
+	}{
+		"EmptyFilledByExternal": {
+			p: v1beta1.VPCPeeringConnectionParameters{},
+			c: func() *ec2.VpcPeeringConnection {
+				c := peeringConnection(ec2.VpcPeeringConnectionStateReasonCodeActive)
+				return &c
+			}(),
+			want: v1beta1.VPCPeeringConnectionParameters{
+				PeerOwnerID: aws.String("123456789012"),
+				PeerRegion:  aws.String("eu-west-1"),
+			},
+		},
+		"FilledNotOverridden": {
+			p: v1beta1.VPCPeeringConnectionParameters{
+				PeerOwnerID: aws.String("210987654321"),
+				PeerRegion:  aws.String("us-east-1"),
+			},
+			c: func() *ec2.VpcPeeringConnection {
+				c := peeringConnection(ec2.VpcPeeringConnectionStateReasonCodeActive)
+				return &c
+			}(),
+			want: v1beta1.VPCPeeringConnectionParameters{
+				PeerOwnerID: aws.String("210987654321"),
+				PeerRegion:  aws.String("us-east-1"),
+			},
+		},
+		"NilExternal": {
+			p:    v1beta1.VPCPeeringConnectionParameters{},
+			want: v1beta1.VPCPeeringConnectionParameters{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeVPCPeeringConnection(&tc.p, tc.c)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsVPCPeeringConnectionUpToDate(t *testing.T) {
+	allowDNS := &v1beta1.VPCPeeringConnectionOptions{AllowDNSResolutionFromRemoteVPC: aws.Bool(true)}
+
+	cases := map[string]struct {
+		p    v1beta1.VPCPeeringConnectionParameters
+		c    ec2.VpcPeeringConnection
+		want bool
+	}{
+		"UpToDate": {
+			p:    v1beta1.VPCPeeringConnectionParameters{RequesterOptions: allowDNS},
+			c:    peeringConnection(ec2.VpcPeeringConnectionStateReasonCodeActive, withRequesterDNSResolution(true)),
+			want: true,
+		},
+		"OptionsDiffer": {
+			p:    v1beta1.VPCPeeringConnectionParameters{RequesterOptions: allowDNS},
+			c:    peeringConnection(ec2.VpcPeeringConnectionStateReasonCodeActive, withRequesterDNSResolution(false)),
+			want: false,
+		},
+		"OptionsIgnoredBeforeActive": {
+			p:    v1beta1.VPCPeeringConnectionParameters{RequesterOptions: allowDNS},
+			c:    peeringConnection(ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance),
+			want: true,
+		},
+		"TagsDiffer": {
+			p: v1beta1.VPCPeeringConnectionParameters{Tags: []v1beta1.Tag{{Key: "k", Value: "v"}}},
+			c: peeringConnection(ec2.VpcPeeringConnectionStateReasonCodeActive, func(c *ec2.VpcPeeringConnection) {
+				c.Tags = []ec2.Tag{{Key: aws.String("k"), Value: aws.String("other")}}
+			}),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsVPCPeeringConnectionUpToDate(tc.p, tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsVPCPeeringConnectionAccepterUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1beta1.VPCPeeringConnectionAccepterParameters
+		c    ec2.VpcPeeringConnection
+		want bool
+	}{
+		"NoTagsIgnoresExternalTags": {
+			p: v1beta1.VPCPeeringConnectionAccepterParameters{},
+			c: peeringConnection(ec2.VpcPeeringConnectionStateReasonCodeActive, func(c *ec2.VpcPeeringConnection) {
+				c.Tags = []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}}
+			}),
+			want: true,
+		},
+		"AccepterOptionsDiffer": {
+			p: v1beta1.VPCPeeringConnectionAccepterParameters{
+				AccepterOptions: &v1beta1.VPCPeeringConnectionOptions{AllowDNSResolutionFromRemoteVPC: aws.Bool(true)},
+			},
+			c:    peeringConnection(ec2.VpcPeeringConnectionStateReasonCodeActive),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsVPCPeeringConnectionAccepterUpToDate(tc.p, tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
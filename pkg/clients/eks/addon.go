@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	eksv1 "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// AddonClient is the external client used for Addon Custom Resource. The V1
+// SDK is used since add-ons are not supported by the V2 SDK.
+type AddonClient interface {
+	CreateAddonWithContext(ctx context.Context, input *eksv1.CreateAddonInput, opts ...request.Option) (*eksv1.CreateAddonOutput, error)
+	DescribeAddonWithContext(ctx context.Context, input *eksv1.DescribeAddonInput, opts ...request.Option) (*eksv1.DescribeAddonOutput, error)
+	UpdateAddonWithContext(ctx context.Context, input *eksv1.UpdateAddonInput, opts ...request.Option) (*eksv1.UpdateAddonOutput, error)
+	DeleteAddonWithContext(ctx context.Context, input *eksv1.DeleteAddonInput, opts ...request.Option) (*eksv1.DeleteAddonOutput, error)
+	TagResourceWithContext(ctx context.Context, input *eksv1.TagResourceInput, opts ...request.Option) (*eksv1.TagResourceOutput, error)
+	UntagResourceWithContext(ctx context.Context, input *eksv1.UntagResourceInput, opts ...request.Option) (*eksv1.UntagResourceOutput, error)
+}
+
+// NewAddonClient returns a new V1 client using the supplied session.
+func NewAddonClient(sess *session.Session) AddonClient {
+	return eksv1.New(sess)
+}
+
+// GenerateCreateAddonInput from AddonParameters.
+func GenerateCreateAddonInput(p *v1alpha1.AddonParameters) *eksv1.CreateAddonInput {
+	return &eksv1.CreateAddonInput{
+		AddonName:             awsv1.String(p.AddonName),
+		AddonVersion:          p.AddonVersion,
+		ClusterName:           awsv1.String(p.ClusterName),
+		ResolveConflicts:      p.ResolveConflicts,
+		ServiceAccountRoleArn: p.ServiceAccountRoleARN,
+		Tags:                  stringMap(p.Tags),
+	}
+}
+
+// GenerateUpdateAddonInput returns the input to update the version or the
+// service account role of the addon. It returns nil if neither of them needs
+// to be updated.
+func GenerateUpdateAddonInput(p *v1alpha1.AddonParameters, a *eksv1.Addon) *eksv1.UpdateAddonInput {
+	versionUpToDate := p.AddonVersion == nil || awsclients.StringValue(p.AddonVersion) == awsclients.StringValue(a.AddonVersion)
+	roleUpToDate := p.ServiceAccountRoleARN == nil || awsclients.StringValue(p.ServiceAccountRoleARN) == awsclients.StringValue(a.ServiceAccountRoleArn)
+	if versionUpToDate && roleUpToDate {
+		return nil
+	}
+	return &eksv1.UpdateAddonInput{
+		AddonName:             awsv1.String(p.AddonName),
+		AddonVersion:          p.AddonVersion,
+		ClusterName:           awsv1.String(p.ClusterName),
+		ResolveConflicts:      p.ResolveConflicts,
+		ServiceAccountRoleArn: p.ServiceAccountRoleARN,
+	}
+}
+
+// GenerateAddonObservation is used to produce v1alpha1.AddonObservation from
+// eks.Addon.
+func GenerateAddonObservation(a *eksv1.Addon) v1alpha1.AddonObservation {
+	if a == nil {
+		return v1alpha1.AddonObservation{}
+	}
+	o := v1alpha1.AddonObservation{
+		AddonARN:     awsclients.StringValue(a.AddonArn),
+		AddonVersion: awsclients.StringValue(a.AddonVersion),
+		Status:       v1alpha1.AddonStatusType(awsclients.StringValue(a.Status)),
+	}
+	if a.CreatedAt != nil {
+		o.CreatedAt = &metav1.Time{Time: *a.CreatedAt}
+	}
+	if a.ModifiedAt != nil {
+		o.ModifiedAt = &metav1.Time{Time: *a.ModifiedAt}
+	}
+	if a.Health != nil && len(a.Health.Issues) > 0 {
+		o.Health.Issues = make([]v1alpha1.AddonIssue, len(a.Health.Issues))
+		for c, i := range a.Health.Issues {
+			o.Health.Issues[c] = v1alpha1.AddonIssue{
+				Code:        awsclients.StringValue(i.Code),
+				Message:     awsclients.StringValue(i.Message),
+				ResourceIDs: stringValueSlice(i.ResourceIds),
+			}
+		}
+	}
+	return o
+}
+
+// LateInitializeAddon fills the empty fields in *v1alpha1.AddonParameters with
+// the values seen in eks.Addon.
+func LateInitializeAddon(in *v1alpha1.AddonParameters, a *eksv1.Addon) {
+	if a == nil {
+		return
+	}
+	in.AddonVersion = awsclients.LateInitializeStringPtr(in.AddonVersion, a.AddonVersion)
+	in.ServiceAccountRoleARN = awsclients.LateInitializeStringPtr(in.ServiceAccountRoleARN, a.ServiceAccountRoleArn)
+	if len(in.Tags) == 0 && len(a.Tags) > 0 {
+		in.Tags = awsv1.StringValueMap(a.Tags)
+	}
+}
+
+// IsAddonUpToDate checks whether there is a change in any of the modifiable
+// fields.
+func IsAddonUpToDate(p *v1alpha1.AddonParameters, a *eksv1.Addon) bool {
+	if !cmp.Equal(p.Tags, awsv1.StringValueMap(a.Tags), cmpopts.EquateEmpty()) {
+		return false
+	}
+	return GenerateUpdateAddonInput(p, a) == nil
+}
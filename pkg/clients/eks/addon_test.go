@@ -0,0 +1,330 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+	"time"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+)
+
+var (
+	addonName    = "vpc-cni"
+	addonVersion = "v1.7.5-eksbuild.2"
+	addonRoleArn = "arn:aws:iam::123456789012:role/cool-role"
+)
+
+func TestGenerateCreateAddonInput(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.AddonParameters
+		want *eks.CreateAddonInput
+	}{
+		"AllFields": {
+			p: &v1alpha1.AddonParameters{
+				AddonName:             addonName,
+				AddonVersion:          &addonVersion,
+				ClusterName:           clusterName,
+				ResolveConflicts:      awsv1.String(eks.ResolveConflictsOverwrite),
+				ServiceAccountRoleARN: &addonRoleArn,
+				Tags:                  map[string]string{"cool": "tag"},
+			},
+			want: &eks.CreateAddonInput{
+				AddonName:             &addonName,
+				AddonVersion:          &addonVersion,
+				ClusterName:           &clusterName,
+				ResolveConflicts:      awsv1.String(eks.ResolveConflictsOverwrite),
+				ServiceAccountRoleArn: &addonRoleArn,
+				Tags:                  awsv1.StringMap(map[string]string{"cool": "tag"}),
+			},
+		},
+		"RequiredFields": {
+			p: &v1alpha1.AddonParameters{
+				AddonName:   addonName,
+				ClusterName: clusterName,
+			},
+			want: &eks.CreateAddonInput{
+				AddonName:   &addonName,
+				ClusterName: &clusterName,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateAddonInput(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateAddonInput(t *testing.T) {
+	otherVersion := "v1.8.0-eksbuild.1"
+	type args struct {
+		p *v1alpha1.AddonParameters
+		a *eks.Addon
+	}
+
+	cases := map[string]struct {
+		args args
+		want *eks.UpdateAddonInput
+	}{
+		"UpToDate": {
+			args: args{
+				p: &v1alpha1.AddonParameters{
+					AddonName:             addonName,
+					AddonVersion:          &addonVersion,
+					ClusterName:           clusterName,
+					ServiceAccountRoleARN: &addonRoleArn,
+				},
+				a: &eks.Addon{
+					AddonVersion:          &addonVersion,
+					ServiceAccountRoleArn: &addonRoleArn,
+				},
+			},
+		},
+		"NotSet": {
+			args: args{
+				p: &v1alpha1.AddonParameters{
+					AddonName:   addonName,
+					ClusterName: clusterName,
+				},
+				a: &eks.Addon{
+					AddonVersion: &addonVersion,
+				},
+			},
+		},
+		"Version": {
+			args: args{
+				p: &v1alpha1.AddonParameters{
+					AddonName:        addonName,
+					AddonVersion:     &otherVersion,
+					ClusterName:      clusterName,
+					ResolveConflicts: awsv1.String(eks.ResolveConflictsOverwrite),
+				},
+				a: &eks.Addon{
+					AddonVersion: &addonVersion,
+				},
+			},
+			want: &eks.UpdateAddonInput{
+				AddonName:        &addonName,
+				AddonVersion:     &otherVersion,
+				ClusterName:      &clusterName,
+				ResolveConflicts: awsv1.String(eks.ResolveConflictsOverwrite),
+			},
+		},
+		"ServiceAccountRole": {
+			args: args{
+				p: &v1alpha1.AddonParameters{
+					AddonName:             addonName,
+					AddonVersion:          &addonVersion,
+					ClusterName:           clusterName,
+					ServiceAccountRoleARN: &addonRoleArn,
+				},
+				a: &eks.Addon{
+					AddonVersion: &addonVersion,
+				},
+			},
+			want: &eks.UpdateAddonInput{
+				AddonName:             &addonName,
+				AddonVersion:          &addonVersion,
+				ClusterName:           &clusterName,
+				ServiceAccountRoleArn: &addonRoleArn,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateAddonInput(tc.args.p, tc.args.a)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateAddonObservation(t *testing.T) {
+	addonArn := "arn:aws:eks:us-east-1:123456789012:addon/cool-cluster/vpc-cni/id"
+	now := time.Now()
+
+	cases := map[string]struct {
+		a    *eks.Addon
+		want v1alpha1.AddonObservation
+	}{
+		"Full": {
+			a: &eks.Addon{
+				AddonArn:     &addonArn,
+				AddonVersion: &addonVersion,
+				CreatedAt:    &now,
+				Health: &eks.AddonHealth{
+					Issues: []*eks.AddonIssue{{
+						Code:        awsv1.String(eks.AddonIssueCodeInsufficientNumberOfReplicas),
+						Message:     awsv1.String("not enough replicas"),
+						ResourceIds: awsv1.StringSlice([]string{"aws-node"}),
+					}},
+				},
+				ModifiedAt: &now,
+				Status:     awsv1.String(eks.AddonStatusDegraded),
+			},
+			want: v1alpha1.AddonObservation{
+				AddonARN:     addonArn,
+				AddonVersion: addonVersion,
+				CreatedAt:    &v1.Time{Time: now},
+				Health: v1alpha1.AddonHealth{
+					Issues: []v1alpha1.AddonIssue{{
+						Code:        eks.AddonIssueCodeInsufficientNumberOfReplicas,
+						Message:     "not enough replicas",
+						ResourceIDs: []string{"aws-node"},
+					}},
+				},
+				ModifiedAt: &v1.Time{Time: now},
+				Status:     v1alpha1.AddonStatusDegraded,
+			},
+		},
+		"Nil": {
+			want: v1alpha1.AddonObservation{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateAddonObservation(tc.a)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeAddon(t *testing.T) {
+	otherVersion := "v1.8.0-eksbuild.1"
+	type args struct {
+		p *v1alpha1.AddonParameters
+		a *eks.Addon
+	}
+
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.AddonParameters
+	}{
+		"AllFieldsEmpty": {
+			args: args{
+				p: &v1alpha1.AddonParameters{},
+				a: &eks.Addon{
+					AddonVersion:          &addonVersion,
+					ServiceAccountRoleArn: &addonRoleArn,
+					Tags:                  awsv1.StringMap(map[string]string{"cool": "tag"}),
+				},
+			},
+			want: &v1alpha1.AddonParameters{
+				AddonVersion:          &addonVersion,
+				ServiceAccountRoleARN: &addonRoleArn,
+				Tags:                  map[string]string{"cool": "tag"},
+			},
+		},
+		"KeepSpecValues": {
+			args: args{
+				p: &v1alpha1.AddonParameters{
+					AddonVersion: &otherVersion,
+				},
+				a: &eks.Addon{
+					AddonVersion: &addonVersion,
+				},
+			},
+			want: &v1alpha1.AddonParameters{
+				AddonVersion: &otherVersion,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeAddon(tc.args.p, tc.args.a)
+			if diff := cmp.Diff(tc.want, tc.args.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAddonUpToDate(t *testing.T) {
+	otherVersion := "v1.8.0-eksbuild.1"
+	type args struct {
+		p *v1alpha1.AddonParameters
+		a *eks.Addon
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p: &v1alpha1.AddonParameters{
+					AddonVersion: &addonVersion,
+					Tags:         map[string]string{"cool": "tag"},
+				},
+				a: &eks.Addon{
+					AddonVersion: &addonVersion,
+					Tags:         awsv1.StringMap(map[string]string{"cool": "tag"}),
+				},
+			},
+			want: true,
+		},
+		"UpdateTags": {
+			args: args{
+				p: &v1alpha1.AddonParameters{
+					AddonVersion: &addonVersion,
+					Tags:         map[string]string{"cool": "tag", "another": "tag"},
+				},
+				a: &eks.Addon{
+					AddonVersion: &addonVersion,
+					Tags:         awsv1.StringMap(map[string]string{"cool": "tag"}),
+				},
+			},
+			want: false,
+		},
+		"UpdateVersion": {
+			args: args{
+				p: &v1alpha1.AddonParameters{
+					AddonVersion: &otherVersion,
+				},
+				a: &eks.Addon{
+					AddonVersion: &addonVersion,
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAddonUpToDate(tc.args.p, tc.args.a)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
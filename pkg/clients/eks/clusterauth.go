@@ -0,0 +1,318 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+)
+
+// The ConfigMap that maps IAM identities to Kubernetes users and groups.
+// More information: https://docs.aws.amazon.com/eks/latest/userguide/add-user-role.html
+const (
+	AuthConfigMapName      = "aws-auth"
+	AuthConfigMapNamespace = "kube-system"
+
+	authMapRolesKey = "mapRoles"
+	authMapUsersKey = "mapUsers"
+
+	errParseMapRoles = "cannot parse mapRoles of aws-auth ConfigMap"
+	errParseMapUsers = "cannot parse mapUsers of aws-auth ConfigMap"
+)
+
+type authMapRole struct {
+	RoleARN  string   `json:"rolearn"`
+	Username string   `json:"username"`
+	Groups   []string `json:"groups,omitempty"`
+}
+
+type authMapUser struct {
+	UserARN  string   `json:"userarn"`
+	Username string   `json:"username"`
+	Groups   []string `json:"groups,omitempty"`
+}
+
+type authMappings struct {
+	roles []authMapRole
+	users []authMapUser
+}
+
+// NewKubeClient returns a client for the Kubernetes cluster that the supplied
+// kubeconfig points to.
+func NewKubeClient(kubeconfig []byte) (client.Client, error) {
+	cfg, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return client.New(cfg, client.Options{})
+}
+
+func parseAuthMappings(cm *corev1.ConfigMap) (*authMappings, error) {
+	m := &authMappings{}
+	if err := yaml.Unmarshal([]byte(cm.Data[authMapRolesKey]), &m.roles); err != nil {
+		return nil, errors.Wrap(err, errParseMapRoles)
+	}
+	if err := yaml.Unmarshal([]byte(cm.Data[authMapUsersKey]), &m.users); err != nil {
+		return nil, errors.Wrap(err, errParseMapUsers)
+	}
+	return m, nil
+}
+
+func (m *authMappings) write(cm *corev1.ConfigMap) error {
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	roles, err := yaml.Marshal(m.roles)
+	if err != nil {
+		return err
+	}
+	users, err := yaml.Marshal(m.users)
+	if err != nil {
+		return err
+	}
+	cm.Data[authMapRolesKey] = string(roles)
+	cm.Data[authMapUsersKey] = string(users)
+	if len(m.roles) == 0 {
+		delete(cm.Data, authMapRolesKey)
+	}
+	if len(m.users) == 0 {
+		delete(cm.Data, authMapUsersKey)
+	}
+	return nil
+}
+
+func generateAuthMapRole(r v1alpha1.MapRole) authMapRole {
+	return authMapRole{RoleARN: r.RoleARN, Username: r.Username, Groups: r.Groups}
+}
+
+func generateAuthMapUser(u v1alpha1.MapUser) authMapUser {
+	return authMapUser{UserARN: u.UserARN, Username: u.Username, Groups: u.Groups}
+}
+
+func generateMapRole(r authMapRole) v1alpha1.MapRole {
+	return v1alpha1.MapRole{RoleARN: r.RoleARN, Username: r.Username, Groups: r.Groups}
+}
+
+func generateMapUser(u authMapUser) v1alpha1.MapUser {
+	return v1alpha1.MapUser{UserARN: u.UserARN, Username: u.Username, Groups: u.Groups}
+}
+
+// ObserveClusterAuth returns whether any of the mappings in
+// ClusterAuthParameters or ClusterAuthObservation exist in the supplied
+// aws-auth ConfigMap and whether all of them are up to date. Mappings are
+// identified by their ARN. The recorded mappings that are no longer desired
+// are not up to date as long as they exist, or, if they replaced an entry
+// that existed before, as long as that entry is not restored.
+func ObserveClusterAuth(p *v1alpha1.ClusterAuthParameters, o v1alpha1.ClusterAuthObservation, cm *corev1.ConfigMap) (exists bool, upToDate bool, err error) { // nolint:gocyclo
+	m, err := parseAuthMappings(cm)
+	if err != nil {
+		return false, false, err
+	}
+	roles := make(map[string]authMapRole, len(m.roles))
+	for _, r := range m.roles {
+		roles[r.RoleARN] = r
+	}
+	users := make(map[string]authMapUser, len(m.users))
+	for _, u := range m.users {
+		users[u.UserARN] = u
+	}
+	upToDate = true
+	desiredRoles := make(map[string]bool, len(p.MapRoles))
+	for _, r := range p.MapRoles {
+		desiredRoles[r.RoleARN] = true
+		current, ok := roles[r.RoleARN]
+		exists = exists || ok
+		if !ok || !cmpAuthMapping(current.Username, current.Groups, r.Username, r.Groups) {
+			upToDate = false
+		}
+	}
+	for _, arn := range o.MapRoles {
+		if _, ok := roles[arn]; ok && !desiredRoles[arn] {
+			exists = true
+			upToDate = false
+		}
+	}
+	for _, r := range o.ReplacedMapRoles {
+		if current, ok := roles[r.RoleARN]; ok && !desiredRoles[r.RoleARN] && !cmpAuthMapping(current.Username, current.Groups, r.Username, r.Groups) {
+			exists = true
+			upToDate = false
+		}
+	}
+	desiredUsers := make(map[string]bool, len(p.MapUsers))
+	for _, u := range p.MapUsers {
+		desiredUsers[u.UserARN] = true
+		current, ok := users[u.UserARN]
+		exists = exists || ok
+		if !ok || !cmpAuthMapping(current.Username, current.Groups, u.Username, u.Groups) {
+			upToDate = false
+		}
+	}
+	for _, arn := range o.MapUsers {
+		if _, ok := users[arn]; ok && !desiredUsers[arn] {
+			exists = true
+			upToDate = false
+		}
+	}
+	for _, u := range o.ReplacedMapUsers {
+		if current, ok := users[u.UserARN]; ok && !desiredUsers[u.UserARN] && !cmpAuthMapping(current.Username, current.Groups, u.Username, u.Groups) {
+			exists = true
+			upToDate = false
+		}
+	}
+	return exists, upToDate, nil
+}
+
+func cmpAuthMapping(currentUsername string, currentGroups []string, username string, groups []string) bool {
+	if currentUsername != username || len(currentGroups) != len(groups) {
+		return false
+	}
+	for i := range groups {
+		if currentGroups[i] != groups[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// UpsertClusterAuth adds the mappings in ClusterAuthParameters to the supplied
+// aws-auth ConfigMap or updates them if they already exist. The recorded
+// mappings in ClusterAuthObservation that are no longer desired are removed if
+// they were added by the ClusterAuth, or restored to the entry they replaced.
+// Other mappings are left untouched. It returns the observation to record,
+// which holds the mappings added by the ClusterAuth and the original entries
+// of the ones it updated.
+func UpsertClusterAuth(p *v1alpha1.ClusterAuthParameters, o v1alpha1.ClusterAuthObservation, cm *corev1.ConfigMap) (v1alpha1.ClusterAuthObservation, error) { // nolint:gocyclo
+	m, err := parseAuthMappings(cm)
+	if err != nil {
+		return v1alpha1.ClusterAuthObservation{}, err
+	}
+	obs := v1alpha1.ClusterAuthObservation{}
+	addedRoles := arnSet(o.MapRoles)
+	replacedRoles := make(map[string]v1alpha1.MapRole, len(o.ReplacedMapRoles))
+	for _, r := range o.ReplacedMapRoles {
+		replacedRoles[r.RoleARN] = r
+	}
+	desiredRoles := make(map[string]bool, len(p.MapRoles))
+	for _, r := range p.MapRoles {
+		desiredRoles[r.RoleARN] = true
+		found := false
+		for i := range m.roles {
+			if m.roles[i].RoleARN != r.RoleARN {
+				continue
+			}
+			if !found && !addedRoles[r.RoleARN] {
+				original, ok := replacedRoles[r.RoleARN]
+				if !ok {
+					original = generateMapRole(m.roles[i])
+				}
+				obs.ReplacedMapRoles = append(obs.ReplacedMapRoles, original)
+			}
+			m.roles[i] = generateAuthMapRole(r)
+			found = true
+		}
+		if !found {
+			m.roles = append(m.roles, generateAuthMapRole(r))
+		}
+		if !found || addedRoles[r.RoleARN] {
+			obs.MapRoles = append(obs.MapRoles, r.RoleARN)
+		}
+	}
+	m.roles = removeAuthMapRoles(m.roles, func(arn string) bool { return addedRoles[arn] && !desiredRoles[arn] })
+	for i := range m.roles {
+		if original, ok := replacedRoles[m.roles[i].RoleARN]; ok && !desiredRoles[original.RoleARN] {
+			m.roles[i] = generateAuthMapRole(original)
+		}
+	}
+	addedUsers := arnSet(o.MapUsers)
+	replacedUsers := make(map[string]v1alpha1.MapUser, len(o.ReplacedMapUsers))
+	for _, u := range o.ReplacedMapUsers {
+		replacedUsers[u.UserARN] = u
+	}
+	desiredUsers := make(map[string]bool, len(p.MapUsers))
+	for _, u := range p.MapUsers {
+		desiredUsers[u.UserARN] = true
+		found := false
+		for i := range m.users {
+			if m.users[i].UserARN != u.UserARN {
+				continue
+			}
+			if !found && !addedUsers[u.UserARN] {
+				original, ok := replacedUsers[u.UserARN]
+				if !ok {
+					original = generateMapUser(m.users[i])
+				}
+				obs.ReplacedMapUsers = append(obs.ReplacedMapUsers, original)
+			}
+			m.users[i] = generateAuthMapUser(u)
+			found = true
+		}
+		if !found {
+			m.users = append(m.users, generateAuthMapUser(u))
+		}
+		if !found || addedUsers[u.UserARN] {
+			obs.MapUsers = append(obs.MapUsers, u.UserARN)
+		}
+	}
+	m.users = removeAuthMapUsers(m.users, func(arn string) bool { return addedUsers[arn] && !desiredUsers[arn] })
+	for i := range m.users {
+		if original, ok := replacedUsers[m.users[i].UserARN]; ok && !desiredUsers[original.UserARN] {
+			m.users[i] = generateAuthMapUser(original)
+		}
+	}
+	return obs, m.write(cm)
+}
+
+// RemoveClusterAuth removes the mappings that were added by the ClusterAuth
+// from the supplied aws-auth ConfigMap and restores the entries it replaced.
+// Other mappings are left untouched.
+func RemoveClusterAuth(o v1alpha1.ClusterAuthObservation, cm *corev1.ConfigMap) error {
+	_, err := UpsertClusterAuth(&v1alpha1.ClusterAuthParameters{}, o, cm)
+	return err
+}
+
+func arnSet(arns []string) map[string]bool {
+	s := make(map[string]bool, len(arns))
+	for _, arn := range arns {
+		s[arn] = true
+	}
+	return s
+}
+
+func removeAuthMapRoles(roles []authMapRole, remove func(arn string) bool) []authMapRole {
+	kept := roles[:0]
+	for _, r := range roles {
+		if !remove(r.RoleARN) {
+			kept = append(kept, r)
+		}
+	}
+	return kept
+}
+
+func removeAuthMapUsers(users []authMapUser, remove func(arn string) bool) []authMapUser {
+	kept := users[:0]
+	for _, u := range users {
+		if !remove(u.UserARN) {
+			kept = append(kept, u)
+		}
+	}
+	return kept
+}
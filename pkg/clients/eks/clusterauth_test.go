@@ -0,0 +1,377 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+)
+
+var (
+	nodeRoleArn = "arn:aws:iam::123456789012:role/node"
+	teamRoleArn = "arn:aws:iam::123456789012:role/team"
+	userArn     = "arn:aws:iam::123456789012:user/cool-user"
+
+	nodeRoleEntry = `- groups:
+  - system:bootstrappers
+  - system:nodes
+  rolearn: arn:aws:iam::123456789012:role/node
+  username: system:node:{{EC2PrivateDNSName}}
+`
+	teamRoleEntry = `- groups:
+  - system:masters
+  rolearn: arn:aws:iam::123456789012:role/team
+  username: team
+`
+	userEntry = `- groups:
+  - system:masters
+  userarn: arn:aws:iam::123456789012:user/cool-user
+  username: cool-user
+`
+	oldTeamRoleEntry = `- rolearn: arn:aws:iam::123456789012:role/team
+  username: old
+`
+)
+
+func clusterAuthParams() *v1alpha1.ClusterAuthParameters {
+	return &v1alpha1.ClusterAuthParameters{
+		MapRoles: []v1alpha1.MapRole{{
+			RoleARN:  teamRoleArn,
+			Username: "team",
+			Groups:   []string{"system:masters"},
+		}},
+		MapUsers: []v1alpha1.MapUser{{
+			UserARN:  userArn,
+			Username: "cool-user",
+			Groups:   []string{"system:masters"},
+		}},
+	}
+}
+
+func TestObserveClusterAuth(t *testing.T) {
+	type want struct {
+		exists   bool
+		upToDate bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		o    v1alpha1.ClusterAuthObservation
+		cm   *corev1.ConfigMap
+		want want
+	}{
+		"Empty": {
+			cm: &corev1.ConfigMap{},
+		},
+		"OtherMappingsOnly": {
+			cm: &corev1.ConfigMap{Data: map[string]string{authMapRolesKey: nodeRoleEntry}},
+		},
+		"UpToDate": {
+			cm: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: nodeRoleEntry + teamRoleEntry,
+				authMapUsersKey: userEntry,
+			}},
+			want: want{exists: true, upToDate: true},
+		},
+		"MissingUser": {
+			cm: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: teamRoleEntry,
+			}},
+			want: want{exists: true},
+		},
+		"DifferentGroups": {
+			cm: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: `- rolearn: arn:aws:iam::123456789012:role/team
+  username: team
+`,
+				authMapUsersKey: userEntry,
+			}},
+			want: want{exists: true},
+		},
+		"RecordedMappingNotDesired": {
+			o: v1alpha1.ClusterAuthObservation{MapRoles: []string{teamRoleArn, nodeRoleArn}, MapUsers: []string{userArn}},
+			cm: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: nodeRoleEntry + teamRoleEntry,
+				authMapUsersKey: userEntry,
+			}},
+			want: want{exists: true},
+		},
+		"RecordedMappingRemoved": {
+			o: v1alpha1.ClusterAuthObservation{MapRoles: []string{teamRoleArn, nodeRoleArn}, MapUsers: []string{userArn}},
+			cm: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: teamRoleEntry,
+				authMapUsersKey: userEntry,
+			}},
+			want: want{exists: true, upToDate: true},
+		},
+		"ReplacedMappingNotRestored": {
+			o: v1alpha1.ClusterAuthObservation{ReplacedMapRoles: []v1alpha1.MapRole{{RoleARN: nodeRoleArn, Username: "node"}}},
+			cm: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: nodeRoleEntry + teamRoleEntry,
+				authMapUsersKey: userEntry,
+			}},
+			want: want{exists: true},
+		},
+		"ReplacedMappingRestored": {
+			o: v1alpha1.ClusterAuthObservation{ReplacedMapRoles: []v1alpha1.MapRole{{
+				RoleARN:  nodeRoleArn,
+				Username: "system:node:{{EC2PrivateDNSName}}",
+				Groups:   []string{"system:bootstrappers", "system:nodes"},
+			}}},
+			cm: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: nodeRoleEntry + teamRoleEntry,
+				authMapUsersKey: userEntry,
+			}},
+			want: want{exists: true, upToDate: true},
+		},
+		"OnlyRecordedMappingExists": {
+			o: v1alpha1.ClusterAuthObservation{MapRoles: []string{nodeRoleArn}},
+			cm: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: nodeRoleEntry,
+			}},
+			want: want{exists: true},
+		},
+		"InvalidYAML": {
+			cm: &corev1.ConfigMap{Data: map[string]string{authMapRolesKey: "rolearn: [not a list"}},
+			want: want{
+				err: errors.Wrap(errors.New("error converting YAML to JSON: yaml: line 1: did not find expected ',' or ']'"), errParseMapRoles),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			exists, upToDate, err := ObserveClusterAuth(clusterAuthParams(), tc.o, tc.cm)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.exists, exists); diff != "" {
+				t.Errorf("exists: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("upToDate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpsertClusterAuth(t *testing.T) {
+	type want struct {
+		cm    *corev1.ConfigMap
+		added v1alpha1.ClusterAuthObservation
+	}
+
+	cases := map[string]struct {
+		o    v1alpha1.ClusterAuthObservation
+		cm   *corev1.ConfigMap
+		want want
+	}{
+		"Empty": {
+			cm: &corev1.ConfigMap{},
+			want: want{
+				cm: &corev1.ConfigMap{Data: map[string]string{
+					authMapRolesKey: teamRoleEntry,
+					authMapUsersKey: userEntry,
+				}},
+				added: v1alpha1.ClusterAuthObservation{MapRoles: []string{teamRoleArn}, MapUsers: []string{userArn}},
+			},
+		},
+		"KeepOtherMappings": {
+			cm: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: nodeRoleEntry,
+				"mapAccounts":   "- \"123456789012\"\n",
+			}},
+			want: want{
+				cm: &corev1.ConfigMap{Data: map[string]string{
+					authMapRolesKey: nodeRoleEntry + teamRoleEntry,
+					authMapUsersKey: userEntry,
+					"mapAccounts":   "- \"123456789012\"\n",
+				}},
+				added: v1alpha1.ClusterAuthObservation{MapRoles: []string{teamRoleArn}, MapUsers: []string{userArn}},
+			},
+		},
+		"UpdateExisting": {
+			cm: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: oldTeamRoleEntry,
+				authMapUsersKey: userEntry,
+			}},
+			want: want{
+				cm: &corev1.ConfigMap{Data: map[string]string{
+					authMapRolesKey: teamRoleEntry,
+					authMapUsersKey: userEntry,
+				}},
+				added: v1alpha1.ClusterAuthObservation{
+					ReplacedMapRoles: []v1alpha1.MapRole{{RoleARN: teamRoleArn, Username: "old"}},
+					ReplacedMapUsers: []v1alpha1.MapUser{{UserARN: userArn, Username: "cool-user", Groups: []string{"system:masters"}}},
+				},
+			},
+		},
+		"KeepReplaced": {
+			o: v1alpha1.ClusterAuthObservation{
+				MapUsers:         []string{userArn},
+				ReplacedMapRoles: []v1alpha1.MapRole{{RoleARN: teamRoleArn, Username: "old"}},
+			},
+			cm: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: teamRoleEntry,
+				authMapUsersKey: userEntry,
+			}},
+			want: want{
+				cm: &corev1.ConfigMap{Data: map[string]string{
+					authMapRolesKey: teamRoleEntry,
+					authMapUsersKey: userEntry,
+				}},
+				added: v1alpha1.ClusterAuthObservation{
+					MapUsers:         []string{userArn},
+					ReplacedMapRoles: []v1alpha1.MapRole{{RoleARN: teamRoleArn, Username: "old"}},
+				},
+			},
+		},
+		"RestoreReplacedNotDesired": {
+			o: v1alpha1.ClusterAuthObservation{
+				MapRoles:         []string{teamRoleArn},
+				MapUsers:         []string{userArn},
+				ReplacedMapRoles: []v1alpha1.MapRole{{RoleARN: nodeRoleArn, Username: "node"}},
+			},
+			cm: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: nodeRoleEntry + teamRoleEntry,
+				authMapUsersKey: userEntry,
+			}},
+			want: want{
+				cm: &corev1.ConfigMap{Data: map[string]string{
+					authMapRolesKey: `- rolearn: arn:aws:iam::123456789012:role/node
+  username: node
+` + teamRoleEntry,
+					authMapUsersKey: userEntry,
+				}},
+				added: v1alpha1.ClusterAuthObservation{MapRoles: []string{teamRoleArn}, MapUsers: []string{userArn}},
+			},
+		},
+		"UpdateAdded": {
+			o: v1alpha1.ClusterAuthObservation{MapRoles: []string{teamRoleArn}, MapUsers: []string{userArn}},
+			cm: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: oldTeamRoleEntry,
+				authMapUsersKey: userEntry,
+			}},
+			want: want{
+				cm: &corev1.ConfigMap{Data: map[string]string{
+					authMapRolesKey: teamRoleEntry,
+					authMapUsersKey: userEntry,
+				}},
+				added: v1alpha1.ClusterAuthObservation{MapRoles: []string{teamRoleArn}, MapUsers: []string{userArn}},
+			},
+		},
+		"RemoveAddedNotDesired": {
+			o: v1alpha1.ClusterAuthObservation{MapRoles: []string{nodeRoleArn, teamRoleArn}, MapUsers: []string{userArn}},
+			cm: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: nodeRoleEntry + teamRoleEntry,
+				authMapUsersKey: userEntry,
+			}},
+			want: want{
+				cm: &corev1.ConfigMap{Data: map[string]string{
+					authMapRolesKey: teamRoleEntry,
+					authMapUsersKey: userEntry,
+				}},
+				added: v1alpha1.ClusterAuthObservation{MapRoles: []string{teamRoleArn}, MapUsers: []string{userArn}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			added, err := UpsertClusterAuth(clusterAuthParams(), tc.o, tc.cm)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want.cm, tc.cm); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.added, added); diff != "" {
+				t.Errorf("added: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRemoveClusterAuth(t *testing.T) {
+	added := v1alpha1.ClusterAuthObservation{MapRoles: []string{teamRoleArn}, MapUsers: []string{userArn}}
+
+	cases := map[string]struct {
+		o    v1alpha1.ClusterAuthObservation
+		cm   *corev1.ConfigMap
+		want *corev1.ConfigMap
+	}{
+		"RemoveAll": {
+			o: added,
+			cm: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: teamRoleEntry,
+				authMapUsersKey: userEntry,
+			}},
+			want: &corev1.ConfigMap{Data: map[string]string{}},
+		},
+		"KeepOtherMappings": {
+			o: added,
+			cm: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: nodeRoleEntry + teamRoleEntry,
+				authMapUsersKey: userEntry,
+			}},
+			want: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: nodeRoleEntry,
+			}},
+		},
+		"KeepMappingsNotAdded": {
+			o: v1alpha1.ClusterAuthObservation{MapUsers: []string{userArn}},
+			cm: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: nodeRoleEntry + teamRoleEntry,
+				authMapUsersKey: userEntry,
+			}},
+			want: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: nodeRoleEntry + teamRoleEntry,
+			}},
+		},
+		"RestoreReplaced": {
+			o: v1alpha1.ClusterAuthObservation{
+				MapUsers:         []string{userArn},
+				ReplacedMapRoles: []v1alpha1.MapRole{{RoleARN: teamRoleArn, Username: "old"}},
+			},
+			cm: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: nodeRoleEntry + teamRoleEntry,
+				authMapUsersKey: userEntry,
+			}},
+			want: &corev1.ConfigMap{Data: map[string]string{
+				authMapRolesKey: nodeRoleEntry + oldTeamRoleEntry,
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if err := RemoveClusterAuth(tc.o, tc.cm); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, tc.cm); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
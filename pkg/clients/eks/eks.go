@@ -17,10 +17,13 @@ limitations under the License.
 package eks
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
-
+	"fmt"
 	"net"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -29,8 +32,13 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/eks/eksiface"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/aws-sdk-go-v2/service/sts/stsiface"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	eksv1 "github.com/aws/aws-sdk-go/service/eks"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
@@ -45,6 +53,31 @@ import (
 const (
 	clusterIDHeader = "x-k8s-aws-id"
 	v1Prefix        = "k8s-aws-v1."
+
+	execAPIVersion = "client.authentication.k8s.io/v1alpha1"
+	execCommand    = "aws-iam-authenticator"
+)
+
+const (
+	// TokenLifetime is how long a bearer token generated for an EKS cluster
+	// is accepted. EKS honours tokens for 15 minutes after they are signed;
+	// like aws-iam-authenticator we report one minute less to allow for
+	// clock skew.
+	TokenLifetime = 14 * time.Minute
+
+	// TokenRefreshMargin is how long before a bearer token expires that the
+	// connection details of a cluster should be refreshed. It must exceed the
+	// interval at which Clusters are observed, or tokens may lapse.
+	TokenRefreshMargin = 4 * time.Minute
+
+	// ConnectionSecretTokenExpirationKey is the connection secret key that
+	// holds the RFC3339 expiry time of the token embedded in the kubeconfig.
+	ConnectionSecretTokenExpirationKey = "tokenExpiration"
+
+	// ConnectionSecretExecKubeconfigKey is the connection secret key that
+	// holds a kubeconfig which obtains its token by executing
+	// aws-iam-authenticator rather than embedding a short-lived one.
+	ConnectionSecretExecKubeconfigKey = "execKubeconfig"
 )
 
 // Client defines EKS Client operations
@@ -63,6 +96,19 @@ func NewSTSClient(cfg aws.Config) STSClient {
 	return sts.New(cfg)
 }
 
+// EncryptionConfigClient associates encryption configurations with existing
+// clusters. The V1 SDK is used since AssociateEncryptionConfig is not
+// supported by the V2 SDK.
+type EncryptionConfigClient interface {
+	AssociateEncryptionConfigWithContext(ctx context.Context, input *eksv1.AssociateEncryptionConfigInput, opts ...request.Option) (*eksv1.AssociateEncryptionConfigOutput, error)
+}
+
+// NewEncryptionConfigClient returns a new V1 client using the supplied
+// session.
+func NewEncryptionConfigClient(sess *session.Session) EncryptionConfigClient {
+	return eksv1.New(sess)
+}
+
 // IsErrorNotFound helper function to test for ErrCodeResourceNotFoundException error.
 func IsErrorNotFound(err error) bool {
 	if err == nil {
@@ -154,13 +200,17 @@ func CreatePatch(in *eks.Cluster, target *v1beta1.ClusterParameters) (*v1beta1.C
 	return patch, nil
 }
 
-// GenerateUpdateClusterConfigInput from ClusterParameters.
-func GenerateUpdateClusterConfigInput(name string, p *v1beta1.ClusterParameters) *eks.UpdateClusterConfigInput {
+// GenerateUpdateClusterConfigInput from the supplied patch of
+// ClusterParameters. It returns nil if neither the logging nor the VPC
+// configuration of the supplied eks.Cluster needs to be updated.
+func GenerateUpdateClusterConfigInput(name string, p *v1beta1.ClusterParameters, cluster *eks.Cluster) *eks.UpdateClusterConfigInput {
 	u := &eks.UpdateClusterConfigInput{
 		Name: awsclients.String(name),
 	}
 
-	if p.Logging != nil {
+	// EKS only accepts one type of configuration update per request, so
+	// logging changes are sent on their own before any VPC changes.
+	if p.Logging != nil && !IsLoggingUpToDate(p.Logging, cluster.Logging) {
 		u.Logging = &eks.Logging{
 			ClusterLogging: make([]eks.LogSetup, len(p.Logging.ClusterLogging)),
 		}
@@ -174,11 +224,15 @@ func GenerateUpdateClusterConfigInput(name string, p *v1beta1.ClusterParameters)
 				Types:   types,
 			}
 		}
+		return u
 	}
 
 	// NOTE(muvaf): SecurityGroupIds and SubnetIds cannot be updated. They are
 	// included in VpcConfigRequest probably because it is used in Create call
 	// as well.
+	if p.ResourcesVpcConfig.EndpointPrivateAccess == nil && p.ResourcesVpcConfig.EndpointPublicAccess == nil && len(p.ResourcesVpcConfig.PublicAccessCidrs) == 0 {
+		return nil
+	}
 	u.ResourcesVpcConfig = &eks.VpcConfigRequest{
 		EndpointPrivateAccess: p.ResourcesVpcConfig.EndpointPrivateAccess,
 		EndpointPublicAccess:  p.ResourcesVpcConfig.EndpointPublicAccess,
@@ -187,6 +241,152 @@ func GenerateUpdateClusterConfigInput(name string, p *v1beta1.ClusterParameters)
 	return u
 }
 
+// IsLoggingUpToDate returns true if the supplied eks.Logging enables exactly
+// the log types that the supplied Logging enables. Log types that are not
+// enabled are disabled, so how they are listed does not matter.
+func IsLoggingUpToDate(p *v1beta1.Logging, l *eks.Logging) bool {
+	if p == nil {
+		return true
+	}
+	desired := map[string]bool{}
+	for _, cl := range p.ClusterLogging {
+		for _, t := range cl.Types {
+			desired[string(t)] = awsclients.BoolValue(cl.Enabled)
+		}
+	}
+	observed := map[string]bool{}
+	if l != nil {
+		for _, cl := range l.ClusterLogging {
+			for _, t := range cl.Types {
+				observed[string(t)] = awsclients.BoolValue(cl.Enabled)
+			}
+		}
+	}
+	for t := range desired {
+		if desired[t] != observed[t] {
+			return false
+		}
+	}
+	for t := range observed {
+		if desired[t] != observed[t] {
+			return false
+		}
+	}
+	return true
+}
+
+// GenerateAssociateEncryptionConfigInput from ClusterParameters.
+func GenerateAssociateEncryptionConfigInput(name string, p *v1beta1.ClusterParameters) *eksv1.AssociateEncryptionConfigInput {
+	a := &eksv1.AssociateEncryptionConfigInput{
+		ClusterName:      awsclients.String(name),
+		EncryptionConfig: make([]*eksv1.EncryptionConfig, len(p.EncryptionConfig)),
+	}
+	for i, conf := range p.EncryptionConfig {
+		a.EncryptionConfig[i] = &eksv1.EncryptionConfig{
+			Provider: &eksv1.Provider{
+				KeyArn: awsclients.String(conf.Provider.KeyArn),
+			},
+			Resources: stringSlice(conf.Resources),
+		}
+	}
+	return a
+}
+
+// NextClusterVersion returns the version a cluster running the current
+// version must be upgraded to on its way to the desired version. EKS only
+// supports upgrading a cluster by one minor version at a time. The desired
+// version is returned if either version cannot be parsed.
+func NextClusterVersion(current, desired string) string {
+	curMajor, curMinor, err := parseClusterVersion(current)
+	if err != nil {
+		return desired
+	}
+	desMajor, desMinor, err := parseClusterVersion(desired)
+	if err != nil {
+		return desired
+	}
+	if curMajor != desMajor || desMinor <= curMinor+1 {
+		return desired
+	}
+	return fmt.Sprintf("%d.%d", curMajor, curMinor+1)
+}
+
+func parseClusterVersion(v string) (int, int, error) {
+	parts := strings.SplitN(v, ".", 2)
+	if len(parts) != 2 {
+		return 0, 0, errors.New("version must be of the form <major>.<minor>")
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, err
+	}
+	return major, minor, nil
+}
+
+// GenerateClusterUpdate is used to produce v1beta1.ClusterUpdate from
+// eks.Update.
+func GenerateClusterUpdate(u *eks.Update) *v1beta1.ClusterUpdate {
+	if u == nil {
+		return nil
+	}
+	o := &v1beta1.ClusterUpdate{
+		ID:     awsclients.StringValue(u.Id),
+		Status: string(u.Status),
+		Type:   string(u.Type),
+	}
+	if u.CreatedAt != nil {
+		o.CreatedAt = &metav1.Time{Time: *u.CreatedAt}
+	}
+	if len(u.Errors) > 0 {
+		o.Errors = make([]v1beta1.ClusterUpdateError, len(u.Errors))
+		for i, e := range u.Errors {
+			o.Errors[i] = v1beta1.ClusterUpdateError{
+				ErrorCode:    string(e.ErrorCode),
+				ErrorMessage: awsclients.StringValue(e.ErrorMessage),
+				ResourceIDs:  e.ResourceIds,
+			}
+		}
+	}
+	return o
+}
+
+// GenerateClusterUpdateV1 is used to produce v1beta1.ClusterUpdate from the
+// eks.Update of the V1 SDK.
+func GenerateClusterUpdateV1(u *eksv1.Update) *v1beta1.ClusterUpdate {
+	if u == nil {
+		return nil
+	}
+	o := &v1beta1.ClusterUpdate{
+		ID:     awsclients.StringValue(u.Id),
+		Status: awsclients.StringValue(u.Status),
+		Type:   awsclients.StringValue(u.Type),
+	}
+	if u.CreatedAt != nil {
+		o.CreatedAt = &metav1.Time{Time: *u.CreatedAt}
+	}
+	if len(u.Errors) > 0 {
+		o.Errors = make([]v1beta1.ClusterUpdateError, len(u.Errors))
+		for i, e := range u.Errors {
+			o.Errors[i] = v1beta1.ClusterUpdateError{
+				ErrorCode:    awsclients.StringValue(e.ErrorCode),
+				ErrorMessage: awsclients.StringValue(e.ErrorMessage),
+				ResourceIDs:  awsv1.StringValueSlice(e.ResourceIds),
+			}
+		}
+	}
+	return o
+}
+
+// IsUpdateInProgress returns true if the supplied update has not yet
+// completed.
+func IsUpdateInProgress(u *v1beta1.ClusterUpdate) bool {
+	return u != nil && u.Status == string(eks.UpdateStatusInProgress)
+}
+
 // GenerateObservation is used to produce v1beta1.ClusterObservation from
 // eks.Cluster.
 func GenerateObservation(cluster *eks.Cluster) v1beta1.ClusterObservation { // nolint:gocyclo
@@ -312,14 +512,34 @@ func IsUpToDate(p *v1beta1.ClusterParameters, cluster *eks.Cluster) (bool, error
 			}
 		}
 	}
+	if !IsLoggingUpToDate(p.Logging, cluster.Logging) {
+		return false, nil
+	}
 	res := cmp.Equal(&v1beta1.ClusterParameters{}, patch, cmpopts.EquateEmpty(),
 		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}, []xpv1.Reference{}),
-		cmpopts.IgnoreFields(v1beta1.ClusterParameters{}, "Region"),
+		cmpopts.IgnoreFields(v1beta1.ClusterParameters{}, "Region", "Logging"),
 		cmpopts.IgnoreFields(v1beta1.VpcConfigRequest{}, "PublicAccessCidrs", "SubnetIDs", "SecurityGroupIDs"))
 	return res, nil
 }
 
+// GetDriftedFields returns the fields of the supplied ClusterParameters that
+// differ from the supplied eks.Cluster.
+func GetDriftedFields(p *v1beta1.ClusterParameters, cluster *eks.Cluster) ([]string, error) {
+	current := &v1beta1.ClusterParameters{}
+	LateInitialize(current, cluster)
+	fields, err := awsclients.DriftedFields(current, p, "region", "logging", "resourcesVpcConfig.subnetIds", "resourcesVpcConfig.securityGroupIds")
+	if err != nil || IsLoggingUpToDate(p.Logging, cluster.Logging) {
+		return fields, err
+	}
+	fields = append(fields, "logging")
+	sort.Strings(fields)
+	return fields, nil
+}
+
 // GetConnectionDetails extracts managed.ConnectionDetails out of eks.Cluster.
+// The kubeconfig embeds a bearer token that is only valid for TokenLifetime,
+// so its expiry is published alongside it together with an exec-based
+// kubeconfig that does not expire.
 func GetConnectionDetails(cluster *eks.Cluster, stsClient STSClient) managed.ConnectionDetails {
 	if cluster == nil || cluster.Name == nil || cluster.Endpoint == nil || cluster.CertificateAuthority == nil || cluster.CertificateAuthority.Data == nil {
 		return managed.ConnectionDetails{}
@@ -339,6 +559,7 @@ func GetConnectionDetails(cluster *eks.Cluster, stsClient STSClient) managed.Con
 		return managed.ConnectionDetails{}
 	}
 	token := v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(presignedURLString))
+	expiration := request.Time.Add(TokenLifetime)
 
 	// NOTE(hasheddan): We must decode the CA data before constructing our
 	// Kubeconfig, as the raw Kubeconfig will be base64 encoded again when
@@ -347,7 +568,47 @@ func GetConnectionDetails(cluster *eks.Cluster, stsClient STSClient) managed.Con
 	if err != nil {
 		return managed.ConnectionDetails{}
 	}
-	kc := clientcmdapi.Config{
+
+	rawConfig, err := clientcmd.Write(generateKubeconfig(cluster, caData, &clientcmdapi.AuthInfo{
+		Token: token,
+	}))
+	if err != nil {
+		return managed.ConnectionDetails{}
+	}
+	rawExecConfig, err := clientcmd.Write(generateKubeconfig(cluster, caData, &clientcmdapi.AuthInfo{
+		Exec: &clientcmdapi.ExecConfig{
+			APIVersion: execAPIVersion,
+			Command:    execCommand,
+			Args:       []string{"token", "-i", *cluster.Name},
+		},
+	}))
+	if err != nil {
+		return managed.ConnectionDetails{}
+	}
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey:   []byte(*cluster.Endpoint),
+		xpv1.ResourceCredentialsSecretKubeconfigKey: rawConfig,
+		xpv1.ResourceCredentialsSecretCAKey:         caData,
+		ConnectionSecretTokenExpirationKey:          []byte(expiration.UTC().Format(time.RFC3339)),
+		ConnectionSecretExecKubeconfigKey:           rawExecConfig,
+	}
+}
+
+// TokenNeedsRefresh returns true if the bearer token in the supplied connection
+// secret data is missing, unreadable, or expires within TokenRefreshMargin of
+// the supplied time.
+func TokenNeedsRefresh(data map[string][]byte, now time.Time) bool {
+	exp, err := time.Parse(time.RFC3339, string(data[ConnectionSecretTokenExpirationKey]))
+	if err != nil {
+		return true
+	}
+	return !now.Add(TokenRefreshMargin).Before(exp)
+}
+
+// generateKubeconfig returns a kubeconfig for the supplied cluster that
+// authenticates using the supplied AuthInfo.
+func generateKubeconfig(cluster *eks.Cluster, caData []byte, auth *clientcmdapi.AuthInfo) clientcmdapi.Config {
+	return clientcmdapi.Config{
 		Clusters: map[string]*clientcmdapi.Cluster{
 			*cluster.Name: {
 				Server:                   *cluster.Endpoint,
@@ -361,20 +622,8 @@ func GetConnectionDetails(cluster *eks.Cluster, stsClient STSClient) managed.Con
 			},
 		},
 		AuthInfos: map[string]*clientcmdapi.AuthInfo{
-			*cluster.Name: {
-				Token: token,
-			},
+			*cluster.Name: auth,
 		},
 		CurrentContext: *cluster.Name,
 	}
-
-	rawConfig, err := clientcmd.Write(kc)
-	if err != nil {
-		return managed.ConnectionDetails{}
-	}
-	return managed.ConnectionDetails{
-		xpv1.ResourceCredentialsSecretEndpointKey:   []byte(*cluster.Endpoint),
-		xpv1.ResourceCredentialsSecretKubeconfigKey: rawConfig,
-		xpv1.ResourceCredentialsSecretCAKey:         caData,
-	}
 }
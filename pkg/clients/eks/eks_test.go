@@ -21,11 +21,18 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/defaults"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	eksv1 "github.com/aws/aws-sdk-go/service/eks"
 	"github.com/google/go-cmp/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
 )
@@ -241,8 +248,9 @@ func TestGenerateCreateClusterInput(t *testing.T) {
 
 func TestGenerateUpdateClusterInput(t *testing.T) {
 	type args struct {
-		name string
-		p    *v1beta1.ClusterParameters
+		name    string
+		p       *v1beta1.ClusterParameters
+		cluster *eks.Cluster
 	}
 
 	cases := map[string]struct {
@@ -280,6 +288,18 @@ func TestGenerateUpdateClusterInput(t *testing.T) {
 					Tags:    map[string]string{"key": "val"},
 					Version: &version,
 				},
+				cluster: &eks.Cluster{
+					Logging: &eks.Logging{
+						ClusterLogging: []eks.LogSetup{
+							{
+								Enabled: &trueVal,
+								Types: []eks.LogType{
+									eks.LogTypeApi,
+								},
+							},
+						},
+					},
+				},
 			},
 			want: &eks.UpdateClusterConfigInput{
 				Logging: &eks.Logging{
@@ -293,11 +313,6 @@ func TestGenerateUpdateClusterInput(t *testing.T) {
 					},
 				},
 				Name: &clusterName,
-				ResourcesVpcConfig: &eks.VpcConfigRequest{
-					EndpointPrivateAccess: &trueVal,
-					EndpointPublicAccess:  &trueVal,
-					PublicAccessCidrs:     []string{"0.0.0.0/0"},
-				},
 			},
 		},
 		"SomeFields": {
@@ -312,6 +327,7 @@ func TestGenerateUpdateClusterInput(t *testing.T) {
 					RoleArn: roleArn,
 					Version: &version,
 				},
+				cluster: &eks.Cluster{},
 			},
 			want: &eks.UpdateClusterConfigInput{
 				Name: &clusterName,
@@ -322,11 +338,322 @@ func TestGenerateUpdateClusterInput(t *testing.T) {
 				},
 			},
 		},
+		"LoggingUpToDate": {
+			args: args{
+				name: clusterName,
+				p: &v1beta1.ClusterParameters{
+					Logging: &v1beta1.Logging{
+						ClusterLogging: []v1beta1.LogSetup{
+							{
+								Enabled: &trueVal,
+								Types: []v1beta1.LogType{
+									v1beta1.LogTypeAPI,
+								},
+							},
+						},
+					},
+					ResourcesVpcConfig: v1beta1.VpcConfigRequest{
+						EndpointPublicAccess: &falseVal,
+					},
+				},
+				cluster: &eks.Cluster{
+					Logging: &eks.Logging{
+						ClusterLogging: []eks.LogSetup{
+							{
+								Enabled: &trueVal,
+								Types: []eks.LogType{
+									eks.LogTypeApi,
+								},
+							},
+							{
+								Enabled: &falseVal,
+								Types: []eks.LogType{
+									eks.LogTypeAudit,
+									eks.LogTypeAuthenticator,
+								},
+							},
+						},
+					},
+				},
+			},
+			want: &eks.UpdateClusterConfigInput{
+				Name: &clusterName,
+				ResourcesVpcConfig: &eks.VpcConfigRequest{
+					EndpointPublicAccess: &falseVal,
+				},
+			},
+		},
+		"NothingToUpdate": {
+			args: args{
+				name: clusterName,
+				p: &v1beta1.ClusterParameters{
+					ResourcesVpcConfig: v1beta1.VpcConfigRequest{
+						SubnetIDs: []string{"subnet"},
+					},
+				},
+				cluster: &eks.Cluster{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateClusterConfigInput(tc.args.name, tc.args.p, tc.args.cluster)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateAssociateEncryptionConfigInput(t *testing.T) {
+	type args struct {
+		name string
+		p    *v1beta1.ClusterParameters
+	}
+
+	cases := map[string]struct {
+		args args
+		want *eksv1.AssociateEncryptionConfigInput
+	}{
+		"AllFields": {
+			args: args{
+				name: clusterName,
+				p: &v1beta1.ClusterParameters{
+					EncryptionConfig: []v1beta1.EncryptionConfig{
+						{
+							Provider: v1beta1.Provider{
+								KeyArn: keyArn,
+							},
+							Resources: []string{"secrets"},
+						},
+					},
+					RoleArn: roleArn,
+					Version: &version,
+				},
+			},
+			want: &eksv1.AssociateEncryptionConfigInput{
+				ClusterName: &clusterName,
+				EncryptionConfig: []*eksv1.EncryptionConfig{
+					{
+						Provider: &eksv1.Provider{
+							KeyArn: &keyArn,
+						},
+						Resources: []*string{aws.String("secrets")},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateAssociateEncryptionConfigInput(tc.args.name, tc.args.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNextClusterVersion(t *testing.T) {
+	type args struct {
+		current string
+		desired string
+	}
+
+	cases := map[string]struct {
+		args args
+		want string
+	}{
+		"NextMinor": {
+			args: args{current: "1.16", desired: "1.17"},
+			want: "1.17",
+		},
+		"SeveralMinors": {
+			args: args{current: "1.16", desired: "1.19"},
+			want: "1.17",
+		},
+		"Downgrade": {
+			args: args{current: "1.17", desired: "1.16"},
+			want: "1.16",
+		},
+		"DifferentMajor": {
+			args: args{current: "1.17", desired: "2.0"},
+			want: "2.0",
+		},
+		"UnknownCurrent": {
+			args: args{current: "", desired: "1.19"},
+			want: "1.19",
+		},
+		"InvalidDesired": {
+			args: args{current: "1.16", desired: "1.x"},
+			want: "1.x",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NextClusterVersion(tc.args.current, tc.args.desired)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateClusterUpdate(t *testing.T) {
+	createTime := time.Now()
+	updateID := "update-id"
+	errMessage := "bad things"
+
+	cases := map[string]struct {
+		update *eks.Update
+		want   *v1beta1.ClusterUpdate
+	}{
+		"Nil": {},
+		"AllFields": {
+			update: &eks.Update{
+				CreatedAt: &createTime,
+				Errors: []eks.ErrorDetail{
+					{
+						ErrorCode:    eks.ErrorCodeAccessDenied,
+						ErrorMessage: &errMessage,
+						ResourceIds:  []string{keyArn},
+					},
+				},
+				Id:     &updateID,
+				Status: eks.UpdateStatusFailed,
+				Type:   eks.UpdateTypeVersionUpdate,
+			},
+			want: &v1beta1.ClusterUpdate{
+				CreatedAt: &metav1.Time{Time: createTime},
+				Errors: []v1beta1.ClusterUpdateError{
+					{
+						ErrorCode:    string(eks.ErrorCodeAccessDenied),
+						ErrorMessage: errMessage,
+						ResourceIDs:  []string{keyArn},
+					},
+				},
+				ID:     updateID,
+				Status: string(eks.UpdateStatusFailed),
+				Type:   string(eks.UpdateTypeVersionUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateClusterUpdate(tc.update)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateClusterUpdateV1(t *testing.T) {
+	createTime := time.Now()
+	updateID := "update-id"
+	errMessage := "bad things"
+
+	cases := map[string]struct {
+		update *eksv1.Update
+		want   *v1beta1.ClusterUpdate
+	}{
+		"Nil": {},
+		"AllFields": {
+			update: &eksv1.Update{
+				CreatedAt: &createTime,
+				Errors: []*eksv1.ErrorDetail{
+					{
+						ErrorCode:    awsv1.String(eksv1.ErrorCodeAccessDenied),
+						ErrorMessage: &errMessage,
+						ResourceIds:  []*string{&keyArn},
+					},
+				},
+				Id:     &updateID,
+				Status: awsv1.String(eksv1.UpdateStatusFailed),
+				Type:   awsv1.String(eksv1.UpdateTypeAssociateEncryptionConfig),
+			},
+			want: &v1beta1.ClusterUpdate{
+				CreatedAt: &metav1.Time{Time: createTime},
+				Errors: []v1beta1.ClusterUpdateError{
+					{
+						ErrorCode:    eksv1.ErrorCodeAccessDenied,
+						ErrorMessage: errMessage,
+						ResourceIDs:  []string{keyArn},
+					},
+				},
+				ID:     updateID,
+				Status: eksv1.UpdateStatusFailed,
+				Type:   eksv1.UpdateTypeAssociateEncryptionConfig,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateClusterUpdateV1(tc.update)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsLoggingUpToDate(t *testing.T) {
+	type args struct {
+		p *v1beta1.Logging
+		l *eks.Logging
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NotSet": {
+			args: args{
+				l: &eks.Logging{ClusterLogging: []eks.LogSetup{{Enabled: &trueVal, Types: []eks.LogType{eks.LogTypeApi}}}},
+			},
+			want: true,
+		},
+		"DisabledTypesOmitted": {
+			args: args{
+				p: &v1beta1.Logging{ClusterLogging: []v1beta1.LogSetup{{Enabled: &trueVal, Types: []v1beta1.LogType{v1beta1.LogTypeAPI}}}},
+				l: &eks.Logging{ClusterLogging: []eks.LogSetup{
+					{Enabled: &trueVal, Types: []eks.LogType{eks.LogTypeApi}},
+					{Enabled: &falseVal, Types: []eks.LogType{eks.LogTypeAudit, eks.LogTypeScheduler}},
+				}},
+			},
+			want: true,
+		},
+		"AllDisabled": {
+			args: args{
+				p: &v1beta1.Logging{ClusterLogging: []v1beta1.LogSetup{{Enabled: &falseVal, Types: []v1beta1.LogType{v1beta1.LogTypeAPI}}}},
+			},
+			want: true,
+		},
+		"TypeNotEnabled": {
+			args: args{
+				p: &v1beta1.Logging{ClusterLogging: []v1beta1.LogSetup{{Enabled: &trueVal, Types: []v1beta1.LogType{v1beta1.LogTypeAPI, v1beta1.LogTypeAudit}}}},
+				l: &eks.Logging{ClusterLogging: []eks.LogSetup{
+					{Enabled: &trueVal, Types: []eks.LogType{eks.LogTypeApi}},
+					{Enabled: &falseVal, Types: []eks.LogType{eks.LogTypeAudit}},
+				}},
+			},
+		},
+		"TypeNotDisabled": {
+			args: args{
+				p: &v1beta1.Logging{ClusterLogging: []v1beta1.LogSetup{{Enabled: &falseVal, Types: []v1beta1.LogType{v1beta1.LogTypeAPI}}}},
+				l: &eks.Logging{ClusterLogging: []eks.LogSetup{{Enabled: &trueVal, Types: []eks.LogType{eks.LogTypeApi}}}},
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			got := GenerateUpdateClusterConfigInput(tc.args.name, tc.args.p)
+			got := IsLoggingUpToDate(tc.args.p, tc.args.l)
 			if diff := cmp.Diff(tc.want, got); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
@@ -818,3 +1145,141 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGetConnectionDetails(t *testing.T) {
+	endpoint := "https://example.eks.amazonaws.com"
+
+	cfg := defaults.Config()
+	cfg.Region = "us-east-1"
+	cfg.Credentials = aws.NewStaticCredentialsProvider("AKID", "SECRET", "")
+
+	cases := map[string]struct {
+		cluster *eks.Cluster
+		want    managed.ConnectionDetails
+	}{
+		"NilCluster": {
+			want: managed.ConnectionDetails{},
+		},
+		"MissingCertificateAuthority": {
+			cluster: &eks.Cluster{Name: &clusterName, Endpoint: &endpoint},
+			want:    managed.ConnectionDetails{},
+		},
+		"InvalidCertificateAuthority": {
+			cluster: &eks.Cluster{
+				Name:                 &clusterName,
+				Endpoint:             &endpoint,
+				CertificateAuthority: &eks.Certificate{Data: aws.String("%%%")},
+			},
+			want: managed.ConnectionDetails{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetConnectionDetails(tc.cluster, sts.New(cfg))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTokenNeedsRefresh(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	expires := func(d time.Duration) map[string][]byte {
+		return map[string][]byte{ConnectionSecretTokenExpirationKey: []byte(now.Add(d).Format(time.RFC3339))}
+	}
+
+	cases := map[string]struct {
+		data map[string][]byte
+		want bool
+	}{
+		"NoExpiration": {
+			data: map[string][]byte{},
+			want: true,
+		},
+		"InvalidExpiration": {
+			data: map[string][]byte{ConnectionSecretTokenExpirationKey: []byte("soon")},
+			want: true,
+		},
+		"Expired": {
+			data: expires(-time.Minute),
+			want: true,
+		},
+		"ExpiresWithinMargin": {
+			data: expires(TokenRefreshMargin),
+			want: true,
+		},
+		"Valid": {
+			data: expires(TokenRefreshMargin + time.Minute),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := TokenNeedsRefresh(tc.data, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateKubeconfig(t *testing.T) {
+	endpoint := "https://example.eks.amazonaws.com"
+	caData := []byte("ca")
+	exec := &clientcmdapi.AuthInfo{Exec: &clientcmdapi.ExecConfig{
+		APIVersion: execAPIVersion,
+		Command:    execCommand,
+		Args:       []string{"token", "-i", clusterName},
+	}}
+
+	cases := map[string]struct {
+		cluster *eks.Cluster
+		auth    *clientcmdapi.AuthInfo
+		want    clientcmdapi.Config
+	}{
+		"Token": {
+			cluster: &eks.Cluster{Name: &clusterName, Endpoint: &endpoint},
+			auth:    &clientcmdapi.AuthInfo{Token: v1Prefix + "token"},
+			want: clientcmdapi.Config{
+				Clusters: map[string]*clientcmdapi.Cluster{
+					clusterName: {Server: endpoint, CertificateAuthorityData: caData},
+				},
+				Contexts: map[string]*clientcmdapi.Context{
+					clusterName: {Cluster: clusterName, AuthInfo: clusterName},
+				},
+				AuthInfos: map[string]*clientcmdapi.AuthInfo{
+					clusterName: {Token: v1Prefix + "token"},
+				},
+				CurrentContext: clusterName,
+			},
+		},
+		"Exec": {
+			cluster: &eks.Cluster{Name: &clusterName, Endpoint: &endpoint},
+			auth:    exec,
+			want: clientcmdapi.Config{
+				Clusters: map[string]*clientcmdapi.Cluster{
+					clusterName: {Server: endpoint, CertificateAuthorityData: caData},
+				},
+				Contexts: map[string]*clientcmdapi.Context{
+					clusterName: {Cluster: clusterName, AuthInfo: clusterName},
+				},
+				AuthInfos: map[string]*clientcmdapi.AuthInfo{
+					clusterName: exec,
+				},
+				CurrentContext: clusterName,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := generateKubeconfig(tc.cluster, caData, tc.auth)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,8 +17,14 @@ limitations under the License.
 package fake
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/eksiface"
+	"github.com/aws/aws-sdk-go/aws/request"
+	eksv1 "github.com/aws/aws-sdk-go/service/eks"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/eks"
 )
 
 var _ eksiface.ClientAPI = &MockClient{}
@@ -34,12 +40,7 @@ type MockClient struct {
 	MockTagResourceRequest          func(*eks.TagResourceInput) eks.TagResourceRequest
 	MockUntagResourceRequest        func(*eks.UntagResourceInput) eks.UntagResourceRequest
 	MockUpdateClusterVersionRequest func(*eks.UpdateClusterVersionInput) eks.UpdateClusterVersionRequest
-
-	MockDescribeNodegroupRequest      func(*eks.DescribeNodegroupInput) eks.DescribeNodegroupRequest
-	MockCreateNodegroupRequest        func(*eks.CreateNodegroupInput) eks.CreateNodegroupRequest
-	MockUpdateNodegroupVersionRequest func(*eks.UpdateNodegroupVersionInput) eks.UpdateNodegroupVersionRequest
-	MockUpdateNodegroupConfigRequest  func(*eks.UpdateNodegroupConfigInput) eks.UpdateNodegroupConfigRequest
-	MockDeleteNodegroupRequest        func(*eks.DeleteNodegroupInput) eks.DeleteNodegroupRequest
+	MockDescribeUpdateRequest       func(*eks.DescribeUpdateInput) eks.DescribeUpdateRequest
 
 	MockDescribeFargateProfileRequest func(*eks.DescribeFargateProfileInput) eks.DescribeFargateProfileRequest
 	MockCreateFargateProfileRequest   func(*eks.CreateFargateProfileInput) eks.CreateFargateProfileRequest
@@ -84,34 +85,10 @@ func (c *MockClient) UpdateClusterVersionRequest(i *eks.UpdateClusterVersionInpu
 	return c.MockUpdateClusterVersionRequest(i)
 }
 
-// DescribeNodegroupRequest calls the underlying MockDescribeNodegroupRequest
-// method.
-func (c *MockClient) DescribeNodegroupRequest(i *eks.DescribeNodegroupInput) eks.DescribeNodegroupRequest {
-	return c.MockDescribeNodegroupRequest(i)
-}
-
-// CreateNodegroupRequest calls the underlying MockCreateNodegroupRequest
-// method.
-func (c *MockClient) CreateNodegroupRequest(i *eks.CreateNodegroupInput) eks.CreateNodegroupRequest {
-	return c.MockCreateNodegroupRequest(i)
-}
-
-// UpdateNodegroupVersionRequest calls the underlying
-// MockUpdateNodegroupVersionRequest method.
-func (c *MockClient) UpdateNodegroupVersionRequest(i *eks.UpdateNodegroupVersionInput) eks.UpdateNodegroupVersionRequest {
-	return c.MockUpdateNodegroupVersionRequest(i)
-}
-
-// UpdateNodegroupConfigRequest calls the underlying
-// MockUpdateNodegroupConfigRequest method.
-func (c *MockClient) UpdateNodegroupConfigRequest(i *eks.UpdateNodegroupConfigInput) eks.UpdateNodegroupConfigRequest {
-	return c.MockUpdateNodegroupConfigRequest(i)
-}
-
-// DeleteNodegroupRequest calls the underlying MockDeleteNodegroupRequest
+// DescribeUpdateRequest calls the underlying MockDescribeUpdateRequest
 // method.
-func (c *MockClient) DeleteNodegroupRequest(i *eks.DeleteNodegroupInput) eks.DeleteNodegroupRequest {
-	return c.MockDeleteNodegroupRequest(i)
+func (c *MockClient) DescribeUpdateRequest(i *eks.DescribeUpdateInput) eks.DescribeUpdateRequest {
+	return c.MockDescribeUpdateRequest(i)
 }
 
 // DescribeFargateProfileRequest calls the underlying MockDescribeFargateProfileRequest
@@ -131,3 +108,150 @@ func (c *MockClient) CreateFargateProfileRequest(i *eks.CreateFargateProfileInpu
 func (c *MockClient) DeleteFargateProfileRequest(i *eks.DeleteFargateProfileInput) eks.DeleteFargateProfileRequest {
 	return c.MockDeleteFargateProfileRequest(i)
 }
+
+var _ clientset.EncryptionConfigClient = &MockEncryptionConfigClient{}
+
+// MockEncryptionConfigClient is a type that implements all the methods for
+// EncryptionConfigClient interface.
+type MockEncryptionConfigClient struct {
+	MockAssociateEncryptionConfig func(*eksv1.AssociateEncryptionConfigInput) (*eksv1.AssociateEncryptionConfigOutput, error)
+}
+
+// AssociateEncryptionConfigWithContext calls the underlying
+// MockAssociateEncryptionConfig method.
+func (c *MockEncryptionConfigClient) AssociateEncryptionConfigWithContext(_ context.Context, i *eksv1.AssociateEncryptionConfigInput, _ ...request.Option) (*eksv1.AssociateEncryptionConfigOutput, error) {
+	return c.MockAssociateEncryptionConfig(i)
+}
+
+var _ clientset.NodeGroupClient = &MockNodeGroupClient{}
+
+// MockNodeGroupClient is a fake implementation of eks.NodeGroupClient.
+type MockNodeGroupClient struct {
+	MockCreateNodegroup        func(*eksv1.CreateNodegroupInput) (*eksv1.CreateNodegroupOutput, error)
+	MockDescribeNodegroup      func(*eksv1.DescribeNodegroupInput) (*eksv1.DescribeNodegroupOutput, error)
+	MockUpdateNodegroupConfig  func(*eksv1.UpdateNodegroupConfigInput) (*eksv1.UpdateNodegroupConfigOutput, error)
+	MockUpdateNodegroupVersion func(*eksv1.UpdateNodegroupVersionInput) (*eksv1.UpdateNodegroupVersionOutput, error)
+	MockDeleteNodegroup        func(*eksv1.DeleteNodegroupInput) (*eksv1.DeleteNodegroupOutput, error)
+	MockTagResource            func(*eksv1.TagResourceInput) (*eksv1.TagResourceOutput, error)
+	MockUntagResource          func(*eksv1.UntagResourceInput) (*eksv1.UntagResourceOutput, error)
+}
+
+// CreateNodegroupWithContext calls the underlying MockCreateNodegroup method.
+func (c *MockNodeGroupClient) CreateNodegroupWithContext(_ context.Context, i *eksv1.CreateNodegroupInput, _ ...request.Option) (*eksv1.CreateNodegroupOutput, error) {
+	return c.MockCreateNodegroup(i)
+}
+
+// DescribeNodegroupWithContext calls the underlying MockDescribeNodegroup
+// method.
+func (c *MockNodeGroupClient) DescribeNodegroupWithContext(_ context.Context, i *eksv1.DescribeNodegroupInput, _ ...request.Option) (*eksv1.DescribeNodegroupOutput, error) {
+	return c.MockDescribeNodegroup(i)
+}
+
+// UpdateNodegroupConfigWithContext calls the underlying
+// MockUpdateNodegroupConfig method.
+func (c *MockNodeGroupClient) UpdateNodegroupConfigWithContext(_ context.Context, i *eksv1.UpdateNodegroupConfigInput, _ ...request.Option) (*eksv1.UpdateNodegroupConfigOutput, error) {
+	return c.MockUpdateNodegroupConfig(i)
+}
+
+// UpdateNodegroupVersionWithContext calls the underlying
+// MockUpdateNodegroupVersion method.
+func (c *MockNodeGroupClient) UpdateNodegroupVersionWithContext(_ context.Context, i *eksv1.UpdateNodegroupVersionInput, _ ...request.Option) (*eksv1.UpdateNodegroupVersionOutput, error) {
+	return c.MockUpdateNodegroupVersion(i)
+}
+
+// DeleteNodegroupWithContext calls the underlying MockDeleteNodegroup method.
+func (c *MockNodeGroupClient) DeleteNodegroupWithContext(_ context.Context, i *eksv1.DeleteNodegroupInput, _ ...request.Option) (*eksv1.DeleteNodegroupOutput, error) {
+	return c.MockDeleteNodegroup(i)
+}
+
+// TagResourceWithContext calls the underlying MockTagResource method.
+func (c *MockNodeGroupClient) TagResourceWithContext(_ context.Context, i *eksv1.TagResourceInput, _ ...request.Option) (*eksv1.TagResourceOutput, error) {
+	return c.MockTagResource(i)
+}
+
+// UntagResourceWithContext calls the underlying MockUntagResource method.
+func (c *MockNodeGroupClient) UntagResourceWithContext(_ context.Context, i *eksv1.UntagResourceInput, _ ...request.Option) (*eksv1.UntagResourceOutput, error) {
+	return c.MockUntagResource(i)
+}
+
+var _ clientset.AddonClient = &MockAddonClient{}
+
+// MockAddonClient is a fake implementation of eks.AddonClient.
+type MockAddonClient struct {
+	MockCreateAddon   func(*eksv1.CreateAddonInput) (*eksv1.CreateAddonOutput, error)
+	MockDescribeAddon func(*eksv1.DescribeAddonInput) (*eksv1.DescribeAddonOutput, error)
+	MockUpdateAddon   func(*eksv1.UpdateAddonInput) (*eksv1.UpdateAddonOutput, error)
+	MockDeleteAddon   func(*eksv1.DeleteAddonInput) (*eksv1.DeleteAddonOutput, error)
+	MockTagResource   func(*eksv1.TagResourceInput) (*eksv1.TagResourceOutput, error)
+	MockUntagResource func(*eksv1.UntagResourceInput) (*eksv1.UntagResourceOutput, error)
+}
+
+// CreateAddonWithContext calls the underlying MockCreateAddon method.
+func (c *MockAddonClient) CreateAddonWithContext(_ context.Context, i *eksv1.CreateAddonInput, _ ...request.Option) (*eksv1.CreateAddonOutput, error) {
+	return c.MockCreateAddon(i)
+}
+
+// DescribeAddonWithContext calls the underlying MockDescribeAddon method.
+func (c *MockAddonClient) DescribeAddonWithContext(_ context.Context, i *eksv1.DescribeAddonInput, _ ...request.Option) (*eksv1.DescribeAddonOutput, error) {
+	return c.MockDescribeAddon(i)
+}
+
+// UpdateAddonWithContext calls the underlying MockUpdateAddon method.
+func (c *MockAddonClient) UpdateAddonWithContext(_ context.Context, i *eksv1.UpdateAddonInput, _ ...request.Option) (*eksv1.UpdateAddonOutput, error) {
+	return c.MockUpdateAddon(i)
+}
+
+// DeleteAddonWithContext calls the underlying MockDeleteAddon method.
+func (c *MockAddonClient) DeleteAddonWithContext(_ context.Context, i *eksv1.DeleteAddonInput, _ ...request.Option) (*eksv1.DeleteAddonOutput, error) {
+	return c.MockDeleteAddon(i)
+}
+
+// TagResourceWithContext calls the underlying MockTagResource method.
+func (c *MockAddonClient) TagResourceWithContext(_ context.Context, i *eksv1.TagResourceInput, _ ...request.Option) (*eksv1.TagResourceOutput, error) {
+	return c.MockTagResource(i)
+}
+
+// UntagResourceWithContext calls the underlying MockUntagResource method.
+func (c *MockAddonClient) UntagResourceWithContext(_ context.Context, i *eksv1.UntagResourceInput, _ ...request.Option) (*eksv1.UntagResourceOutput, error) {
+	return c.MockUntagResource(i)
+}
+
+var _ clientset.IdentityProviderConfigClient = &MockIdentityProviderConfigClient{}
+
+// MockIdentityProviderConfigClient is a fake implementation of
+// eks.IdentityProviderConfigClient.
+type MockIdentityProviderConfigClient struct {
+	MockAssociateIdentityProviderConfig    func(*eksv1.AssociateIdentityProviderConfigInput) (*eksv1.AssociateIdentityProviderConfigOutput, error)
+	MockDescribeIdentityProviderConfig     func(*eksv1.DescribeIdentityProviderConfigInput) (*eksv1.DescribeIdentityProviderConfigOutput, error)
+	MockDisassociateIdentityProviderConfig func(*eksv1.DisassociateIdentityProviderConfigInput) (*eksv1.DisassociateIdentityProviderConfigOutput, error)
+	MockTagResource                        func(*eksv1.TagResourceInput) (*eksv1.TagResourceOutput, error)
+	MockUntagResource                      func(*eksv1.UntagResourceInput) (*eksv1.UntagResourceOutput, error)
+}
+
+// AssociateIdentityProviderConfigWithContext calls the underlying
+// MockAssociateIdentityProviderConfig method.
+func (c *MockIdentityProviderConfigClient) AssociateIdentityProviderConfigWithContext(_ context.Context, i *eksv1.AssociateIdentityProviderConfigInput, _ ...request.Option) (*eksv1.AssociateIdentityProviderConfigOutput, error) {
+	return c.MockAssociateIdentityProviderConfig(i)
+}
+
+// DescribeIdentityProviderConfigWithContext calls the underlying
+// MockDescribeIdentityProviderConfig method.
+func (c *MockIdentityProviderConfigClient) DescribeIdentityProviderConfigWithContext(_ context.Context, i *eksv1.DescribeIdentityProviderConfigInput, _ ...request.Option) (*eksv1.DescribeIdentityProviderConfigOutput, error) {
+	return c.MockDescribeIdentityProviderConfig(i)
+}
+
+// DisassociateIdentityProviderConfigWithContext calls the underlying
+// MockDisassociateIdentityProviderConfig method.
+func (c *MockIdentityProviderConfigClient) DisassociateIdentityProviderConfigWithContext(_ context.Context, i *eksv1.DisassociateIdentityProviderConfigInput, _ ...request.Option) (*eksv1.DisassociateIdentityProviderConfigOutput, error) {
+	return c.MockDisassociateIdentityProviderConfig(i)
+}
+
+// TagResourceWithContext calls the underlying MockTagResource method.
+func (c *MockIdentityProviderConfigClient) TagResourceWithContext(_ context.Context, i *eksv1.TagResourceInput, _ ...request.Option) (*eksv1.TagResourceOutput, error) {
+	return c.MockTagResource(i)
+}
+
+// UntagResourceWithContext calls the underlying MockUntagResource method.
+func (c *MockIdentityProviderConfigClient) UntagResourceWithContext(_ context.Context, i *eksv1.UntagResourceInput, _ ...request.Option) (*eksv1.UntagResourceOutput, error) {
+	return c.MockUntagResource(i)
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	eksv1 "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// IdentityProviderConfigTypeOIDC is the type of OIDC identity provider
+// configurations.
+const IdentityProviderConfigTypeOIDC = "oidc"
+
+// IdentityProviderConfigClient is the external client used for
+// IdentityProviderConfig Custom Resource. The V1 SDK is used since identity
+// provider configurations are not supported by the V2 SDK.
+type IdentityProviderConfigClient interface {
+	AssociateIdentityProviderConfigWithContext(ctx context.Context, input *eksv1.AssociateIdentityProviderConfigInput, opts ...request.Option) (*eksv1.AssociateIdentityProviderConfigOutput, error)
+	DescribeIdentityProviderConfigWithContext(ctx context.Context, input *eksv1.DescribeIdentityProviderConfigInput, opts ...request.Option) (*eksv1.DescribeIdentityProviderConfigOutput, error)
+	DisassociateIdentityProviderConfigWithContext(ctx context.Context, input *eksv1.DisassociateIdentityProviderConfigInput, opts ...request.Option) (*eksv1.DisassociateIdentityProviderConfigOutput, error)
+	TagResourceWithContext(ctx context.Context, input *eksv1.TagResourceInput, opts ...request.Option) (*eksv1.TagResourceOutput, error)
+	UntagResourceWithContext(ctx context.Context, input *eksv1.UntagResourceInput, opts ...request.Option) (*eksv1.UntagResourceOutput, error)
+}
+
+// NewIdentityProviderConfigClient returns a new V1 client using the supplied
+// session.
+func NewIdentityProviderConfigClient(sess *session.Session) IdentityProviderConfigClient {
+	return eksv1.New(sess)
+}
+
+// GenerateAssociateIdentityProviderConfigInput from
+// IdentityProviderConfigParameters.
+func GenerateAssociateIdentityProviderConfigInput(name string, p *v1alpha1.IdentityProviderConfigParameters) *eksv1.AssociateIdentityProviderConfigInput {
+	return &eksv1.AssociateIdentityProviderConfigInput{
+		ClusterName: awsv1.String(p.ClusterName),
+		Oidc: &eksv1.OidcIdentityProviderConfigRequest{
+			ClientId:                   awsv1.String(p.OIDC.ClientID),
+			GroupsClaim:                p.OIDC.GroupsClaim,
+			GroupsPrefix:               p.OIDC.GroupsPrefix,
+			IdentityProviderConfigName: awsv1.String(name),
+			IssuerUrl:                  awsv1.String(p.OIDC.IssuerURL),
+			RequiredClaims:             stringMap(p.OIDC.RequiredClaims),
+			UsernameClaim:              p.OIDC.UsernameClaim,
+			UsernamePrefix:             p.OIDC.UsernamePrefix,
+		},
+		Tags: stringMap(p.Tags),
+	}
+}
+
+// GenerateIdentityProviderConfigObservation is used to produce
+// v1alpha1.IdentityProviderConfigObservation from
+// eks.OidcIdentityProviderConfig.
+func GenerateIdentityProviderConfigObservation(c *eksv1.OidcIdentityProviderConfig) v1alpha1.IdentityProviderConfigObservation {
+	if c == nil {
+		return v1alpha1.IdentityProviderConfigObservation{}
+	}
+	return v1alpha1.IdentityProviderConfigObservation{
+		IdentityProviderConfigArn: awsclients.StringValue(c.IdentityProviderConfigArn),
+		Status:                    v1alpha1.IdentityProviderConfigStatusType(awsclients.StringValue(c.Status)),
+	}
+}
+
+// LateInitializeIdentityProviderConfig fills the empty fields in
+// *v1alpha1.IdentityProviderConfigParameters with the values seen in
+// eks.OidcIdentityProviderConfig.
+func LateInitializeIdentityProviderConfig(in *v1alpha1.IdentityProviderConfigParameters, c *eksv1.OidcIdentityProviderConfig) {
+	if c == nil {
+		return
+	}
+	in.OIDC.GroupsClaim = awsclients.LateInitializeStringPtr(in.OIDC.GroupsClaim, c.GroupsClaim)
+	in.OIDC.GroupsPrefix = awsclients.LateInitializeStringPtr(in.OIDC.GroupsPrefix, c.GroupsPrefix)
+	in.OIDC.UsernameClaim = awsclients.LateInitializeStringPtr(in.OIDC.UsernameClaim, c.UsernameClaim)
+	in.OIDC.UsernamePrefix = awsclients.LateInitializeStringPtr(in.OIDC.UsernamePrefix, c.UsernamePrefix)
+	if len(in.Tags) == 0 && len(c.Tags) > 0 {
+		in.Tags = awsv1.StringValueMap(c.Tags)
+	}
+}
+
+// IsIdentityProviderConfigUpToDate checks whether there is a change in the
+// tags. Any other field is immutable and can't be updated.
+func IsIdentityProviderConfigUpToDate(p *v1alpha1.IdentityProviderConfigParameters, c *eksv1.OidcIdentityProviderConfig) bool {
+	return cmp.Equal(p.Tags, awsv1.StringValueMap(c.Tags), cmpopts.EquateEmpty())
+}
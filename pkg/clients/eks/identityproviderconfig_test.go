@@ -0,0 +1,211 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+)
+
+var (
+	idpName   = "cool-idp"
+	clientID  = "cool-client"
+	issuerURL = "https://issuer.example.com"
+)
+
+func TestGenerateAssociateIdentityProviderConfigInput(t *testing.T) {
+	type args struct {
+		name string
+		p    *v1alpha1.IdentityProviderConfigParameters
+	}
+
+	cases := map[string]struct {
+		args args
+		want *eks.AssociateIdentityProviderConfigInput
+	}{
+		"AllFields": {
+			args: args{
+				name: idpName,
+				p: &v1alpha1.IdentityProviderConfigParameters{
+					ClusterName: clusterName,
+					OIDC: v1alpha1.OIDCIdentityProviderConfig{
+						ClientID:       clientID,
+						GroupsClaim:    awsv1.String("groups"),
+						GroupsPrefix:   awsv1.String("oidc:"),
+						IssuerURL:      issuerURL,
+						RequiredClaims: map[string]string{"cool": "claim"},
+						UsernameClaim:  awsv1.String("email"),
+						UsernamePrefix: awsv1.String("oidc:"),
+					},
+					Tags: map[string]string{"cool": "tag"},
+				},
+			},
+			want: &eks.AssociateIdentityProviderConfigInput{
+				ClusterName: &clusterName,
+				Oidc: &eks.OidcIdentityProviderConfigRequest{
+					ClientId:                   &clientID,
+					GroupsClaim:                awsv1.String("groups"),
+					GroupsPrefix:               awsv1.String("oidc:"),
+					IdentityProviderConfigName: &idpName,
+					IssuerUrl:                  &issuerURL,
+					RequiredClaims:             awsv1.StringMap(map[string]string{"cool": "claim"}),
+					UsernameClaim:              awsv1.String("email"),
+					UsernamePrefix:             awsv1.String("oidc:"),
+				},
+				Tags: awsv1.StringMap(map[string]string{"cool": "tag"}),
+			},
+		},
+		"RequiredFields": {
+			args: args{
+				name: idpName,
+				p: &v1alpha1.IdentityProviderConfigParameters{
+					ClusterName: clusterName,
+					OIDC: v1alpha1.OIDCIdentityProviderConfig{
+						ClientID:  clientID,
+						IssuerURL: issuerURL,
+					},
+				},
+			},
+			want: &eks.AssociateIdentityProviderConfigInput{
+				ClusterName: &clusterName,
+				Oidc: &eks.OidcIdentityProviderConfigRequest{
+					ClientId:                   &clientID,
+					IdentityProviderConfigName: &idpName,
+					IssuerUrl:                  &issuerURL,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateAssociateIdentityProviderConfigInput(tc.args.name, tc.args.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeIdentityProviderConfig(t *testing.T) {
+	type args struct {
+		p *v1alpha1.IdentityProviderConfigParameters
+		c *eks.OidcIdentityProviderConfig
+	}
+
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.IdentityProviderConfigParameters
+	}{
+		"AllFieldsEmpty": {
+			args: args{
+				p: &v1alpha1.IdentityProviderConfigParameters{},
+				c: &eks.OidcIdentityProviderConfig{
+					GroupsClaim:    awsv1.String("groups"),
+					GroupsPrefix:   awsv1.String("oidc:"),
+					UsernameClaim:  awsv1.String("sub"),
+					UsernamePrefix: awsv1.String("-"),
+					Tags:           awsv1.StringMap(map[string]string{"cool": "tag"}),
+				},
+			},
+			want: &v1alpha1.IdentityProviderConfigParameters{
+				OIDC: v1alpha1.OIDCIdentityProviderConfig{
+					GroupsClaim:    awsv1.String("groups"),
+					GroupsPrefix:   awsv1.String("oidc:"),
+					UsernameClaim:  awsv1.String("sub"),
+					UsernamePrefix: awsv1.String("-"),
+				},
+				Tags: map[string]string{"cool": "tag"},
+			},
+		},
+		"KeepSpecValues": {
+			args: args{
+				p: &v1alpha1.IdentityProviderConfigParameters{
+					OIDC: v1alpha1.OIDCIdentityProviderConfig{
+						UsernameClaim: awsv1.String("email"),
+					},
+				},
+				c: &eks.OidcIdentityProviderConfig{
+					UsernameClaim: awsv1.String("sub"),
+				},
+			},
+			want: &v1alpha1.IdentityProviderConfigParameters{
+				OIDC: v1alpha1.OIDCIdentityProviderConfig{
+					UsernameClaim: awsv1.String("email"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeIdentityProviderConfig(tc.args.p, tc.args.c)
+			if diff := cmp.Diff(tc.want, tc.args.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsIdentityProviderConfigUpToDate(t *testing.T) {
+	type args struct {
+		p *v1alpha1.IdentityProviderConfigParameters
+		c *eks.OidcIdentityProviderConfig
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p: &v1alpha1.IdentityProviderConfigParameters{
+					Tags: map[string]string{"cool": "tag"},
+				},
+				c: &eks.OidcIdentityProviderConfig{
+					Tags: awsv1.StringMap(map[string]string{"cool": "tag"}),
+				},
+			},
+			want: true,
+		},
+		"UpdateTags": {
+			args: args{
+				p: &v1alpha1.IdentityProviderConfigParameters{
+					Tags: map[string]string{"cool": "tag", "another": "tag"},
+				},
+				c: &eks.OidcIdentityProviderConfig{
+					Tags: awsv1.StringMap(map[string]string{"cool": "tag"}),
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsIdentityProviderConfigUpToDate(tc.args.p, tc.args.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,39 +17,73 @@ limitations under the License.
 package eks
 
 import (
-	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"context"
+	"strings"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	eksv1 "github.com/aws/aws-sdk-go/service/eks"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
-	aws "github.com/crossplane/provider-aws/pkg/clients"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+// NodeGroupClient is the external client used for NodeGroup Custom Resource.
+// The V1 SDK is used since the launch template, capacity type, taints and
+// update configuration of node groups are not supported by the V2 SDK.
+type NodeGroupClient interface {
+	CreateNodegroupWithContext(ctx context.Context, input *eksv1.CreateNodegroupInput, opts ...request.Option) (*eksv1.CreateNodegroupOutput, error)
+	DescribeNodegroupWithContext(ctx context.Context, input *eksv1.DescribeNodegroupInput, opts ...request.Option) (*eksv1.DescribeNodegroupOutput, error)
+	UpdateNodegroupConfigWithContext(ctx context.Context, input *eksv1.UpdateNodegroupConfigInput, opts ...request.Option) (*eksv1.UpdateNodegroupConfigOutput, error)
+	UpdateNodegroupVersionWithContext(ctx context.Context, input *eksv1.UpdateNodegroupVersionInput, opts ...request.Option) (*eksv1.UpdateNodegroupVersionOutput, error)
+	DeleteNodegroupWithContext(ctx context.Context, input *eksv1.DeleteNodegroupInput, opts ...request.Option) (*eksv1.DeleteNodegroupOutput, error)
+	TagResourceWithContext(ctx context.Context, input *eksv1.TagResourceInput, opts ...request.Option) (*eksv1.TagResourceOutput, error)
+	UntagResourceWithContext(ctx context.Context, input *eksv1.UntagResourceInput, opts ...request.Option) (*eksv1.UntagResourceOutput, error)
+}
+
+// NewNodeGroupClient returns a new V1 client using the supplied session.
+func NewNodeGroupClient(sess *session.Session) NodeGroupClient {
+	return eksv1.New(sess)
+}
+
 // GenerateCreateNodeGroupInput from NodeGroupParameters.
-func GenerateCreateNodeGroupInput(name string, p *v1alpha1.NodeGroupParameters) *eks.CreateNodegroupInput {
-	c := &eks.CreateNodegroupInput{
+func GenerateCreateNodeGroupInput(name string, p *v1alpha1.NodeGroupParameters) *eksv1.CreateNodegroupInput {
+	c := &eksv1.CreateNodegroupInput{
 		NodegroupName:  &name,
-		AmiType:        eks.AMITypes(aws.StringValue(p.AMIType)),
+		AmiType:        p.AMIType,
+		CapacityType:   p.CapacityType,
 		ClusterName:    &p.ClusterName,
 		DiskSize:       p.DiskSize,
-		InstanceTypes:  p.InstanceTypes,
-		Labels:         p.Labels,
+		InstanceTypes:  stringSlice(p.InstanceTypes),
+		Labels:         stringMap(p.Labels),
 		NodeRole:       &p.NodeRole,
 		ReleaseVersion: p.ReleaseVersion,
-		Subnets:        p.Subnets,
-		Tags:           p.Tags,
+		Subnets:        stringSlice(p.Subnets),
+		Tags:           stringMap(p.Tags),
 		Version:        p.Version,
 	}
+	for _, t := range p.Taints {
+		c.Taints = append(c.Taints, generateTaint(t))
+	}
+	if p.LaunchTemplate != nil {
+		c.LaunchTemplate = &eksv1.LaunchTemplateSpecification{
+			Id:      p.LaunchTemplate.ID,
+			Name:    p.LaunchTemplate.Name,
+			Version: p.LaunchTemplate.Version,
+		}
+	}
 	if p.RemoteAccess != nil {
-		c.RemoteAccess = &eks.RemoteAccessConfig{
+		c.RemoteAccess = &eksv1.RemoteAccessConfig{
 			Ec2SshKey:            p.RemoteAccess.EC2SSHKey,
-			SourceSecurityGroups: p.RemoteAccess.SourceSecurityGroups,
+			SourceSecurityGroups: stringSlice(p.RemoteAccess.SourceSecurityGroups),
 		}
 	}
 	if p.ScalingConfig != nil {
-		c.ScalingConfig = &eks.NodegroupScalingConfig{
+		c.ScalingConfig = &eksv1.NodegroupScalingConfig{
 			DesiredSize: p.ScalingConfig.DesiredSize,
 			MinSize:     p.ScalingConfig.MinSize,
 			MaxSize:     p.ScalingConfig.MaxSize,
@@ -63,25 +97,43 @@ func GenerateCreateNodeGroupInput(name string, p *v1alpha1.NodeGroupParameters)
 			c.ScalingConfig.DesiredSize = p.ScalingConfig.MinSize
 		}
 	}
+	if p.UpdateConfig != nil {
+		c.UpdateConfig = &eksv1.NodegroupUpdateConfig{
+			MaxUnavailable:           p.UpdateConfig.MaxUnavailable,
+			MaxUnavailablePercentage: p.UpdateConfig.MaxUnavailablePercentage,
+		}
+	}
 	return c
 }
 
 // GenerateUpdateNodeGroupConfigInput from NodeGroupParameters.
-func GenerateUpdateNodeGroupConfigInput(name string, p *v1alpha1.NodeGroupParameters, ng *eks.Nodegroup) *eks.UpdateNodegroupConfigInput {
-	u := &eks.UpdateNodegroupConfigInput{
+func GenerateUpdateNodeGroupConfigInput(name string, p *v1alpha1.NodeGroupParameters, ng *eksv1.Nodegroup) *eksv1.UpdateNodegroupConfigInput {
+	u := &eksv1.UpdateNodegroupConfigInput{
 		NodegroupName: &name,
 		ClusterName:   &p.ClusterName,
 	}
 
 	if len(p.Labels) > 0 {
-		addOrModify, remove := aws.DiffLabels(p.Labels, ng.Labels)
-		u.Labels = &eks.UpdateLabelsPayload{
-			AddOrUpdateLabels: addOrModify,
-			RemoveLabels:      remove,
+		addOrModify, remove := awsclients.DiffLabels(p.Labels, awsv1.StringValueMap(ng.Labels))
+		u.Labels = &eksv1.UpdateLabelsPayload{
+			AddOrUpdateLabels: awsv1.StringMap(addOrModify),
+			RemoveLabels:      awsv1.StringSlice(remove),
+		}
+	}
+	if addOrUpdate, remove := DiffTaints(p.Taints, ng.Taints); len(addOrUpdate) > 0 || len(remove) > 0 {
+		u.Taints = &eksv1.UpdateTaintsPayload{
+			AddOrUpdateTaints: addOrUpdate,
+			RemoveTaints:      remove,
+		}
+	}
+	if p.UpdateConfig != nil && !isUpdateConfigUpToDate(p.UpdateConfig, ng.UpdateConfig) {
+		u.UpdateConfig = &eksv1.NodegroupUpdateConfig{
+			MaxUnavailable:           p.UpdateConfig.MaxUnavailable,
+			MaxUnavailablePercentage: p.UpdateConfig.MaxUnavailablePercentage,
 		}
 	}
 	if p.ScalingConfig != nil {
-		u.ScalingConfig = &eks.NodegroupScalingConfig{
+		u.ScalingConfig = &eksv1.NodegroupScalingConfig{
 			DesiredSize: p.ScalingConfig.DesiredSize,
 			MinSize:     p.ScalingConfig.MinSize,
 			MaxSize:     p.ScalingConfig.MaxSize,
@@ -104,15 +156,87 @@ func GenerateUpdateNodeGroupConfigInput(name string, p *v1alpha1.NodeGroupParame
 	return u
 }
 
+// GenerateUpdateNodeGroupVersionInput returns the input to update the
+// Kubernetes version, the AMI release version or the launch template version
+// of the node group. It returns nil if none of them needs to be updated. A
+// release version of a Kubernetes version other than the desired one is
+// ignored, so that one set by earlier versions of this provider does not block
+// Kubernetes version upgrades.
+func GenerateUpdateNodeGroupVersionInput(name string, p *v1alpha1.NodeGroupParameters, ng *eksv1.Nodegroup) *eksv1.UpdateNodegroupVersionInput {
+	u := &eksv1.UpdateNodegroupVersionInput{
+		NodegroupName: &name,
+		ClusterName:   &p.ClusterName,
+		Force:         p.ForceUpdateVersion,
+	}
+	update := false
+	if p.Version != nil && awsclients.StringValue(p.Version) != awsclients.StringValue(ng.Version) {
+		u.Version = p.Version
+		update = true
+	}
+	version := ng.Version
+	if p.Version != nil {
+		version = p.Version
+	}
+	if p.ReleaseVersion != nil && isReleaseOfVersion(awsclients.StringValue(p.ReleaseVersion), awsclients.StringValue(version)) &&
+		awsclients.StringValue(p.ReleaseVersion) != awsclients.StringValue(ng.ReleaseVersion) {
+		u.ReleaseVersion = p.ReleaseVersion
+		update = true
+	}
+	if lt := p.LaunchTemplate; lt != nil && lt.Version != nil {
+		var current *string
+		if ng.LaunchTemplate != nil {
+			current = ng.LaunchTemplate.Version
+		}
+		if awsclients.StringValue(lt.Version) != awsclients.StringValue(current) {
+			u.LaunchTemplate = &eksv1.LaunchTemplateSpecification{
+				Id:      lt.ID,
+				Name:    lt.Name,
+				Version: lt.Version,
+			}
+			update = true
+		}
+	}
+	if !update {
+		return nil
+	}
+	return u
+}
+
+// DiffTaints returns the taints that need to be added or updated and the ones
+// that need to be removed so that the remote taints match the local ones.
+// Taints are identified by their key and effect.
+func DiffTaints(local []v1alpha1.Taint, remote []*eksv1.Taint) (addOrUpdate, remove []*eksv1.Taint) {
+	type taintID struct{ key, effect string }
+	current := make(map[taintID]*string, len(remote))
+	for _, t := range remote {
+		current[taintID{key: awsclients.StringValue(t.Key), effect: awsclients.StringValue(t.Effect)}] = t.Value
+	}
+	desired := make(map[taintID]bool, len(local))
+	for _, t := range local {
+		id := taintID{key: t.Key, effect: t.Effect}
+		desired[id] = true
+		if v, ok := current[id]; ok && awsclients.StringValue(v) == awsclients.StringValue(t.Value) {
+			continue
+		}
+		addOrUpdate = append(addOrUpdate, generateTaint(t))
+	}
+	for _, t := range remote {
+		if !desired[taintID{key: awsclients.StringValue(t.Key), effect: awsclients.StringValue(t.Effect)}] {
+			remove = append(remove, t)
+		}
+	}
+	return addOrUpdate, remove
+}
+
 // GenerateNodeGroupObservation is used to produce v1alpha1.NodeGroupObservation
 // from eks.Nodegroup.
-func GenerateNodeGroupObservation(ng *eks.Nodegroup) v1alpha1.NodeGroupObservation { // nolint:gocyclo
+func GenerateNodeGroupObservation(ng *eksv1.Nodegroup) v1alpha1.NodeGroupObservation { // nolint:gocyclo
 	if ng == nil {
 		return v1alpha1.NodeGroupObservation{}
 	}
 	o := v1alpha1.NodeGroupObservation{
 		NodeGroupArn: awsclients.StringValue(ng.NodegroupArn),
-		Status:       v1alpha1.NodeGroupStatusType(ng.Status),
+		Status:       v1alpha1.NodeGroupStatusType(awsclients.StringValue(ng.Status)),
 	}
 	if ng.CreatedAt != nil {
 		o.CreatedAt = &metav1.Time{Time: *ng.CreatedAt}
@@ -123,9 +247,9 @@ func GenerateNodeGroupObservation(ng *eks.Nodegroup) v1alpha1.NodeGroupObservati
 		}
 		for c, i := range ng.Health.Issues {
 			o.Health.Issues[c] = v1alpha1.Issue{
-				Code:        string(i.Code),
-				Message:     aws.StringValue(i.Message),
-				ResourceIDs: i.ResourceIds,
+				Code:        awsclients.StringValue(i.Code),
+				Message:     awsclients.StringValue(i.Message),
+				ResourceIDs: stringValueSlice(i.ResourceIds),
 			}
 		}
 	}
@@ -134,12 +258,12 @@ func GenerateNodeGroupObservation(ng *eks.Nodegroup) v1alpha1.NodeGroupObservati
 	}
 	if ng.Resources != nil {
 		o.Resources = v1alpha1.NodeGroupResources{
-			RemoteAccessSecurityGroup: aws.StringValue(ng.Resources.RemoteAccessSecurityGroup),
+			RemoteAccessSecurityGroup: awsclients.StringValue(ng.Resources.RemoteAccessSecurityGroup),
 		}
 		if len(ng.Resources.AutoScalingGroups) > 0 {
 			asg := make([]v1alpha1.AutoScalingGroup, len(ng.Resources.AutoScalingGroups))
 			for c, a := range ng.Resources.AutoScalingGroups {
-				asg[c] = v1alpha1.AutoScalingGroup{Name: aws.StringValue(a.Name)}
+				asg[c] = v1alpha1.AutoScalingGroup{Name: awsclients.StringValue(a.Name)}
 			}
 			o.Resources.AutoScalingGroups = asg
 		}
@@ -155,22 +279,30 @@ func GenerateNodeGroupObservation(ng *eks.Nodegroup) v1alpha1.NodeGroupObservati
 
 // LateInitializeNodeGroup fills the empty fields in *v1alpha1.NodeGroupParameters with the
 // values seen in eks.Nodegroup.
-func LateInitializeNodeGroup(in *v1alpha1.NodeGroupParameters, ng *eks.Nodegroup) { // nolint:gocyclo
+func LateInitializeNodeGroup(in *v1alpha1.NodeGroupParameters, ng *eksv1.Nodegroup) { // nolint:gocyclo
 	if ng == nil {
 		return
 	}
-	in.AMIType = awsclients.LateInitializeStringPtr(in.AMIType, awsclients.String(string(ng.AmiType)))
+	in.AMIType = awsclients.LateInitializeStringPtr(in.AMIType, ng.AmiType)
+	in.CapacityType = awsclients.LateInitializeStringPtr(in.CapacityType, ng.CapacityType)
 	in.DiskSize = awsclients.LateInitializeInt64Ptr(in.DiskSize, ng.DiskSize)
 	if len(in.InstanceTypes) == 0 && len(ng.InstanceTypes) > 0 {
-		in.InstanceTypes = ng.InstanceTypes
+		in.InstanceTypes = awsv1.StringValueSlice(ng.InstanceTypes)
 	}
 	if len(in.Labels) == 0 && len(ng.Labels) > 0 {
-		in.Labels = ng.Labels
+		in.Labels = awsv1.StringValueMap(ng.Labels)
+	}
+	if in.LaunchTemplate == nil && ng.LaunchTemplate != nil {
+		in.LaunchTemplate = &v1alpha1.LaunchTemplateSpecification{
+			ID:      ng.LaunchTemplate.Id,
+			Name:    ng.LaunchTemplate.Name,
+			Version: ng.LaunchTemplate.Version,
+		}
 	}
 	if in.RemoteAccess == nil && ng.RemoteAccess != nil {
 		in.RemoteAccess = &v1alpha1.RemoteAccessConfig{
 			EC2SSHKey:            ng.RemoteAccess.Ec2SshKey,
-			SourceSecurityGroups: ng.RemoteAccess.SourceSecurityGroups,
+			SourceSecurityGroups: stringValueSlice(ng.RemoteAccess.SourceSecurityGroups),
 		}
 	}
 	if in.ScalingConfig == nil && ng.ScalingConfig != nil {
@@ -180,25 +312,64 @@ func LateInitializeNodeGroup(in *v1alpha1.NodeGroupParameters, ng *eks.Nodegroup
 			MaxSize:     ng.ScalingConfig.MaxSize,
 		}
 	}
-	in.ReleaseVersion = awsclients.LateInitializeStringPtr(in.ReleaseVersion, ng.ReleaseVersion)
+	if len(in.Taints) == 0 && len(ng.Taints) > 0 {
+		in.Taints = make([]v1alpha1.Taint, len(ng.Taints))
+		for i, t := range ng.Taints {
+			in.Taints[i] = v1alpha1.Taint{
+				Effect: awsclients.StringValue(t.Effect),
+				Key:    awsclients.StringValue(t.Key),
+				Value:  t.Value,
+			}
+		}
+	}
+	if in.UpdateConfig == nil && ng.UpdateConfig != nil {
+		in.UpdateConfig = &v1alpha1.NodeGroupUpdateConfig{
+			MaxUnavailable:           ng.UpdateConfig.MaxUnavailable,
+			MaxUnavailablePercentage: ng.UpdateConfig.MaxUnavailablePercentage,
+		}
+	}
+	// The release version is not late initialized since it changes whenever
+	// the Kubernetes version of the node group is updated. A stale value would
+	// otherwise cause the node group to be downgraded.
 	in.Version = awsclients.LateInitializeStringPtr(in.Version, ng.Version)
 	// NOTE(hasheddan): we always will set the default Crossplane tags in
 	// practice during initialization in the controller, but we check if no tags
 	// exist for consistency with expected late initialization behavior.
-	if len(in.Tags) == 0 {
-		in.Tags = ng.Tags
+	if len(in.Tags) == 0 && len(ng.Tags) > 0 {
+		in.Tags = awsv1.StringValueMap(ng.Tags)
 	}
 }
 
+// isReleaseOfVersion returns false if the given AMI release version, e.g.
+// 1.18.9-20210208, belongs to a Kubernetes version other than the given one.
+// Release versions that do not start with a Kubernetes version are assumed to
+// belong to it.
+func isReleaseOfVersion(release, version string) bool {
+	parts := strings.SplitN(release, ".", 3)
+	if len(parts) < 3 || version == "" {
+		return true
+	}
+	return parts[0]+"."+parts[1] == version
+}
+
 // IsNodeGroupUpToDate checks whether there is a change in any of the modifiable fields.
-func IsNodeGroupUpToDate(p *v1alpha1.NodeGroupParameters, ng *eks.Nodegroup) bool { // nolint:gocyclo
-	if !cmp.Equal(p.Tags, ng.Tags, cmpopts.EquateEmpty()) {
+func IsNodeGroupUpToDate(p *v1alpha1.NodeGroupParameters, ng *eksv1.Nodegroup) bool { // nolint:gocyclo
+	if !cmp.Equal(p.Tags, awsv1.StringValueMap(ng.Tags), cmpopts.EquateEmpty()) {
 		return false
 	}
 	if !cmp.Equal(p.Version, ng.Version) {
 		return false
 	}
-	if !cmp.Equal(p.Labels, ng.Labels, cmpopts.EquateEmpty()) {
+	if !cmp.Equal(p.Labels, awsv1.StringValueMap(ng.Labels), cmpopts.EquateEmpty()) {
+		return false
+	}
+	if GenerateUpdateNodeGroupVersionInput("", p, ng) != nil {
+		return false
+	}
+	if addOrUpdate, remove := DiffTaints(p.Taints, ng.Taints); len(addOrUpdate) > 0 || len(remove) > 0 {
+		return false
+	}
+	if p.UpdateConfig != nil && !isUpdateConfigUpToDate(p.UpdateConfig, ng.UpdateConfig) {
 		return false
 	}
 	if p.ScalingConfig == nil && ng.ScalingConfig == nil {
@@ -219,3 +390,44 @@ func IsNodeGroupUpToDate(p *v1alpha1.NodeGroupParameters, ng *eks.Nodegroup) boo
 	}
 	return false
 }
+
+func isUpdateConfigUpToDate(p *v1alpha1.NodeGroupUpdateConfig, c *eksv1.NodegroupUpdateConfig) bool {
+	if c == nil {
+		return false
+	}
+	return cmp.Equal(p.MaxUnavailable, c.MaxUnavailable) &&
+		cmp.Equal(p.MaxUnavailablePercentage, c.MaxUnavailablePercentage)
+}
+
+func generateTaint(t v1alpha1.Taint) *eksv1.Taint {
+	return &eksv1.Taint{
+		Effect: awsv1.String(t.Effect),
+		Key:    awsv1.String(t.Key),
+		Value:  t.Value,
+	}
+}
+
+// stringSlice converts the supplied slice for the V1 SDK, keeping it nil if
+// it is empty so that it is omitted from the request.
+func stringSlice(in []string) []*string {
+	if len(in) == 0 {
+		return nil
+	}
+	return awsv1.StringSlice(in)
+}
+
+func stringValueSlice(in []*string) []string {
+	if len(in) == 0 {
+		return nil
+	}
+	return awsv1.StringValueSlice(in)
+}
+
+// stringMap converts the supplied map for the V1 SDK, keeping it nil if it is
+// empty so that it is omitted from the request.
+func stringMap(in map[string]string) map[string]*string {
+	if len(in) == 0 {
+		return nil
+	}
+	return awsv1.StringMap(in)
+}
@@ -20,7 +20,8 @@ import (
 	"testing"
 	"time"
 
-	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/google/go-cmp/cmp"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -36,6 +37,8 @@ var (
 	currentSize = int64(5)
 	maxSize     = int64(8)
 	nodeRole    = "cool-role"
+	ltID        = "lt-0123"
+	ltVersion   = "2"
 )
 
 func TestGenerateCreateNodeGroupInput(t *testing.T) {
@@ -52,11 +55,16 @@ func TestGenerateCreateNodeGroupInput(t *testing.T) {
 			args: args{
 				name: ngName,
 				p: &v1alpha1.NodeGroupParameters{
-					AMIType:        &amiType,
-					ClusterName:    clusterName,
-					DiskSize:       &diskSize,
-					InstanceTypes:  []string{"cool-type"},
-					Labels:         map[string]string{"cool": "label"},
+					AMIType:       &amiType,
+					CapacityType:  awsv1.String(eks.CapacityTypesSpot),
+					ClusterName:   clusterName,
+					DiskSize:      &diskSize,
+					InstanceTypes: []string{"cool-type"},
+					Labels:        map[string]string{"cool": "label"},
+					LaunchTemplate: &v1alpha1.LaunchTemplateSpecification{
+						ID:      &ltID,
+						Version: &ltVersion,
+					},
 					NodeRole:       nodeRole,
 					ReleaseVersion: &version,
 					RemoteAccess: &v1alpha1.RemoteAccessConfig{
@@ -70,29 +78,50 @@ func TestGenerateCreateNodeGroupInput(t *testing.T) {
 					},
 					Subnets: []string{"cool-subnet"},
 					Tags:    map[string]string{"cool": "tag"},
+					Taints: []v1alpha1.Taint{{
+						Effect: eks.TaintEffectNoSchedule,
+						Key:    "cool",
+						Value:  awsv1.String("taint"),
+					}},
+					UpdateConfig: &v1alpha1.NodeGroupUpdateConfig{
+						MaxUnavailable: &size,
+					},
 					Version: &version,
 				},
 			},
 			want: &eks.CreateNodegroupInput{
-				AmiType:        eks.AMITypes(amiType),
-				ClusterName:    &clusterName,
-				DiskSize:       &diskSize,
-				InstanceTypes:  []string{"cool-type"},
-				Labels:         map[string]string{"cool": "label"},
+				AmiType:       &amiType,
+				CapacityType:  awsv1.String(eks.CapacityTypesSpot),
+				ClusterName:   &clusterName,
+				DiskSize:      &diskSize,
+				InstanceTypes: awsv1.StringSlice([]string{"cool-type"}),
+				Labels:        awsv1.StringMap(map[string]string{"cool": "label"}),
+				LaunchTemplate: &eks.LaunchTemplateSpecification{
+					Id:      &ltID,
+					Version: &ltVersion,
+				},
 				NodeRole:       &nodeRole,
 				NodegroupName:  &ngName,
 				ReleaseVersion: &version,
 				RemoteAccess: &eks.RemoteAccessConfig{
 					Ec2SshKey:            &keyArn,
-					SourceSecurityGroups: []string{"cool-group"},
+					SourceSecurityGroups: awsv1.StringSlice([]string{"cool-group"}),
 				},
 				ScalingConfig: &eks.NodegroupScalingConfig{
 					DesiredSize: &size,
 					MaxSize:     &size,
 					MinSize:     &size,
 				},
-				Subnets: []string{"cool-subnet"},
-				Tags:    map[string]string{"cool": "tag"},
+				Subnets: awsv1.StringSlice([]string{"cool-subnet"}),
+				Tags:    awsv1.StringMap(map[string]string{"cool": "tag"}),
+				Taints: []*eks.Taint{{
+					Effect: awsv1.String(eks.TaintEffectNoSchedule),
+					Key:    awsv1.String("cool"),
+					Value:  awsv1.String("taint"),
+				}},
+				UpdateConfig: &eks.NodegroupUpdateConfig{
+					MaxUnavailable: &size,
+				},
 				Version: &version,
 			},
 		},
@@ -114,10 +143,10 @@ func TestGenerateCreateNodeGroupInput(t *testing.T) {
 				},
 			},
 			want: &eks.CreateNodegroupInput{
-				AmiType:       eks.AMITypes(amiType),
+				AmiType:       &amiType,
 				ClusterName:   &clusterName,
 				DiskSize:      &diskSize,
-				InstanceTypes: []string{"cool-type"},
+				InstanceTypes: awsv1.StringSlice([]string{"cool-type"}),
 				NodeRole:      &nodeRole,
 				NodegroupName: &ngName,
 				ScalingConfig: &eks.NodegroupScalingConfig{
@@ -125,7 +154,7 @@ func TestGenerateCreateNodeGroupInput(t *testing.T) {
 					MaxSize:     &size,
 					MinSize:     &size,
 				},
-				Subnets: []string{"cool-subnet"},
+				Subnets: awsv1.StringSlice([]string{"cool-subnet"}),
 			},
 		},
 		"DefaultDesiredSize": {
@@ -145,10 +174,10 @@ func TestGenerateCreateNodeGroupInput(t *testing.T) {
 				},
 			},
 			want: &eks.CreateNodegroupInput{
-				AmiType:       eks.AMITypes(amiType),
+				AmiType:       &amiType,
 				ClusterName:   &clusterName,
 				DiskSize:      &diskSize,
-				InstanceTypes: []string{"cool-type"},
+				InstanceTypes: awsv1.StringSlice([]string{"cool-type"}),
 				NodeRole:      &nodeRole,
 				NodegroupName: &ngName,
 				ScalingConfig: &eks.NodegroupScalingConfig{
@@ -156,7 +185,7 @@ func TestGenerateCreateNodeGroupInput(t *testing.T) {
 					MaxSize:     &maxSize,
 					MinSize:     &size,
 				},
-				Subnets: []string{"cool-subnet"},
+				Subnets: awsv1.StringSlice([]string{"cool-subnet"}),
 			},
 		},
 	}
@@ -211,8 +240,8 @@ func TestGenerateUpdateNodeGroupInput(t *testing.T) {
 			want: &eks.UpdateNodegroupConfigInput{
 				ClusterName: &clusterName,
 				Labels: &eks.UpdateLabelsPayload{
-					AddOrUpdateLabels: map[string]string{"cool": "label"},
-					RemoveLabels:      []string{},
+					AddOrUpdateLabels: awsv1.StringMap(map[string]string{"cool": "label"}),
+					RemoveLabels:      awsv1.StringSlice([]string{}),
 				},
 				NodegroupName: &ngName,
 				ScalingConfig: &eks.NodegroupScalingConfig{
@@ -247,7 +276,7 @@ func TestGenerateUpdateNodeGroupInput(t *testing.T) {
 					Version: &version,
 				},
 				n: &eks.Nodegroup{
-					Labels: map[string]string{"remove": "label", "key": "badval"},
+					Labels: awsv1.StringMap(map[string]string{"remove": "label", "key": "badval"}),
 					ScalingConfig: &eks.NodegroupScalingConfig{
 						DesiredSize: &size,
 						MaxSize:     &size,
@@ -258,8 +287,8 @@ func TestGenerateUpdateNodeGroupInput(t *testing.T) {
 			want: &eks.UpdateNodegroupConfigInput{
 				ClusterName: &clusterName,
 				Labels: &eks.UpdateLabelsPayload{
-					AddOrUpdateLabels: map[string]string{"cool": "label", "key": "val"},
-					RemoveLabels:      []string{"remove"},
+					AddOrUpdateLabels: awsv1.StringMap(map[string]string{"cool": "label", "key": "val"}),
+					RemoveLabels:      awsv1.StringSlice([]string{"remove"}),
 				},
 				NodegroupName: &ngName,
 				ScalingConfig: &eks.NodegroupScalingConfig{
@@ -269,6 +298,63 @@ func TestGenerateUpdateNodeGroupInput(t *testing.T) {
 				},
 			},
 		},
+		"TaintsAndUpdateConfig": {
+			args: args{
+				name: ngName,
+				p: &v1alpha1.NodeGroupParameters{
+					ClusterName: clusterName,
+					Taints: []v1alpha1.Taint{
+						{
+							Effect: eks.TaintEffectNoSchedule,
+							Key:    "cool",
+							Value:  awsv1.String("taint"),
+						},
+						{
+							Effect: eks.TaintEffectNoExecute,
+							Key:    "same",
+							Value:  awsv1.String("val"),
+						},
+					},
+					UpdateConfig: &v1alpha1.NodeGroupUpdateConfig{
+						MaxUnavailable: &maxSize,
+					},
+				},
+				n: &eks.Nodegroup{
+					Taints: []*eks.Taint{
+						{
+							Effect: awsv1.String(eks.TaintEffectNoExecute),
+							Key:    awsv1.String("same"),
+							Value:  awsv1.String("val"),
+						},
+						{
+							Effect: awsv1.String(eks.TaintEffectPreferNoSchedule),
+							Key:    awsv1.String("remove"),
+						},
+					},
+					UpdateConfig: &eks.NodegroupUpdateConfig{
+						MaxUnavailable: &size,
+					},
+				},
+			},
+			want: &eks.UpdateNodegroupConfigInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+				Taints: &eks.UpdateTaintsPayload{
+					AddOrUpdateTaints: []*eks.Taint{{
+						Effect: awsv1.String(eks.TaintEffectNoSchedule),
+						Key:    awsv1.String("cool"),
+						Value:  awsv1.String("taint"),
+					}},
+					RemoveTaints: []*eks.Taint{{
+						Effect: awsv1.String(eks.TaintEffectPreferNoSchedule),
+						Key:    awsv1.String("remove"),
+					}},
+				},
+				UpdateConfig: &eks.NodegroupUpdateConfig{
+					MaxUnavailable: &maxSize,
+				},
+			},
+		},
 		"IgnoreDesiredSize": {
 			args: args{
 				name: ngName,
@@ -295,7 +381,7 @@ func TestGenerateUpdateNodeGroupInput(t *testing.T) {
 				n: &eks.Nodegroup{
 					ClusterName:   &clusterName,
 					NodegroupName: &ngName,
-					Labels:        map[string]string{"cool": "label"},
+					Labels:        awsv1.StringMap(map[string]string{"cool": "label"}),
 					ScalingConfig: &eks.NodegroupScalingConfig{
 						DesiredSize: &currentSize,
 						MaxSize:     &maxSize,
@@ -306,8 +392,8 @@ func TestGenerateUpdateNodeGroupInput(t *testing.T) {
 			want: &eks.UpdateNodegroupConfigInput{
 				ClusterName: &clusterName,
 				Labels: &eks.UpdateLabelsPayload{
-					AddOrUpdateLabels: map[string]string{},
-					RemoveLabels:      []string{},
+					AddOrUpdateLabels: awsv1.StringMap(map[string]string{}),
+					RemoveLabels:      awsv1.StringSlice([]string{}),
 				},
 				NodegroupName: &ngName,
 				ScalingConfig: &eks.NodegroupScalingConfig{
@@ -343,7 +429,7 @@ func TestGenerateUpdateNodeGroupInput(t *testing.T) {
 				n: &eks.Nodegroup{
 					ClusterName:   &clusterName,
 					NodegroupName: &ngName,
-					Labels:        map[string]string{"cool": "label"},
+					Labels:        awsv1.StringMap(map[string]string{"cool": "label"}),
 					ScalingConfig: &eks.NodegroupScalingConfig{
 						DesiredSize: awsclients.Int64(5),
 						MaxSize:     awsclients.Int64(10),
@@ -354,8 +440,8 @@ func TestGenerateUpdateNodeGroupInput(t *testing.T) {
 			want: &eks.UpdateNodegroupConfigInput{
 				ClusterName: &clusterName,
 				Labels: &eks.UpdateLabelsPayload{
-					AddOrUpdateLabels: map[string]string{},
-					RemoveLabels:      []string{},
+					AddOrUpdateLabels: awsv1.StringMap(map[string]string{}),
+					RemoveLabels:      awsv1.StringSlice([]string{}),
 				},
 				NodegroupName: &ngName,
 				ScalingConfig: &eks.NodegroupScalingConfig{
@@ -377,6 +463,165 @@ func TestGenerateUpdateNodeGroupInput(t *testing.T) {
 	}
 }
 
+func TestGenerateUpdateNodeGroupVersionInput(t *testing.T) {
+	newVersion := "1.17"
+	release := "1.16.15-20210208"
+	newRelease := "1.17.12-20210208"
+	newLTVersion := "3"
+	type args struct {
+		name string
+		p    *v1alpha1.NodeGroupParameters
+		n    *eks.Nodegroup
+	}
+
+	cases := map[string]struct {
+		args args
+		want *eks.UpdateNodegroupVersionInput
+	}{
+		"UpToDate": {
+			args: args{
+				name: ngName,
+				p: &v1alpha1.NodeGroupParameters{
+					ClusterName:    clusterName,
+					ReleaseVersion: &version,
+					LaunchTemplate: &v1alpha1.LaunchTemplateSpecification{
+						ID:      &ltID,
+						Version: &ltVersion,
+					},
+					Version: &version,
+				},
+				n: &eks.Nodegroup{
+					LaunchTemplate: &eks.LaunchTemplateSpecification{
+						Id:      &ltID,
+						Version: &ltVersion,
+					},
+					ReleaseVersion: &version,
+					Version:        &version,
+				},
+			},
+		},
+		"NotSet": {
+			args: args{
+				name: ngName,
+				p: &v1alpha1.NodeGroupParameters{
+					ClusterName: clusterName,
+				},
+				n: &eks.Nodegroup{
+					ReleaseVersion: &version,
+					Version:        &version,
+				},
+			},
+		},
+		"Version": {
+			args: args{
+				name: ngName,
+				p: &v1alpha1.NodeGroupParameters{
+					ClusterName:        clusterName,
+					ForceUpdateVersion: awsv1.Bool(true),
+					Version:            &newVersion,
+				},
+				n: &eks.Nodegroup{
+					ReleaseVersion: &version,
+					Version:        &version,
+				},
+			},
+			want: &eks.UpdateNodegroupVersionInput{
+				ClusterName:   &clusterName,
+				Force:         awsv1.Bool(true),
+				NodegroupName: &ngName,
+				Version:       &newVersion,
+			},
+		},
+		"ReleaseVersion": {
+			args: args{
+				name: ngName,
+				p: &v1alpha1.NodeGroupParameters{
+					ClusterName:    clusterName,
+					ReleaseVersion: &newVersion,
+					Version:        &version,
+				},
+				n: &eks.Nodegroup{
+					ReleaseVersion: &version,
+					Version:        &version,
+				},
+			},
+			want: &eks.UpdateNodegroupVersionInput{
+				ClusterName:    &clusterName,
+				NodegroupName:  &ngName,
+				ReleaseVersion: &newVersion,
+			},
+		},
+		"StaleReleaseVersion": {
+			args: args{
+				name: ngName,
+				p: &v1alpha1.NodeGroupParameters{
+					ClusterName:    clusterName,
+					ReleaseVersion: &release,
+					Version:        &newVersion,
+				},
+				n: &eks.Nodegroup{
+					ReleaseVersion: &release,
+					Version:        &version,
+				},
+			},
+			want: &eks.UpdateNodegroupVersionInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+				Version:       &newVersion,
+			},
+		},
+		"StaleReleaseVersionUpgraded": {
+			args: args{
+				name: ngName,
+				p: &v1alpha1.NodeGroupParameters{
+					ClusterName:    clusterName,
+					ReleaseVersion: &release,
+					Version:        &newVersion,
+				},
+				n: &eks.Nodegroup{
+					ReleaseVersion: &newRelease,
+					Version:        &newVersion,
+				},
+			},
+		},
+		"LaunchTemplateVersion": {
+			args: args{
+				name: ngName,
+				p: &v1alpha1.NodeGroupParameters{
+					ClusterName: clusterName,
+					LaunchTemplate: &v1alpha1.LaunchTemplateSpecification{
+						ID:      &ltID,
+						Version: &newLTVersion,
+					},
+				},
+				n: &eks.Nodegroup{
+					LaunchTemplate: &eks.LaunchTemplateSpecification{
+						Id:      &ltID,
+						Version: &ltVersion,
+					},
+				},
+			},
+			want: &eks.UpdateNodegroupVersionInput{
+				ClusterName: &clusterName,
+				LaunchTemplate: &eks.LaunchTemplateSpecification{
+					Id:      &ltID,
+					Version: &newLTVersion,
+				},
+				NodegroupName: &ngName,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateNodeGroupVersionInput(tc.args.name, tc.args.p, tc.args.n)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateUpdateNodeObservation(t *testing.T) {
 	ngArn := "cool:arn"
 	now := time.Now()
@@ -396,21 +641,21 @@ func TestGenerateUpdateNodeObservation(t *testing.T) {
 			args: args{
 				n: &eks.Nodegroup{
 					NodegroupArn: &ngArn,
-					Status:       eks.NodegroupStatusActive,
+					Status:       awsv1.String(eks.NodegroupStatusActive),
 					CreatedAt:    &now,
 					Health: &eks.NodegroupHealth{
-						Issues: []eks.Issue{
+						Issues: []*eks.Issue{
 							{
-								Code:        eks.NodegroupIssueCodeAccessDenied,
+								Code:        awsv1.String(eks.NodegroupIssueCodeAccessDenied),
 								Message:     &message,
-								ResourceIds: []string{"my-resource"},
+								ResourceIds: awsv1.StringSlice([]string{"my-resource"}),
 							},
 						},
 					},
 					ModifiedAt: &now,
 					Resources: &eks.NodegroupResources{
 						RemoteAccessSecurityGroup: &rasg,
-						AutoScalingGroups: []eks.AutoScalingGroup{
+						AutoScalingGroups: []*eks.AutoScalingGroup{
 							{
 								Name: &asg,
 							},
@@ -477,30 +722,47 @@ func TestLateInitializeNodeGroup(t *testing.T) {
 			args: args{
 				p: &v1alpha1.NodeGroupParameters{},
 				n: &eks.Nodegroup{
-					AmiType:       eks.AMITypesAl2X8664,
+					AmiType:       awsv1.String(eks.AMITypesAl2X8664),
+					CapacityType:  awsv1.String(eks.CapacityTypesSpot),
 					DiskSize:      &diskSize,
-					InstanceTypes: []string{"cool-type"},
-					Labels:        map[string]string{"cool": "label"},
+					InstanceTypes: awsv1.StringSlice([]string{"cool-type"}),
+					Labels:        awsv1.StringMap(map[string]string{"cool": "label"}),
+					LaunchTemplate: &eks.LaunchTemplateSpecification{
+						Id:      &ltID,
+						Version: &ltVersion,
+					},
 					RemoteAccess: &eks.RemoteAccessConfig{
 						Ec2SshKey:            &keyArn,
-						SourceSecurityGroups: []string{"cool-group"},
+						SourceSecurityGroups: awsv1.StringSlice([]string{"cool-group"}),
 					},
 					ScalingConfig: &eks.NodegroupScalingConfig{
 						DesiredSize: &size,
 						MaxSize:     &size,
 						MinSize:     &size,
 					},
+					Taints: []*eks.Taint{{
+						Effect: awsv1.String(eks.TaintEffectNoSchedule),
+						Key:    awsv1.String("cool"),
+						Value:  awsv1.String("taint"),
+					}},
+					UpdateConfig: &eks.NodegroupUpdateConfig{
+						MaxUnavailable: &size,
+					},
 					ReleaseVersion: &version,
 					Version:        &version,
-					Tags:           map[string]string{"cool": "tag"},
+					Tags:           awsv1.StringMap(map[string]string{"cool": "tag"}),
 				},
 			},
 			want: &v1alpha1.NodeGroupParameters{
-				AMIType:        &ami,
-				DiskSize:       &diskSize,
-				InstanceTypes:  []string{"cool-type"},
-				Labels:         map[string]string{"cool": "label"},
-				ReleaseVersion: &version,
+				AMIType:       &ami,
+				CapacityType:  awsv1.String(eks.CapacityTypesSpot),
+				DiskSize:      &diskSize,
+				InstanceTypes: []string{"cool-type"},
+				Labels:        map[string]string{"cool": "label"},
+				LaunchTemplate: &v1alpha1.LaunchTemplateSpecification{
+					ID:      &ltID,
+					Version: &ltVersion,
+				},
 				RemoteAccess: &v1alpha1.RemoteAccessConfig{
 					EC2SSHKey:            &keyArn,
 					SourceSecurityGroups: []string{"cool-group"},
@@ -510,6 +772,14 @@ func TestLateInitializeNodeGroup(t *testing.T) {
 					MaxSize:     &size,
 					MinSize:     &size,
 				},
+				Taints: []v1alpha1.Taint{{
+					Effect: eks.TaintEffectNoSchedule,
+					Key:    "cool",
+					Value:  awsv1.String("taint"),
+				}},
+				UpdateConfig: &v1alpha1.NodeGroupUpdateConfig{
+					MaxUnavailable: &size,
+				},
 				Tags:    map[string]string{"cool": "tag"},
 				Version: &version,
 			},
@@ -524,13 +794,13 @@ func TestLateInitializeNodeGroup(t *testing.T) {
 					},
 				},
 				n: &eks.Nodegroup{
-					AmiType:       eks.AMITypesAl2X8664,
+					AmiType:       awsv1.String(eks.AMITypesAl2X8664),
 					DiskSize:      &diskSize,
-					InstanceTypes: []string{"cool-type"},
-					Labels:        map[string]string{"cool": "label"},
+					InstanceTypes: awsv1.StringSlice([]string{"cool-type"}),
+					Labels:        awsv1.StringMap(map[string]string{"cool": "label"}),
 					RemoteAccess: &eks.RemoteAccessConfig{
 						Ec2SshKey:            &keyArn,
-						SourceSecurityGroups: []string{"cool-group"},
+						SourceSecurityGroups: awsv1.StringSlice([]string{"cool-group"}),
 					},
 					ScalingConfig: &eks.NodegroupScalingConfig{
 						DesiredSize: &size,
@@ -539,15 +809,14 @@ func TestLateInitializeNodeGroup(t *testing.T) {
 					},
 					ReleaseVersion: &version,
 					Version:        &version,
-					Tags:           map[string]string{"cool": "tag"},
+					Tags:           awsv1.StringMap(map[string]string{"cool": "tag"}),
 				},
 			},
 			want: &v1alpha1.NodeGroupParameters{
-				AMIType:        &ami,
-				DiskSize:       &diskSize,
-				InstanceTypes:  []string{"cool-type"},
-				Labels:         map[string]string{"cool": "label"},
-				ReleaseVersion: &version,
+				AMIType:       &ami,
+				DiskSize:      &diskSize,
+				InstanceTypes: []string{"cool-type"},
+				Labels:        map[string]string{"cool": "label"},
 				RemoteAccess: &v1alpha1.RemoteAccessConfig{
 					EC2SSHKey:            &keyArn,
 					SourceSecurityGroups: []string{"cool-group"},
@@ -598,14 +867,14 @@ func TestIsNodeGroupUpToDate(t *testing.T) {
 					},
 				},
 				n: &eks.Nodegroup{
-					Labels: map[string]string{"cool": "label"},
+					Labels: awsv1.StringMap(map[string]string{"cool": "label"}),
 					ScalingConfig: &eks.NodegroupScalingConfig{
 						DesiredSize: &size,
 						MaxSize:     &size,
 						MinSize:     &size,
 					},
 					Version: &version,
-					Tags:    map[string]string{"cool": "tag"},
+					Tags:    awsv1.StringMap(map[string]string{"cool": "tag"}),
 				},
 			},
 			want: true,
@@ -623,14 +892,14 @@ func TestIsNodeGroupUpToDate(t *testing.T) {
 					},
 				},
 				n: &eks.Nodegroup{
-					Labels: map[string]string{"cool": "label"},
+					Labels: awsv1.StringMap(map[string]string{"cool": "label"}),
 					ScalingConfig: &eks.NodegroupScalingConfig{
 						DesiredSize: &size,
 						MaxSize:     &size,
 						MinSize:     &size,
 					},
 					Version: &version,
-					Tags:    map[string]string{"cool": "tag"},
+					Tags:    awsv1.StringMap(map[string]string{"cool": "tag"}),
 				},
 			},
 			want: false,
@@ -648,7 +917,7 @@ func TestIsNodeGroupUpToDate(t *testing.T) {
 					},
 				},
 				n: &eks.Nodegroup{
-					Labels: map[string]string{"cool": "label"},
+					Labels: awsv1.StringMap(map[string]string{"cool": "label"}),
 					ScalingConfig: &eks.NodegroupScalingConfig{
 						DesiredSize: &size,
 						MaxSize:     &size,
@@ -656,7 +925,47 @@ func TestIsNodeGroupUpToDate(t *testing.T) {
 					},
 					ReleaseVersion: &version,
 					Version:        &version,
-					Tags:           map[string]string{"cool": "tag"},
+					Tags:           awsv1.StringMap(map[string]string{"cool": "tag"}),
+				},
+			},
+			want: false,
+		},
+		"UpdateTaints": {
+			args: args{
+				p: &v1alpha1.NodeGroupParameters{
+					Version: &version,
+					Taints: []v1alpha1.Taint{{
+						Effect: eks.TaintEffectNoSchedule,
+						Key:    "cool",
+						Value:  awsv1.String("taint"),
+					}},
+				},
+				n: &eks.Nodegroup{
+					Version: &version,
+					Taints: []*eks.Taint{{
+						Effect: awsv1.String(eks.TaintEffectNoSchedule),
+						Key:    awsv1.String("cool"),
+						Value:  awsv1.String("other"),
+					}},
+				},
+			},
+			want: false,
+		},
+		"UpdateLaunchTemplateVersion": {
+			args: args{
+				p: &v1alpha1.NodeGroupParameters{
+					Version: &version,
+					LaunchTemplate: &v1alpha1.LaunchTemplateSpecification{
+						ID:      &ltID,
+						Version: &otherVersion,
+					},
+				},
+				n: &eks.Nodegroup{
+					Version: &version,
+					LaunchTemplate: &eks.LaunchTemplateSpecification{
+						Id:      &ltID,
+						Version: &ltVersion,
+					},
 				},
 			},
 			want: false,
@@ -674,7 +983,7 @@ func TestIsNodeGroupUpToDate(t *testing.T) {
 					},
 				},
 				n: &eks.Nodegroup{
-					Labels: map[string]string{"cool": "label"},
+					Labels: awsv1.StringMap(map[string]string{"cool": "label"}),
 					ScalingConfig: &eks.NodegroupScalingConfig{
 						DesiredSize: &size,
 						MaxSize:     &size,
@@ -682,7 +991,7 @@ func TestIsNodeGroupUpToDate(t *testing.T) {
 					},
 					ReleaseVersion: &version,
 					Version:        &version,
-					Tags:           map[string]string{"cool": "tag"},
+					Tags:           awsv1.StringMap(map[string]string{"cool": "tag"}),
 				},
 			},
 			want: false,
@@ -700,7 +1009,7 @@ func TestIsNodeGroupUpToDate(t *testing.T) {
 					},
 				},
 				n: &eks.Nodegroup{
-					Labels: map[string]string{"cool": "label"},
+					Labels: awsv1.StringMap(map[string]string{"cool": "label"}),
 					ScalingConfig: &eks.NodegroupScalingConfig{
 						DesiredSize: &currentSize,
 						MaxSize:     &maxSize,
@@ -708,7 +1017,7 @@ func TestIsNodeGroupUpToDate(t *testing.T) {
 					},
 					ReleaseVersion: &version,
 					Version:        &version,
-					Tags:           map[string]string{"cool": "tag"},
+					Tags:           awsv1.StringMap(map[string]string{"cool": "tag"}),
 				},
 			},
 			want: true,
@@ -0,0 +1,205 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/endpoints"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	endpointsv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+const (
+	errGetCABundleSecret = "cannot get CA bundle secret"
+	errParseCABundle     = "cannot parse any PEM encoded certificate in CA bundle"
+	errFmtNoFIPSEndpoint = "no FIPS endpoint is known for service %s in region %s"
+)
+
+// dualStackServices are the services whose dual-stack endpoints are in the
+// <service>.dualstack.<region>.<dns-suffix> format.
+var dualStackServices = map[string]bool{
+	"s3":         true,
+	"s3-control": true,
+}
+
+// SetEndpointConfig configures the endpoint resolution and the HTTP client of
+// the supplied config according to the given endpoint configuration.
+func SetEndpointConfig(ctx context.Context, c client.Client, cfg *aws.Config, e *v1beta1.EndpointConfig) error {
+	if e == nil {
+		return nil
+	}
+	hc, err := getCABundleHTTPClient(ctx, c, e)
+	if err != nil {
+		return err
+	}
+	if hc != nil {
+		cfg.HTTPClient = hc
+	}
+
+	fallback := cfg.EndpointResolver
+	if fallback == nil {
+		fallback = endpoints.NewDefaultResolver()
+	}
+	strict := endpoints.NewDefaultResolver()
+	strict.StrictMatching = true
+	cfg.EndpointResolver = aws.EndpointResolverFunc(func(service, region string) (aws.Endpoint, error) {
+		if u, ok := e.URLs[service]; ok {
+			return aws.Endpoint{
+				URL:           u,
+				PartitionID:   aws.StringValue(e.PartitionID),
+				SigningRegion: signingRegion(e, region),
+			}, nil
+		}
+		if aws.BoolValue(e.UseFIPSEndpoint) {
+			for _, r := range fipsRegions(region) {
+				if ep, err := strict.ResolveEndpoint(service, r); err == nil {
+					return ep, nil
+				}
+			}
+			return aws.Endpoint{}, errors.Errorf(errFmtNoFIPSEndpoint, service, region)
+		}
+		ep, err := fallback.ResolveEndpoint(service, region)
+		if err != nil || !aws.BoolValue(e.UseDualStackEndpoint) || !dualStackServices[service] {
+			return ep, err
+		}
+		ep.URL, err = dualStackURL(ep.URL, service, region)
+		return ep, err
+	})
+	return nil
+}
+
+// SetEndpointConfigV1 configures the endpoint resolution and the HTTP client
+// of the supplied V1 config according to the given endpoint configuration.
+func SetEndpointConfigV1(ctx context.Context, c client.Client, cfg *awsv1.Config, e *v1beta1.EndpointConfig) error {
+	if e == nil {
+		return nil
+	}
+	hc, err := getCABundleHTTPClient(ctx, c, e)
+	if err != nil {
+		return err
+	}
+	if hc != nil {
+		cfg.HTTPClient = hc
+	}
+
+	fallback := cfg.EndpointResolver
+	if fallback == nil {
+		fallback = endpointsv1.DefaultResolver()
+	}
+	cfg.EndpointResolver = endpointsv1.ResolverFunc(func(service, region string, optFns ...func(*endpointsv1.Options)) (endpointsv1.ResolvedEndpoint, error) {
+		if u, ok := e.URLs[service]; ok {
+			return endpointsv1.ResolvedEndpoint{
+				URL:           u,
+				PartitionID:   awsv1.StringValue(e.PartitionID),
+				SigningRegion: signingRegion(e, region),
+			}, nil
+		}
+		if awsv1.BoolValue(e.UseFIPSEndpoint) {
+			strict := make([]func(*endpointsv1.Options), 0, len(optFns)+1)
+			strict = append(append(strict, optFns...), func(o *endpointsv1.Options) { o.StrictMatching = true })
+			for _, r := range fipsRegions(region) {
+				if ep, err := fallback.EndpointFor(service, r, strict...); err == nil {
+					return ep, nil
+				}
+			}
+			return endpointsv1.ResolvedEndpoint{}, errors.Errorf(errFmtNoFIPSEndpoint, service, region)
+		}
+		if awsv1.BoolValue(e.UseDualStackEndpoint) {
+			optFns = append(optFns, func(o *endpointsv1.Options) { o.UseDualStack = true })
+		}
+		return fallback.EndpointFor(service, region, optFns...)
+	})
+	return nil
+}
+
+// getCABundleHTTPClient returns an HTTP client that trusts the certificate
+// authorities in the CA bundle referenced by the given endpoint configuration
+// in addition to the ones of the system. It returns nil if no CA bundle is
+// referenced.
+func getCABundleHTTPClient(ctx context.Context, c client.Client, e *v1beta1.EndpointConfig) (*http.Client, error) {
+	data, err := getCABundleData(ctx, c, e)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errors.New(errParseCABundle)
+	}
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	tr.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	return &http.Client{Transport: tr}, nil
+}
+
+// getCABundleData returns the PEM encoded CA bundle referenced by the
+// supplied endpoint configuration, if any.
+func getCABundleData(ctx context.Context, c client.Client, e *v1beta1.EndpointConfig) ([]byte, error) {
+	if e == nil || e.CABundleSecretRef == nil {
+		return nil, nil
+	}
+	ref := e.CABundleSecretRef
+	s := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetCABundleSecret)
+	}
+	return s.Data[ref.Key], nil
+}
+
+// signingRegion returns the region that the calls sent to the overridden
+// endpoints are signed for.
+func signingRegion(e *v1beta1.EndpointConfig, region string) string {
+	if e.SigningRegion != nil {
+		return *e.SigningRegion
+	}
+	return region
+}
+
+// fipsRegions returns the pseudo regions that the FIPS endpoints of a region
+// are modeled with. Both forms are in use depending on the service.
+func fipsRegions(region string) []string {
+	return []string{"fips-" + region, region + "-fips"}
+}
+
+// dualStackURL returns the dual-stack URL of the given service endpoint.
+func dualStackURL(endpoint, service, region string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	suffix := "amazonaws.com"
+	if strings.HasSuffix(u.Host, ".cn") {
+		suffix = "amazonaws.com.cn"
+	}
+	u.Host = fmt.Sprintf("%s.dualstack.%s.%s", service, region, suffix)
+	return u.String(), nil
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/endpoints"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+func TestSetEndpointConfig(t *testing.T) {
+	errBoom := errors.New("boom")
+	localstack := "http://localhost:4566"
+
+	type args struct {
+		kube    *test.MockClient
+		e       *v1beta1.EndpointConfig
+		service string
+		region  string
+	}
+	type want struct {
+		endpoint aws.Endpoint
+		err      error
+		resolve  error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoConfig": {
+			args: args{service: "ec2", region: "us-west-2"},
+			want: want{endpoint: aws.Endpoint{
+				URL:                "https://ec2.us-west-2.amazonaws.com",
+				PartitionID:        "aws",
+				SigningName:        "ec2",
+				SigningNameDerived: true,
+				SigningRegion:      "us-west-2",
+				SigningMethod:      "v4",
+			}},
+		},
+		"OverriddenService": {
+			args: args{
+				e:       &v1beta1.EndpointConfig{URLs: map[string]string{"ec2": localstack}},
+				service: "ec2",
+				region:  "us-west-2",
+			},
+			want: want{endpoint: aws.Endpoint{URL: localstack, SigningRegion: "us-west-2"}},
+		},
+		"OverriddenServiceWithSigningRegion": {
+			args: args{
+				e: &v1beta1.EndpointConfig{
+					URLs:          map[string]string{"ec2": localstack},
+					SigningRegion: aws.String("us-east-1"),
+					PartitionID:   aws.String("aws"),
+				},
+				service: "ec2",
+				region:  "us-west-2",
+			},
+			want: want{endpoint: aws.Endpoint{URL: localstack, SigningRegion: "us-east-1", PartitionID: "aws"}},
+		},
+		"NotOverriddenService": {
+			args: args{
+				e:       &v1beta1.EndpointConfig{URLs: map[string]string{"ec2": localstack}},
+				service: "sqs",
+				region:  "us-west-2",
+			},
+			want: want{endpoint: aws.Endpoint{
+				URL:                "https://sqs.us-west-2.amazonaws.com",
+				PartitionID:        "aws",
+				SigningName:        "sqs",
+				SigningNameDerived: true,
+				SigningRegion:      "us-west-2",
+				SigningMethod:      "v4",
+			}},
+		},
+		"DualStack": {
+			args: args{
+				e:       &v1beta1.EndpointConfig{UseDualStackEndpoint: aws.Bool(true)},
+				service: "s3",
+				region:  "us-west-2",
+			},
+			want: want{endpoint: aws.Endpoint{
+				URL:                "https://s3.dualstack.us-west-2.amazonaws.com",
+				PartitionID:        "aws",
+				SigningName:        "s3",
+				SigningNameDerived: true,
+				SigningRegion:      "us-west-2",
+				SigningMethod:      "s3",
+			}},
+		},
+		"FIPS": {
+			args: args{
+				e:       &v1beta1.EndpointConfig{UseFIPSEndpoint: aws.Bool(true)},
+				service: "sts",
+				region:  "us-east-1",
+			},
+			want: want{endpoint: aws.Endpoint{
+				URL:                "https://sts-fips.us-east-1.amazonaws.com",
+				PartitionID:        "aws",
+				SigningName:        "sts",
+				SigningNameDerived: true,
+				SigningRegion:      "us-east-1",
+				SigningMethod:      "v4",
+			}},
+		},
+		"NoFIPSEndpoint": {
+			args: args{
+				e:       &v1beta1.EndpointConfig{UseFIPSEndpoint: aws.Bool(true)},
+				service: "ec2",
+				region:  "eu-west-1",
+			},
+			want: want{resolve: errors.Errorf(errFmtNoFIPSEndpoint, "ec2", "eu-west-1")},
+		},
+		"CABundleSecretNotFound": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				e: &v1beta1.EndpointConfig{CABundleSecretRef: &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "ca", Namespace: "crossplane-system"},
+					Key:             "ca.crt",
+				}},
+			},
+			want: want{err: errors.Wrap(errBoom, errGetCABundleSecret)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg := &aws.Config{EndpointResolver: endpoints.NewDefaultResolver()}
+			err := SetEndpointConfig(context.Background(), tc.args.kube, cfg, tc.args.e)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			got, err := cfg.EndpointResolver.ResolveEndpoint(tc.args.service, tc.args.region)
+			if diff := cmp.Diff(tc.want.resolve, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.endpoint, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetEndpointConfigV1(t *testing.T) {
+	localstack := "http://localhost:4566"
+
+	cases := map[string]struct {
+		e       *v1beta1.EndpointConfig
+		service string
+		want    string
+	}{
+		"OverriddenService": {
+			e:       &v1beta1.EndpointConfig{URLs: map[string]string{"rds": localstack}},
+			service: "rds",
+			want:    localstack,
+		},
+		"NotOverriddenService": {
+			e:       &v1beta1.EndpointConfig{URLs: map[string]string{"rds": localstack}},
+			service: "sqs",
+			want:    "https://sqs.us-west-2.amazonaws.com",
+		},
+		"DualStack": {
+			e:       &v1beta1.EndpointConfig{UseDualStackEndpoint: aws.Bool(true)},
+			service: "s3",
+			want:    "https://s3.dualstack.us-west-2.amazonaws.com",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg := awsv1.NewConfig()
+			if err := SetEndpointConfigV1(context.Background(), nil, cfg, tc.e); err != nil {
+				t.Fatalf("SetEndpointConfigV1(...): %s", err)
+			}
+			got, err := cfg.EndpointResolver.EndpointFor(tc.service, "us-west-2")
+			if err != nil {
+				t.Fatalf("EndpointFor(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got.URL); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
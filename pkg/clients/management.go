@@ -0,0 +1,126 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+// AnnotationKeyManagementPolicy is the annotation of a managed resource that
+// determines which operations the provider may perform on the external
+// resource.
+const AnnotationKeyManagementPolicy = "aws.alpha.crossplane.io/management-policy"
+
+// A ManagementPolicy determines which operations the provider may perform on
+// an external resource.
+type ManagementPolicy string
+
+// Management policies.
+const (
+	// ManagementPolicyDefault allows the provider to create, update and
+	// delete the external resource.
+	ManagementPolicyDefault ManagementPolicy = "Default"
+
+	// ManagementPolicyObserveOnly only allows the provider to observe the
+	// external resource and late-initialize the managed resource from it.
+	// The external resource must already exist, and is neither updated nor
+	// deleted.
+	ManagementPolicyObserveOnly ManagementPolicy = "ObserveOnly"
+)
+
+const (
+	errObserveOnlyNotFound = "external resource does not exist and cannot be created because the management policy is ObserveOnly"
+	errObserveOnlyCreate   = "cannot create external resource because the management policy is ObserveOnly"
+	errFmtUnknownPolicy    = "unknown management policy %q"
+)
+
+// GetManagementPolicy returns the management policy of the supplied managed
+// resource.
+func GetManagementPolicy(mg resource.Managed) ManagementPolicy {
+	if p, ok := mg.GetAnnotations()[AnnotationKeyManagementPolicy]; ok {
+		return ManagementPolicy(p)
+	}
+	return ManagementPolicyDefault
+}
+
+// NewManagementPolicyConnecter returns a managed.ExternalConnecter whose
+// external clients honour the management policy of the managed resources.
+func NewManagementPolicyConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+		e, err := c.Connect(ctx, mg)
+		if err != nil {
+			return nil, err
+		}
+		return &managementPolicyExternal{ExternalClient: e}, nil
+	})
+}
+
+type managementPolicyExternal struct {
+	managed.ExternalClient
+}
+
+func (e *managementPolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	switch p := GetManagementPolicy(mg); p {
+	case ManagementPolicyDefault:
+		return e.ExternalClient.Observe(ctx, mg)
+	case ManagementPolicyObserveOnly:
+	default:
+		return managed.ExternalObservation{}, errors.Errorf(errFmtUnknownPolicy, p)
+	}
+
+	// NOTE: An observe-only managed resource that is being deleted is
+	// reported as gone so that its finalizer is removed without deleting
+	// the external resource.
+	if meta.WasDeleted(mg) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	if err != nil {
+		return o, err
+	}
+	if !o.ResourceExists {
+		return o, errors.New(errObserveOnlyNotFound)
+	}
+	o.ResourceUpToDate = true
+	return o, nil
+}
+
+func (e *managementPolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if GetManagementPolicy(mg) != ManagementPolicyDefault {
+		return managed.ExternalCreation{}, errors.New(errObserveOnlyCreate)
+	}
+	return e.ExternalClient.Create(ctx, mg)
+}
+
+func (e *managementPolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if GetManagementPolicy(mg) != ManagementPolicyDefault {
+		return managed.ExternalUpdate{}, nil
+	}
+	return e.ExternalClient.Update(ctx, mg)
+}
+
+func (e *managementPolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	if GetManagementPolicy(mg) != ManagementPolicyDefault {
+		return nil
+	}
+	return e.ExternalClient.Delete(ctx, mg)
+}
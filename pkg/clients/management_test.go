@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+var errBoom = errors.New("boom")
+
+func withPolicy(p ManagementPolicy) func(*fake.Managed) {
+	return func(mg *fake.Managed) {
+		mg.SetAnnotations(map[string]string{AnnotationKeyManagementPolicy: string(p)})
+	}
+}
+
+func deleted(mg *fake.Managed) {
+	now := metav1.Now()
+	mg.SetDeletionTimestamp(&now)
+}
+
+func managedWith(opts ...func(*fake.Managed)) *fake.Managed {
+	mg := &fake.Managed{}
+	for _, f := range opts {
+		f(mg)
+	}
+	return mg
+}
+
+func TestManagementPolicyObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		o      managed.ExternalObservation
+		want   want
+	}{
+		"Default": {
+			reason: "The observation of a resource without a management policy should not be changed.",
+			mg:     managedWith(),
+			o:      managed.ExternalObservation{ResourceExists: true},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"ObserveOnly": {
+			reason: "An observe-only resource should always be reported as up to date.",
+			mg:     managedWith(withPolicy(ManagementPolicyObserveOnly)),
+			o:      managed.ExternalObservation{ResourceExists: true, ResourceLateInitialized: true},
+			want:   want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}},
+		},
+		"ObserveOnlyNotFound": {
+			reason: "An observe-only resource that does not exist should return an error.",
+			mg:     managedWith(withPolicy(ManagementPolicyObserveOnly)),
+			o:      managed.ExternalObservation{ResourceExists: false},
+			want:   want{err: errors.New(errObserveOnlyNotFound)},
+		},
+		"ObserveOnlyDeleted": {
+			reason: "An observe-only resource that is being deleted should be reported as gone.",
+			mg:     managedWith(withPolicy(ManagementPolicyObserveOnly), deleted),
+			o:      managed.ExternalObservation{ResourceExists: true},
+			want:   want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"UnknownPolicy": {
+			reason: "An unknown management policy should return an error.",
+			mg:     managedWith(withPolicy("Sometimes")),
+			want:   want{err: errors.Errorf(errFmtUnknownPolicy, "Sometimes")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &managementPolicyExternal{ExternalClient: &managed.ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+					return tc.o, nil
+				},
+			}}
+			o, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestManagementPolicyDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   error
+	}{
+		"Default": {
+			reason: "A resource without a management policy should be deleted.",
+			mg:     managedWith(),
+			want:   errBoom,
+		},
+		"ObserveOnly": {
+			reason: "An observe-only resource should never be deleted.",
+			mg:     managedWith(withPolicy(ManagementPolicyObserveOnly)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &managementPolicyExternal{ExternalClient: &managed.ExternalClientFns{
+				DeleteFn: func(_ context.Context, _ resource.Managed) error {
+					return errBoom
+				},
+			}}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
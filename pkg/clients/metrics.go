@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awserrv1 "github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const metricsHandlerName = "crossplane.APICallMetrics"
+
+var (
+	apiCallLabels = []string{"service", "operation", "status_code", "error_code", "provider_config"}
+
+	apiCallsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "aws_api_calls_total",
+		Help: "Total number of AWS API call attempts.",
+	}, apiCallLabels)
+
+	apiCallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "aws_api_call_duration_seconds",
+		Help:    "Latency of AWS API call attempts in seconds.",
+		Buckets: prometheus.DefBuckets,
+	}, apiCallLabels)
+)
+
+func init() {
+	metrics.Registry.MustRegister(apiCallsTotal, apiCallDuration)
+}
+
+// NewMetricsHandler returns a handler that records the metrics of every AWS
+// API call attempt made by the clients built with a config that was built for
+// the given ProviderConfig.
+func NewMetricsHandler(providerConfig string) aws.NamedHandler {
+	return aws.NamedHandler{
+		Name: metricsHandlerName,
+		Fn: func(r *aws.Request) {
+			op := ""
+			if r.Operation != nil {
+				op = r.Operation.Name
+			}
+			code := ""
+			if err, ok := r.Error.(awserr.Error); ok {
+				code = err.Code()
+			}
+			observeAPICall(time.Since(r.AttemptTime), r.Metadata.ServiceID, op, statusCode(r.HTTPResponse), code, providerConfig)
+		},
+	}
+}
+
+// NewMetricsHandlerV1 returns a handler that records the metrics of every
+// AWS API call attempt made by the V1 clients built with a session that was
+// built for the given ProviderConfig.
+func NewMetricsHandlerV1(providerConfig string) request.NamedHandler {
+	return request.NamedHandler{
+		Name: metricsHandlerName,
+		Fn: func(r *request.Request) {
+			op := ""
+			if r.Operation != nil {
+				op = r.Operation.Name
+			}
+			code := ""
+			if err, ok := r.Error.(awserrv1.Error); ok {
+				code = err.Code()
+			}
+			observeAPICall(time.Since(r.AttemptTime), r.ClientInfo.ServiceID, op, statusCode(r.HTTPResponse), code, providerConfig)
+		},
+	}
+}
+
+func observeAPICall(d time.Duration, lvs ...string) {
+	apiCallsTotal.WithLabelValues(lvs...).Inc()
+	apiCallDuration.WithLabelValues(lvs...).Observe(d.Seconds())
+}
+
+// statusCode returns the status code of the supplied response, or 0 if no
+// response was received.
+func statusCode(r *http.Response) string {
+	if r == nil {
+		return "0"
+	}
+	return strconv.Itoa(r.StatusCode)
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awserrv1 "github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMetricsHandler(t *testing.T) {
+	cases := map[string]struct {
+		r      *aws.Request
+		labels []string
+	}{
+		"Successful": {
+			r: &aws.Request{
+				Metadata:     aws.Metadata{ServiceID: "EC2"},
+				Operation:    &aws.Operation{Name: "DescribeVpcs"},
+				HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+				AttemptTime:  time.Now(),
+			},
+			labels: []string{"EC2", "DescribeVpcs", "200", "", "successful"},
+		},
+		"Throttled": {
+			r: &aws.Request{
+				Metadata:     aws.Metadata{ServiceID: "EC2"},
+				Operation:    &aws.Operation{Name: "DescribeVpcs"},
+				HTTPResponse: &http.Response{StatusCode: http.StatusServiceUnavailable},
+				Error:        awserr.New("RequestLimitExceeded", "", nil),
+				AttemptTime:  time.Now(),
+			},
+			labels: []string{"EC2", "DescribeVpcs", "503", "RequestLimitExceeded", "throttled"},
+		},
+		"NoResponse": {
+			r: &aws.Request{
+				Metadata:    aws.Metadata{ServiceID: "EC2"},
+				Operation:   &aws.Operation{Name: "DescribeVpcs"},
+				Error:       awserr.New("RequestError", "", nil),
+				AttemptTime: time.Now(),
+			},
+			labels: []string{"EC2", "DescribeVpcs", "0", "RequestError", "noresponse"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			NewMetricsHandler(tc.labels[4]).Fn(tc.r)
+			if diff := cmp.Diff(float64(1), testutil.ToFloat64(apiCallsTotal.WithLabelValues(tc.labels...))); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMetricsHandlerV1(t *testing.T) {
+	r := &request.Request{
+		ClientInfo:   metadata.ClientInfo{ServiceID: "RDS"},
+		Operation:    &request.Operation{Name: "DescribeDBClusters"},
+		HTTPResponse: &http.Response{StatusCode: http.StatusBadRequest},
+		Error:        awserrv1.New("Throttling", "", nil),
+		AttemptTime:  time.Now(),
+	}
+	NewMetricsHandlerV1("v1").Fn(r)
+	got := testutil.ToFloat64(apiCallsTotal.WithLabelValues("RDS", "DescribeDBClusters", "400", "Throttling", "v1"))
+	if diff := cmp.Diff(float64(1), got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
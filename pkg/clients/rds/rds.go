@@ -474,6 +474,15 @@ func IsUpToDate(ctx context.Context, kube client.Client, r *v1beta1.RDSInstance,
 	) && !pwdChanged, nil
 }
 
+// GetDriftedFields returns the fields of the supplied RDSInstanceParameters
+// that differ from the supplied rds.DBInstance.
+func GetDriftedFields(p *v1beta1.RDSInstanceParameters, db rds.DBInstance) ([]string, error) {
+	current := &v1beta1.RDSInstanceParameters{}
+	LateInitialize(current, &db)
+	return awsclients.DriftedFields(current, p, "region", "tags", "skipFinalSnapshotBeforeDeletion",
+		"finalDBSnapshotIdentifier", "applyModificationsImmediately", "allowMajorVersionUpgrade")
+}
+
 // GetPassword fetches the referenced input password for an RDSInstance CRD and determines whether it has changed or not
 func GetPassword(ctx context.Context, kube client.Client, in *xpv1.SecretKeySelector, out *xpv1.SecretReference) (newPwd string, changed bool, err error) {
 	if in == nil {
@@ -0,0 +1,184 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+)
+
+const (
+	// AccessPointNotFoundErrCode is the error code returned by S3 Control
+	// when an access point does not exist.
+	AccessPointNotFoundErrCode = "NoSuchAccessPoint"
+	// AccessPointPolicyNotFoundErrCode is the error code returned by S3
+	// Control when an access point has no policy.
+	AccessPointPolicyNotFoundErrCode = "NoSuchAccessPointPolicy"
+
+	// ConnectionKeyAccessPointARN is the connection detail key of the
+	// access point ARN.
+	ConnectionKeyAccessPointARN = "arn"
+	// ConnectionKeyAccessPointName is the connection detail key of the
+	// access point name.
+	ConnectionKeyAccessPointName = "name"
+	// ConnectionKeyAccessPointAlias is the connection detail key of the
+	// access point alias, which can be used wherever a bucket name is
+	// accepted.
+	ConnectionKeyAccessPointAlias = "alias"
+)
+
+// AccessPointClient is the external client used for AccessPoint Custom
+// Resource. The V1 SDK is used since access point aliases are not reported by
+// the V2 SDK.
+type AccessPointClient interface {
+	CreateAccessPointWithContext(ctx context.Context, input *s3control.CreateAccessPointInput, opts ...request.Option) (*s3control.CreateAccessPointOutput, error)
+	GetAccessPointWithContext(ctx context.Context, input *s3control.GetAccessPointInput, opts ...request.Option) (*s3control.GetAccessPointOutput, error)
+	DeleteAccessPointWithContext(ctx context.Context, input *s3control.DeleteAccessPointInput, opts ...request.Option) (*s3control.DeleteAccessPointOutput, error)
+	GetAccessPointPolicyWithContext(ctx context.Context, input *s3control.GetAccessPointPolicyInput, opts ...request.Option) (*s3control.GetAccessPointPolicyOutput, error)
+	PutAccessPointPolicyWithContext(ctx context.Context, input *s3control.PutAccessPointPolicyInput, opts ...request.Option) (*s3control.PutAccessPointPolicyOutput, error)
+	DeleteAccessPointPolicyWithContext(ctx context.Context, input *s3control.DeleteAccessPointPolicyInput, opts ...request.Option) (*s3control.DeleteAccessPointPolicyOutput, error)
+}
+
+// NewAccessPointClient returns a new V1 client using the supplied session.
+func NewAccessPointClient(sess *session.Session) AccessPointClient {
+	return s3control.New(sess)
+}
+
+// CallerIdentityClient is used to determine the account that owns an access
+// point when it is not given explicitly.
+type CallerIdentityClient interface {
+	GetCallerIdentityWithContext(ctx context.Context, input *sts.GetCallerIdentityInput, opts ...request.Option) (*sts.GetCallerIdentityOutput, error)
+}
+
+// NewCallerIdentityClient returns a new V1 client using the supplied session.
+func NewCallerIdentityClient(sess *session.Session) CallerIdentityClient {
+	return sts.New(sess)
+}
+
+// IsAccessPointNotFound returns true if the error code indicates that the
+// access point was not found
+func IsAccessPointNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == AccessPointNotFoundErrCode {
+		return true
+	}
+	return false
+}
+
+// IsAccessPointPolicyNotFound returns true if the error code indicates that
+// the access point has no policy
+func IsAccessPointPolicyNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == AccessPointPolicyNotFoundErrCode {
+		return true
+	}
+	return false
+}
+
+// GenerateCreateAccessPointInput returns the input to create the access point
+// with the supplied name.
+func GenerateCreateAccessPointInput(name string, p v1alpha3.AccessPointParameters) *s3control.CreateAccessPointInput {
+	input := &s3control.CreateAccessPointInput{
+		AccountId: p.AccountID,
+		Bucket:    p.BucketName,
+		Name:      aws.String(name),
+	}
+	if p.VPCConfiguration != nil {
+		input.VpcConfiguration = &s3control.VpcConfiguration{VpcId: p.VPCConfiguration.VPCID}
+	}
+	if pab := p.PublicAccessBlockConfiguration; pab != nil {
+		input.PublicAccessBlockConfiguration = &s3control.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       pab.BlockPublicAcls,
+			BlockPublicPolicy:     pab.BlockPublicPolicy,
+			IgnorePublicAcls:      pab.IgnorePublicAcls,
+			RestrictPublicBuckets: pab.RestrictPublicBuckets,
+		}
+	}
+	return input
+}
+
+// AccessPointARN returns the ARN of the access point with the supplied name,
+// in the partition that the supplied region belongs to.
+func AccessPointARN(region, accountID, name string) string {
+	partition := "aws"
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		partition = p.ID()
+	}
+	return fmt.Sprintf("arn:%s:s3:%s:%s:accesspoint/%s", partition, region, accountID, name)
+}
+
+// GenerateAccessPointObservation returns the observation of the access point
+// with the supplied name.
+func GenerateAccessPointObservation(name string, p v1alpha3.AccessPointParameters, out s3control.GetAccessPointOutput) v1alpha3.AccessPointObservation {
+	o := v1alpha3.AccessPointObservation{
+		ARN:           AccessPointARN(p.Region, aws.StringValue(p.AccountID), name),
+		Alias:         aws.StringValue(out.Alias),
+		NetworkOrigin: aws.StringValue(out.NetworkOrigin),
+	}
+	if out.CreationDate != nil {
+		t := metav1.NewTime(*out.CreationDate)
+		o.CreationDate = &t
+	}
+	return o
+}
+
+// FormatAccessPointPolicy returns the JSON policy document of the supplied
+// parameters, or nil if no policy is specified.
+func FormatAccessPointPolicy(p v1alpha3.AccessPointParameters) (*string, error) {
+	switch {
+	case p.RawPolicy != nil:
+		return p.RawPolicy, nil
+	case p.Policy != nil:
+		body, err := Serialize(p.Policy.DeepCopy())
+		if err != nil {
+			return nil, err
+		}
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		return aws.String(string(b)), nil
+	}
+	return nil, nil
+}
+
+// IsAccessPointPolicyUpToDate returns true if the supplied policy documents
+// are semantically equal. A nil policy is only equal to another nil policy.
+func IsAccessPointPolicyUpToDate(desired, current *string) bool {
+	if desired == nil || current == nil {
+		return desired == nil && current == nil
+	}
+	var d, c interface{}
+	if err := json.Unmarshal([]byte(*desired), &d); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(*current), &c); err != nil {
+		return false
+	}
+	return cmp.Equal(d, c)
+}
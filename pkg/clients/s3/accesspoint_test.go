@@ -0,0 +1,236 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+func TestAccessPointARN(t *testing.T) {
+	cases := map[string]struct {
+		region string
+		want   string
+	}{
+		"Commercial": {
+			region: "us-east-1",
+			want:   "arn:aws:s3:us-east-1:123456789012:accesspoint/ap",
+		},
+		"China": {
+			region: "cn-north-1",
+			want:   "arn:aws-cn:s3:cn-north-1:123456789012:accesspoint/ap",
+		},
+		"GovCloud": {
+			region: "us-gov-west-1",
+			want:   "arn:aws-us-gov:s3:us-gov-west-1:123456789012:accesspoint/ap",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := AccessPointARN(tc.region, "123456789012", "ap")
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCreateAccessPointInput(t *testing.T) {
+	cases := map[string]struct {
+		params v1alpha3.AccessPointParameters
+		want   *s3control.CreateAccessPointInput
+	}{
+		"Minimal": {
+			params: v1alpha3.AccessPointParameters{
+				AccountID:  aws.String("123456789012"),
+				BucketName: aws.String("bucket"),
+			},
+			want: &s3control.CreateAccessPointInput{
+				AccountId: aws.String("123456789012"),
+				Bucket:    aws.String("bucket"),
+				Name:      aws.String("ap"),
+			},
+		},
+		"Full": {
+			params: v1alpha3.AccessPointParameters{
+				AccountID:        aws.String("123456789012"),
+				BucketName:       aws.String("bucket"),
+				VPCConfiguration: &v1alpha3.AccessPointVPCConfiguration{VPCID: aws.String("vpc-1")},
+				PublicAccessBlockConfiguration: &v1beta1.PublicAccessBlockConfiguration{
+					BlockPublicAcls:   aws.Bool(true),
+					BlockPublicPolicy: aws.Bool(true),
+				},
+			},
+			want: &s3control.CreateAccessPointInput{
+				AccountId:        aws.String("123456789012"),
+				Bucket:           aws.String("bucket"),
+				Name:             aws.String("ap"),
+				VpcConfiguration: &s3control.VpcConfiguration{VpcId: aws.String("vpc-1")},
+				PublicAccessBlockConfiguration: &s3control.PublicAccessBlockConfiguration{
+					BlockPublicAcls:   aws.Bool(true),
+					BlockPublicPolicy: aws.Bool(true),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateAccessPointInput("ap", tc.params)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateAccessPointObservation(t *testing.T) {
+	created := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+	createdTime := metav1.NewTime(created)
+
+	cases := map[string]struct {
+		out  s3control.GetAccessPointOutput
+		want v1alpha3.AccessPointObservation
+	}{
+		"Empty": {
+			out: s3control.GetAccessPointOutput{},
+			want: v1alpha3.AccessPointObservation{
+				ARN: "arn:aws:s3:us-east-1:123456789012:accesspoint/ap",
+			},
+		},
+		"Full": {
+			out: s3control.GetAccessPointOutput{
+				Alias:         aws.String("ap-abcdefghijklmnopqrstuvwxyz0123-s3alias"),
+				CreationDate:  &created,
+				NetworkOrigin: aws.String(s3control.NetworkOriginVpc),
+			},
+			want: v1alpha3.AccessPointObservation{
+				ARN:           "arn:aws:s3:us-east-1:123456789012:accesspoint/ap",
+				Alias:         "ap-abcdefghijklmnopqrstuvwxyz0123-s3alias",
+				NetworkOrigin: s3control.NetworkOriginVpc,
+				CreationDate:  &createdTime,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := v1alpha3.AccessPointParameters{Region: "us-east-1", AccountID: aws.String("123456789012")}
+			got := GenerateAccessPointObservation("ap", p, tc.out)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFormatAccessPointPolicy(t *testing.T) {
+	type want struct {
+		policy *string
+		err    error
+	}
+
+	cases := map[string]struct {
+		params v1alpha3.AccessPointParameters
+		want   want
+	}{
+		"NoPolicy": {
+			params: v1alpha3.AccessPointParameters{},
+			want:   want{},
+		},
+		"RawPolicy": {
+			params: v1alpha3.AccessPointParameters{RawPolicy: aws.String(`{"Version":"2012-10-17"}`)},
+			want:   want{policy: aws.String(`{"Version":"2012-10-17"}`)},
+		},
+		"Policy": {
+			params: v1alpha3.AccessPointParameters{
+				Policy: &v1alpha3.BucketPolicyBody{
+					Version: "2012-10-17",
+					Statements: []v1alpha3.BucketPolicyStatement{{
+						Effect:   "Allow",
+						Action:   []string{"s3:GetObject"},
+						Resource: []string{"arn:aws:s3:us-east-1:123456789012:accesspoint/ap/object/*"},
+						Principal: &v1alpha3.BucketPrincipal{
+							AWSPrincipals: []v1alpha3.AWSPrincipal{{AWSAccountID: aws.String("123456789012")}},
+						},
+					}},
+				},
+			},
+			want: want{policy: aws.String(`{"Statement":[{"Action":"s3:GetObject","Effect":"Allow","Principal":{"AWS":"123456789012"},"Resource":"arn:aws:s3:us-east-1:123456789012:accesspoint/ap/object/*"}],"Version":"2012-10-17"}`)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := FormatAccessPointPolicy(tc.params)
+			if diff := cmp.Diff(tc.want.err, err); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAccessPointPolicyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired *string
+		current *string
+		want    bool
+	}{
+		"BothNil": {
+			want: true,
+		},
+		"NoDesired": {
+			current: aws.String(`{"Version":"2012-10-17"}`),
+			want:    false,
+		},
+		"NoCurrent": {
+			desired: aws.String(`{"Version":"2012-10-17"}`),
+			want:    false,
+		},
+		"SemanticallyEqual": {
+			desired: aws.String(`{"Version":"2012-10-17","Statement":[]}`),
+			current: aws.String(`{"Statement": [], "Version": "2012-10-17"}`),
+			want:    true,
+		},
+		"Different": {
+			desired: aws.String(`{"Version":"2012-10-17"}`),
+			current: aws.String(`{"Version":"2008-10-17"}`),
+			want:    false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAccessPointPolicyUpToDate(tc.desired, tc.current)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
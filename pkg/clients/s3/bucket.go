@@ -24,7 +24,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awserrv1 "github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	s3v1 "github.com/aws/aws-sdk-go/service/s3"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/pkg/errors"
 
 	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
 )
@@ -46,6 +51,10 @@ var (
 	TaggingNotFoundErrCode = "NoSuchTagSet"
 	// WebsiteNotFoundErrCode is the error code sent by AWS when the website config does not exist
 	WebsiteNotFoundErrCode = "NoSuchWebsiteConfiguration"
+	// ObjectLockNotFoundErrCode is the error code sent by AWS when the Object Lock configuration does not exist
+	ObjectLockNotFoundErrCode = "ObjectLockConfigurationNotFoundError"
+	// OwnershipControlsNotFoundErrCode is the error code sent by AWS when the ownership controls do not exist
+	OwnershipControlsNotFoundErrCode = "OwnershipControlsNotFoundError"
 	// MethodNotAllowed is the error code sent by AWS when the request method for an object is not allowed
 	MethodNotAllowed = "MethodNotAllowed"
 	// UnsupportedArgument is the error code sent by AWS when the request fields contain an argument that is not supported
@@ -58,6 +67,11 @@ type BucketClient interface {
 	CreateBucketRequest(input *s3.CreateBucketInput) s3.CreateBucketRequest
 	DeleteBucketRequest(input *s3.DeleteBucketInput) s3.DeleteBucketRequest
 
+	ListObjectVersionsRequest(input *s3.ListObjectVersionsInput) s3.ListObjectVersionsRequest
+	DeleteObjectsRequest(input *s3.DeleteObjectsInput) s3.DeleteObjectsRequest
+	ListMultipartUploadsRequest(input *s3.ListMultipartUploadsInput) s3.ListMultipartUploadsRequest
+	AbortMultipartUploadRequest(input *s3.AbortMultipartUploadInput) s3.AbortMultipartUploadRequest
+
 	PutBucketEncryptionRequest(input *s3.PutBucketEncryptionInput) s3.PutBucketEncryptionRequest
 	GetBucketEncryptionRequest(input *s3.GetBucketEncryptionInput) s3.GetBucketEncryptionRequest
 	DeleteBucketEncryptionRequest(input *s3.DeleteBucketEncryptionInput) s3.DeleteBucketEncryptionRequest
@@ -92,6 +106,16 @@ type BucketClient interface {
 
 	PutBucketAnalyticsConfigurationRequest(input *s3.PutBucketAnalyticsConfigurationInput) s3.PutBucketAnalyticsConfigurationRequest
 	GetBucketAnalyticsConfigurationRequest(input *s3.GetBucketAnalyticsConfigurationInput) s3.GetBucketAnalyticsConfigurationRequest
+	ListBucketAnalyticsConfigurationsRequest(input *s3.ListBucketAnalyticsConfigurationsInput) s3.ListBucketAnalyticsConfigurationsRequest
+	DeleteBucketAnalyticsConfigurationRequest(input *s3.DeleteBucketAnalyticsConfigurationInput) s3.DeleteBucketAnalyticsConfigurationRequest
+
+	PutBucketInventoryConfigurationRequest(input *s3.PutBucketInventoryConfigurationInput) s3.PutBucketInventoryConfigurationRequest
+	ListBucketInventoryConfigurationsRequest(input *s3.ListBucketInventoryConfigurationsInput) s3.ListBucketInventoryConfigurationsRequest
+	DeleteBucketInventoryConfigurationRequest(input *s3.DeleteBucketInventoryConfigurationInput) s3.DeleteBucketInventoryConfigurationRequest
+
+	PutBucketMetricsConfigurationRequest(input *s3.PutBucketMetricsConfigurationInput) s3.PutBucketMetricsConfigurationRequest
+	ListBucketMetricsConfigurationsRequest(input *s3.ListBucketMetricsConfigurationsInput) s3.ListBucketMetricsConfigurationsRequest
+	DeleteBucketMetricsConfigurationRequest(input *s3.DeleteBucketMetricsConfigurationInput) s3.DeleteBucketMetricsConfigurationRequest
 
 	PutBucketLifecycleConfigurationRequest(input *s3.PutBucketLifecycleConfigurationInput) s3.PutBucketLifecycleConfigurationRequest
 	GetBucketLifecycleConfigurationRequest(input *s3.GetBucketLifecycleConfigurationInput) s3.GetBucketLifecycleConfigurationRequest
@@ -106,6 +130,21 @@ type BucketClient interface {
 	GetPublicAccessBlockRequest(input *s3.GetPublicAccessBlockInput) s3.GetPublicAccessBlockRequest
 	PutPublicAccessBlockRequest(input *s3.PutPublicAccessBlockInput) s3.PutPublicAccessBlockRequest
 	DeletePublicAccessBlockRequest(input *s3.DeletePublicAccessBlockInput) s3.DeletePublicAccessBlockRequest
+
+	GetObjectLockConfigurationRequest(input *s3.GetObjectLockConfigurationInput) s3.GetObjectLockConfigurationRequest
+	PutObjectLockConfigurationRequest(input *s3.PutObjectLockConfigurationInput) s3.PutObjectLockConfigurationRequest
+}
+
+// BucketClientV1 is the external client for the Bucket APIs that are not
+// supported by BucketClient.
+type BucketClientV1 interface {
+	GetBucketOwnershipControlsWithContext(ctx context.Context, input *s3v1.GetBucketOwnershipControlsInput, opts ...request.Option) (*s3v1.GetBucketOwnershipControlsOutput, error)
+	PutBucketOwnershipControlsWithContext(ctx context.Context, input *s3v1.PutBucketOwnershipControlsInput, opts ...request.Option) (*s3v1.PutBucketOwnershipControlsOutput, error)
+	DeleteBucketOwnershipControlsWithContext(ctx context.Context, input *s3v1.DeleteBucketOwnershipControlsInput, opts ...request.Option) (*s3v1.DeleteBucketOwnershipControlsOutput, error)
+
+	ListBucketIntelligentTieringConfigurationsWithContext(ctx context.Context, input *s3v1.ListBucketIntelligentTieringConfigurationsInput, opts ...request.Option) (*s3v1.ListBucketIntelligentTieringConfigurationsOutput, error)
+	PutBucketIntelligentTieringConfigurationWithContext(ctx context.Context, input *s3v1.PutBucketIntelligentTieringConfigurationInput, opts ...request.Option) (*s3v1.PutBucketIntelligentTieringConfigurationOutput, error)
+	DeleteBucketIntelligentTieringConfigurationWithContext(ctx context.Context, input *s3v1.DeleteBucketIntelligentTieringConfigurationInput, opts ...request.Option) (*s3v1.DeleteBucketIntelligentTieringConfigurationOutput, error)
 }
 
 // NewClient returns a new client using AWS credentials as JSON encoded data.
@@ -113,12 +152,17 @@ func NewClient(cfg aws.Config) BucketClient {
 	return s3.New(cfg)
 }
 
+// NewClientV1 returns a new V1 client using the supplied session.
+func NewClientV1(sess *session.Session) BucketClientV1 {
+	return s3v1.New(sess)
+}
+
 // IsNotFound helper function to test for NotFound error
 func IsNotFound(err error) bool {
 	if err == nil {
 		return false
 	}
-	if bucketErr, ok := err.(awserr.Error); ok && bucketErr.Code() == BucketNotFoundErrCode {
+	if bucketErr, ok := err.(awserr.Error); ok && (bucketErr.Code() == BucketNotFoundErrCode || bucketErr.Code() == s3.ErrCodeNoSuchBucket) {
 		return true
 	}
 	return false
@@ -202,6 +246,18 @@ func WebsiteConfigurationNotFound(err error) bool {
 	return ok && s3Err.Code() == WebsiteNotFoundErrCode
 }
 
+// ObjectLockConfigurationNotFound is parses the aws Error and validates if the Object Lock configuration does not exist
+func ObjectLockConfigurationNotFound(err error) bool {
+	s3Err, ok := err.(awserr.Error)
+	return ok && s3Err.Code() == ObjectLockNotFoundErrCode
+}
+
+// OwnershipControlsNotFound is parses the aws V1 Error and validates if the ownership controls do not exist
+func OwnershipControlsNotFound(err error) bool {
+	s3Err, ok := err.(awserrv1.Error)
+	return ok && s3Err.Code() == OwnershipControlsNotFoundErrCode
+}
+
 // MethodNotSupported is parses the aws Error and validates if the method is allowed for a request
 func MethodNotSupported(err error) bool {
 	s3Err, ok := err.(awserr.Error)
@@ -229,6 +285,73 @@ func UpdateBucketACL(ctx context.Context, client BucketClient, bucket *v1beta1.B
 	return err
 }
 
+const (
+	errListMultipartUploads = "cannot list multipart uploads"
+	errAbortMultipartUpload = "cannot abort multipart upload"
+	errListObjectVersions   = "cannot list object versions"
+	errDeleteObjects        = "cannot delete objects"
+	errFmtDeleteObject      = "cannot delete object %s version %s: %s: %s"
+)
+
+// EmptyBucket aborts a single page of the incomplete multipart uploads of the
+// given bucket, and deletes a single page of its object versions and delete
+// markers. It returns the number of objects that were deleted and whether the
+// bucket was already empty, in which case nothing was deleted. A bucket that
+// does not exist is considered empty. Draining a large bucket hence takes
+// several calls.
+func EmptyBucket(ctx context.Context, client BucketClient, bucket string) (int, bool, error) {
+	uploads, err := client.ListMultipartUploadsRequest(&s3.ListMultipartUploadsInput{Bucket: aws.String(bucket)}).Send(ctx)
+	if IsNotFound(err) {
+		return 0, true, nil
+	}
+	if err != nil {
+		return 0, false, errors.Wrap(err, errListMultipartUploads)
+	}
+	for _, u := range uploads.Uploads {
+		_, err := client.AbortMultipartUploadRequest(&s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(bucket),
+			Key:      u.Key,
+			UploadId: u.UploadId,
+		}).Send(ctx)
+		if err != nil && !isNoSuchUpload(err) {
+			return 0, false, errors.Wrap(err, errAbortMultipartUpload)
+		}
+	}
+
+	versions, err := client.ListObjectVersionsRequest(&s3.ListObjectVersionsInput{Bucket: aws.String(bucket)}).Send(ctx)
+	if err != nil {
+		return 0, false, errors.Wrap(err, errListObjectVersions)
+	}
+	objects := make([]s3.ObjectIdentifier, 0, len(versions.Versions)+len(versions.DeleteMarkers))
+	for _, v := range versions.Versions {
+		objects = append(objects, s3.ObjectIdentifier{Key: v.Key, VersionId: v.VersionId})
+	}
+	for _, m := range versions.DeleteMarkers {
+		objects = append(objects, s3.ObjectIdentifier{Key: m.Key, VersionId: m.VersionId})
+	}
+	if len(objects) == 0 {
+		return 0, len(uploads.Uploads) == 0, nil
+	}
+	rsp, err := client.DeleteObjectsRequest(&s3.DeleteObjectsInput{
+		Bucket: aws.String(bucket),
+		Delete: &s3.Delete{Objects: objects, Quiet: aws.Bool(true)},
+	}).Send(ctx)
+	if err != nil {
+		return 0, false, errors.Wrap(err, errDeleteObjects)
+	}
+	if len(rsp.Errors) > 0 {
+		e := rsp.Errors[0]
+		return len(objects) - len(rsp.Errors), false, errors.Errorf(errFmtDeleteObject,
+			aws.StringValue(e.Key), aws.StringValue(e.VersionId), aws.StringValue(e.Code), aws.StringValue(e.Message))
+	}
+	return len(objects), false, nil
+}
+
+func isNoSuchUpload(err error) bool {
+	s3Err, ok := err.(awserr.Error)
+	return ok && s3Err.Code() == s3.ErrCodeNoSuchUpload
+}
+
 // CopyTags converts a list of local v1beta.Tags to S3 Tags
 func CopyTags(tags []v1beta1.Tag) []s3.Tag {
 	out := make([]s3.Tag, 0)
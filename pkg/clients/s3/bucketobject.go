@@ -0,0 +1,171 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"bytes"
+	"crypto/md5" // nolint:gosec
+	"encoding/base64"
+	"encoding/hex"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+)
+
+// ObjectNotFoundErrCode is the error code sent by AWS when a HEAD request
+// targets an object that does not exist.
+const ObjectNotFoundErrCode = "NotFound"
+
+// BucketObjectClient is the external client used for BucketObject Custom Resource
+type BucketObjectClient interface {
+	HeadObjectRequest(input *s3.HeadObjectInput) s3.HeadObjectRequest
+	GetObjectTaggingRequest(input *s3.GetObjectTaggingInput) s3.GetObjectTaggingRequest
+	PutObjectRequest(input *s3.PutObjectInput) s3.PutObjectRequest
+	DeleteObjectRequest(input *s3.DeleteObjectInput) s3.DeleteObjectRequest
+}
+
+// NewBucketObjectClient returns a new client given an aws config
+func NewBucketObjectClient(cfg aws.Config) BucketObjectClient {
+	return s3.New(cfg)
+}
+
+// IsObjectNotFound returns true if the error code indicates that the object
+// or its bucket was not found
+func IsObjectNotFound(err error) bool {
+	s3Err, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	switch s3Err.Code() {
+	case ObjectNotFoundErrCode, s3.ErrCodeNoSuchKey, s3.ErrCodeNoSuchBucket:
+		return true
+	}
+	return false
+}
+
+// ContentMD5 returns the hex encoded MD5 digest of the supplied content, which
+// is what S3 reports as ETag for objects uploaded in a single part without
+// SSE-KMS.
+func ContentMD5(content []byte) string {
+	sum := md5.Sum(content) // nolint:gosec
+	return hex.EncodeToString(sum[:])
+}
+
+// GeneratePutObjectInput returns the input for a PutObject request that
+// writes the supplied content with the desired parameters.
+func GeneratePutObjectInput(p v1alpha3.BucketObjectParameters, content []byte) *s3.PutObjectInput {
+	sum := md5.Sum(content) // nolint:gosec
+	input := &s3.PutObjectInput{
+		Body:         bytes.NewReader(content),
+		Bucket:       p.BucketName,
+		CacheControl: p.CacheControl,
+		ContentMD5:   aws.String(base64.StdEncoding.EncodeToString(sum[:])),
+		ContentType:  p.ContentType,
+		Key:          aws.String(p.Key),
+		Metadata:     lowerKeys(p.Metadata),
+		SSEKMSKeyId:  p.SSEKMSKeyID,
+	}
+	if p.ServerSideEncryption != nil {
+		input.ServerSideEncryption = s3.ServerSideEncryption(*p.ServerSideEncryption)
+	}
+	if len(p.Tags) != 0 {
+		v := url.Values{}
+		for _, t := range p.Tags {
+			v.Set(t.Key, t.Value)
+		}
+		input.Tagging = aws.String(v.Encode())
+	}
+	return input
+}
+
+// GenerateBucketObjectObservation returns the observation of an object that
+// was just written with the supplied content.
+func GenerateBucketObjectObservation(out *s3.PutObjectOutput, content []byte) v1alpha3.BucketObjectObservation {
+	return v1alpha3.BucketObjectObservation{
+		ETag:       strings.Trim(aws.StringValue(out.ETag), `"`),
+		ContentMD5: ContentMD5(content),
+		VersionID:  aws.StringValue(out.VersionId),
+	}
+}
+
+// IsBucketObjectUpToDate returns true if the observed object has the desired
+// content and attributes. The content is compared using the ETag; objects
+// encrypted with SSE-KMS do not have an MD5 ETag, so for those the ETag is
+// compared with the one recorded when the controller last wrote the object.
+func IsBucketObjectUpToDate(p v1alpha3.BucketObjectParameters, obs v1alpha3.BucketObjectObservation, content []byte, head *s3.HeadObjectOutput, tags []s3.Tag) bool { // nolint:gocyclo
+	md5sum := ContentMD5(content)
+	etag := strings.Trim(aws.StringValue(head.ETag), `"`)
+	if etag != md5sum && (etag != obs.ETag || obs.ContentMD5 != md5sum) {
+		return false
+	}
+	if p.ContentType != nil && aws.StringValue(p.ContentType) != aws.StringValue(head.ContentType) {
+		return false
+	}
+	if p.CacheControl != nil && aws.StringValue(p.CacheControl) != aws.StringValue(head.CacheControl) {
+		return false
+	}
+	if p.ServerSideEncryption != nil && aws.StringValue(p.ServerSideEncryption) != string(head.ServerSideEncryption) {
+		return false
+	}
+	if p.SSEKMSKeyID != nil && !isKMSKeyEqual(aws.StringValue(p.SSEKMSKeyID), aws.StringValue(head.SSEKMSKeyId)) {
+		return false
+	}
+	if !cmp.Equal(lowerKeys(p.Metadata), head.Metadata, cmpopts.EquateEmpty()) {
+		return false
+	}
+	desired := make(map[string]string, len(p.Tags))
+	for _, t := range p.Tags {
+		desired[t.Key] = t.Value
+	}
+	observed := make(map[string]string, len(tags))
+	for _, t := range tags {
+		observed[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty())
+}
+
+// isKMSKeyEqual returns true if the desired KMS key, given as a key ID, key
+// ARN, alias name or alias ARN, may be the observed one. S3 reports the ARN
+// of the KMS key even if it was specified by its ID or an alias. An alias
+// cannot be resolved without calling KMS, so a key specified by an alias is
+// always considered equal.
+func isKMSKeyEqual(desired, observed string) bool {
+	if strings.HasPrefix(desired, "alias/") || strings.Contains(desired, ":alias/") {
+		return true
+	}
+	return observed == desired || strings.HasSuffix(observed, ":key/"+desired)
+}
+
+// lowerKeys returns a copy of the supplied metadata with lower case keys, the
+// way S3 stores them.
+func lowerKeys(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		out[strings.ToLower(k)] = v
+	}
+	return out
+}
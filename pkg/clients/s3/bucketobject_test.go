@@ -0,0 +1,231 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+var (
+	objectContent = []byte("hello")
+	// echo -n hello | md5sum
+	objectMD5 = "5d41402abc4b2a76b9719d911017c592"
+
+	errBoom = errors.New("boom")
+)
+
+func objectParams(m ...func(*v1alpha3.BucketObjectParameters)) v1alpha3.BucketObjectParameters {
+	p := v1alpha3.BucketObjectParameters{
+		BucketName:  aws.String("bucket"),
+		Key:         "index.html",
+		ContentType: aws.String("text/html"),
+		Metadata:    map[string]string{"Owner": "team"},
+		Tags:        []v1beta1.Tag{{Key: "env", Value: "dev"}},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func TestIsObjectNotFound(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"NotFound": {
+			err:  awserr.New(ObjectNotFoundErrCode, "", nil),
+			want: true,
+		},
+		"NoSuchBucket": {
+			err:  awserr.New(s3.ErrCodeNoSuchBucket, "", nil),
+			want: true,
+		},
+		"OtherError": {
+			err:  awserr.New("AccessDenied", "", nil),
+			want: false,
+		},
+		"NotAWSError": {
+			err:  errBoom,
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsObjectNotFound(tc.err)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePutObjectInput(t *testing.T) {
+	got := GeneratePutObjectInput(objectParams(func(p *v1alpha3.BucketObjectParameters) {
+		p.ServerSideEncryption = aws.String("aws:kms")
+		p.SSEKMSKeyID = aws.String("key")
+	}), objectContent)
+	want := &s3.PutObjectInput{
+		Body:                 bytes.NewReader(objectContent),
+		Bucket:               aws.String("bucket"),
+		ContentMD5:           aws.String("XUFAKrxLKna5cZ2REBfFkg=="),
+		ContentType:          aws.String("text/html"),
+		Key:                  aws.String("index.html"),
+		Metadata:             map[string]string{"owner": "team"},
+		SSEKMSKeyId:          aws.String("key"),
+		ServerSideEncryption: s3.ServerSideEncryptionAwsKms,
+		Tagging:              aws.String("env=dev"),
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(s3.PutObjectInput{}, "Body"), cmpopts.IgnoreUnexported(s3.PutObjectInput{})); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsBucketObjectUpToDate(t *testing.T) {
+	type args struct {
+		p    v1alpha3.BucketObjectParameters
+		obs  v1alpha3.BucketObjectObservation
+		head *s3.HeadObjectOutput
+		tags []s3.Tag
+	}
+	head := func(etag string) *s3.HeadObjectOutput {
+		return &s3.HeadObjectOutput{
+			ETag:        aws.String(`"` + etag + `"`),
+			ContentType: aws.String("text/html"),
+			Metadata:    map[string]string{"owner": "team"},
+		}
+	}
+	withKMSKey := func(h *s3.HeadObjectOutput, key string) *s3.HeadObjectOutput {
+		h.SSEKMSKeyId = aws.String(key)
+		return h
+	}
+	kmsKeyID := "1234abcd-12ab-34cd-56ef-1234567890ab"
+	kmsKeyARN := "arn:aws:kms:us-east-1:123456789012:key/" + kmsKeyID
+	tags := []s3.Tag{{Key: aws.String("env"), Value: aws.String("dev")}}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{p: objectParams(), head: head(objectMD5), tags: tags},
+			want: true,
+		},
+		"ContentChanged": {
+			args: args{p: objectParams(), head: head("0123"), tags: tags},
+			want: false,
+		},
+		"KMSUpToDate": {
+			args: args{
+				p:    objectParams(),
+				obs:  v1alpha3.BucketObjectObservation{ETag: "kms-etag", ContentMD5: objectMD5},
+				head: head("kms-etag"),
+				tags: tags,
+			},
+			want: true,
+		},
+		"KMSContentChanged": {
+			args: args{
+				p:    objectParams(),
+				obs:  v1alpha3.BucketObjectObservation{ETag: "kms-etag", ContentMD5: "0123"},
+				head: head("kms-etag"),
+				tags: tags,
+			},
+			want: false,
+		},
+		"KMSKeyIDUpToDate": {
+			args: args{
+				p:    objectParams(func(p *v1alpha3.BucketObjectParameters) { p.SSEKMSKeyID = aws.String(kmsKeyID) }),
+				head: withKMSKey(head(objectMD5), kmsKeyARN),
+				tags: tags,
+			},
+			want: true,
+		},
+		"KMSKeyARNUpToDate": {
+			args: args{
+				p:    objectParams(func(p *v1alpha3.BucketObjectParameters) { p.SSEKMSKeyID = aws.String(kmsKeyARN) }),
+				head: withKMSKey(head(objectMD5), kmsKeyARN),
+				tags: tags,
+			},
+			want: true,
+		},
+		"KMSAliasUpToDate": {
+			args: args{
+				p:    objectParams(func(p *v1alpha3.BucketObjectParameters) { p.SSEKMSKeyID = aws.String("alias/objects") }),
+				head: withKMSKey(head(objectMD5), kmsKeyARN),
+				tags: tags,
+			},
+			want: true,
+		},
+		"KMSAliasARNUpToDate": {
+			args: args{
+				p: objectParams(func(p *v1alpha3.BucketObjectParameters) {
+					p.SSEKMSKeyID = aws.String("arn:aws:kms:us-east-1:123456789012:alias/objects")
+				}),
+				head: withKMSKey(head(objectMD5), kmsKeyARN),
+				tags: tags,
+			},
+			want: true,
+		},
+		"KMSKeyChanged": {
+			args: args{
+				p:    objectParams(func(p *v1alpha3.BucketObjectParameters) { p.SSEKMSKeyID = aws.String("2222-3333") }),
+				head: withKMSKey(head(objectMD5), kmsKeyARN),
+				tags: tags,
+			},
+			want: false,
+		},
+		"ContentTypeChanged": {
+			args: args{
+				p:    objectParams(func(p *v1alpha3.BucketObjectParameters) { p.ContentType = aws.String("text/plain") }),
+				head: head(objectMD5),
+				tags: tags,
+			},
+			want: false,
+		},
+		"MetadataChanged": {
+			args: args{
+				p:    objectParams(func(p *v1alpha3.BucketObjectParameters) { p.Metadata = nil }),
+				head: head(objectMD5),
+				tags: tags,
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			args: args{p: objectParams(), head: head(objectMD5)},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsBucketObjectUpToDate(tc.args.p, tc.args.obs, objectContent, tc.args.head, tc.args.tags)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
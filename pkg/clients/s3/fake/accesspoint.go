@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/sts"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AccessPointClient = (*MockAccessPointClient)(nil)
+var _ clientset.CallerIdentityClient = (*MockCallerIdentityClient)(nil)
+
+// MockAccessPointClient is a type that implements all the methods for AccessPointClient interface
+type MockAccessPointClient struct {
+	MockCreateAccessPoint       func(*s3control.CreateAccessPointInput) (*s3control.CreateAccessPointOutput, error)
+	MockGetAccessPoint          func(*s3control.GetAccessPointInput) (*s3control.GetAccessPointOutput, error)
+	MockDeleteAccessPoint       func(*s3control.DeleteAccessPointInput) (*s3control.DeleteAccessPointOutput, error)
+	MockGetAccessPointPolicy    func(*s3control.GetAccessPointPolicyInput) (*s3control.GetAccessPointPolicyOutput, error)
+	MockPutAccessPointPolicy    func(*s3control.PutAccessPointPolicyInput) (*s3control.PutAccessPointPolicyOutput, error)
+	MockDeleteAccessPointPolicy func(*s3control.DeleteAccessPointPolicyInput) (*s3control.DeleteAccessPointPolicyOutput, error)
+}
+
+// CreateAccessPointWithContext calls the underlying MockCreateAccessPoint method.
+func (m *MockAccessPointClient) CreateAccessPointWithContext(_ context.Context, input *s3control.CreateAccessPointInput, _ ...request.Option) (*s3control.CreateAccessPointOutput, error) {
+	return m.MockCreateAccessPoint(input)
+}
+
+// GetAccessPointWithContext calls the underlying MockGetAccessPoint method.
+func (m *MockAccessPointClient) GetAccessPointWithContext(_ context.Context, input *s3control.GetAccessPointInput, _ ...request.Option) (*s3control.GetAccessPointOutput, error) {
+	return m.MockGetAccessPoint(input)
+}
+
+// DeleteAccessPointWithContext calls the underlying MockDeleteAccessPoint method.
+func (m *MockAccessPointClient) DeleteAccessPointWithContext(_ context.Context, input *s3control.DeleteAccessPointInput, _ ...request.Option) (*s3control.DeleteAccessPointOutput, error) {
+	return m.MockDeleteAccessPoint(input)
+}
+
+// GetAccessPointPolicyWithContext calls the underlying MockGetAccessPointPolicy method.
+func (m *MockAccessPointClient) GetAccessPointPolicyWithContext(_ context.Context, input *s3control.GetAccessPointPolicyInput, _ ...request.Option) (*s3control.GetAccessPointPolicyOutput, error) {
+	return m.MockGetAccessPointPolicy(input)
+}
+
+// PutAccessPointPolicyWithContext calls the underlying MockPutAccessPointPolicy method.
+func (m *MockAccessPointClient) PutAccessPointPolicyWithContext(_ context.Context, input *s3control.PutAccessPointPolicyInput, _ ...request.Option) (*s3control.PutAccessPointPolicyOutput, error) {
+	return m.MockPutAccessPointPolicy(input)
+}
+
+// DeleteAccessPointPolicyWithContext calls the underlying MockDeleteAccessPointPolicy method.
+func (m *MockAccessPointClient) DeleteAccessPointPolicyWithContext(_ context.Context, input *s3control.DeleteAccessPointPolicyInput, _ ...request.Option) (*s3control.DeleteAccessPointPolicyOutput, error) {
+	return m.MockDeleteAccessPointPolicy(input)
+}
+
+// MockCallerIdentityClient is a type that implements all the methods for CallerIdentityClient interface
+type MockCallerIdentityClient struct {
+	MockGetCallerIdentity func(*sts.GetCallerIdentityInput) (*sts.GetCallerIdentityOutput, error)
+}
+
+// GetCallerIdentityWithContext calls the underlying MockGetCallerIdentity method.
+func (m *MockCallerIdentityClient) GetCallerIdentityWithContext(_ context.Context, input *sts.GetCallerIdentityInput, _ ...request.Option) (*sts.GetCallerIdentityOutput, error) {
+	return m.MockGetCallerIdentity(input)
+}
@@ -17,7 +17,11 @@ limitations under the License.
 package fake
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go/aws/request"
+	s3v1 "github.com/aws/aws-sdk-go/service/s3"
 
 	clientset "github.com/crossplane/provider-aws/pkg/clients/s3"
 )
@@ -31,6 +35,11 @@ type MockBucketClient struct {
 	MockCreateBucketRequest func(input *s3.CreateBucketInput) s3.CreateBucketRequest
 	MockDeleteBucketRequest func(input *s3.DeleteBucketInput) s3.DeleteBucketRequest
 
+	MockListObjectVersionsRequest   func(input *s3.ListObjectVersionsInput) s3.ListObjectVersionsRequest
+	MockDeleteObjectsRequest        func(input *s3.DeleteObjectsInput) s3.DeleteObjectsRequest
+	MockListMultipartUploadsRequest func(input *s3.ListMultipartUploadsInput) s3.ListMultipartUploadsRequest
+	MockAbortMultipartUploadRequest func(input *s3.AbortMultipartUploadInput) s3.AbortMultipartUploadRequest
+
 	MockPutBucketEncryptionRequest    func(input *s3.PutBucketEncryptionInput) s3.PutBucketEncryptionRequest
 	MockGetBucketEncryptionRequest    func(input *s3.GetBucketEncryptionInput) s3.GetBucketEncryptionRequest
 	MockDeleteBucketEncryptionRequest func(input *s3.DeleteBucketEncryptionInput) s3.DeleteBucketEncryptionRequest
@@ -63,8 +72,18 @@ type MockBucketClient struct {
 	MockGetBucketTaggingRequest    func(input *s3.GetBucketTaggingInput) s3.GetBucketTaggingRequest
 	MockDeleteBucketTaggingRequest func(input *s3.DeleteBucketTaggingInput) s3.DeleteBucketTaggingRequest
 
-	MockPutBucketAnalyticsConfigurationRequest func(input *s3.PutBucketAnalyticsConfigurationInput) s3.PutBucketAnalyticsConfigurationRequest
-	MockGetBucketAnalyticsConfigurationRequest func(input *s3.GetBucketAnalyticsConfigurationInput) s3.GetBucketAnalyticsConfigurationRequest
+	MockPutBucketAnalyticsConfigurationRequest    func(input *s3.PutBucketAnalyticsConfigurationInput) s3.PutBucketAnalyticsConfigurationRequest
+	MockGetBucketAnalyticsConfigurationRequest    func(input *s3.GetBucketAnalyticsConfigurationInput) s3.GetBucketAnalyticsConfigurationRequest
+	MockListBucketAnalyticsConfigurationsRequest  func(input *s3.ListBucketAnalyticsConfigurationsInput) s3.ListBucketAnalyticsConfigurationsRequest
+	MockDeleteBucketAnalyticsConfigurationRequest func(input *s3.DeleteBucketAnalyticsConfigurationInput) s3.DeleteBucketAnalyticsConfigurationRequest
+
+	MockPutBucketInventoryConfigurationRequest    func(input *s3.PutBucketInventoryConfigurationInput) s3.PutBucketInventoryConfigurationRequest
+	MockListBucketInventoryConfigurationsRequest  func(input *s3.ListBucketInventoryConfigurationsInput) s3.ListBucketInventoryConfigurationsRequest
+	MockDeleteBucketInventoryConfigurationRequest func(input *s3.DeleteBucketInventoryConfigurationInput) s3.DeleteBucketInventoryConfigurationRequest
+
+	MockPutBucketMetricsConfigurationRequest    func(input *s3.PutBucketMetricsConfigurationInput) s3.PutBucketMetricsConfigurationRequest
+	MockListBucketMetricsConfigurationsRequest  func(input *s3.ListBucketMetricsConfigurationsInput) s3.ListBucketMetricsConfigurationsRequest
+	MockDeleteBucketMetricsConfigurationRequest func(input *s3.DeleteBucketMetricsConfigurationInput) s3.DeleteBucketMetricsConfigurationRequest
 
 	MockPutBucketLifecycleConfigurationRequest func(input *s3.PutBucketLifecycleConfigurationInput) s3.PutBucketLifecycleConfigurationRequest
 	MockGetBucketLifecycleConfigurationRequest func(input *s3.GetBucketLifecycleConfigurationInput) s3.GetBucketLifecycleConfigurationRequest
@@ -79,6 +98,9 @@ type MockBucketClient struct {
 	MockGetPublicAccessBlockRequest    func(*s3.GetPublicAccessBlockInput) s3.GetPublicAccessBlockRequest
 	MockPutPublicAccessBlockRequest    func(*s3.PutPublicAccessBlockInput) s3.PutPublicAccessBlockRequest
 	MockDeletePublicAccessBlockRequest func(*s3.DeletePublicAccessBlockInput) s3.DeletePublicAccessBlockRequest
+
+	MockGetObjectLockConfigurationRequest func(*s3.GetObjectLockConfigurationInput) s3.GetObjectLockConfigurationRequest
+	MockPutObjectLockConfigurationRequest func(*s3.PutObjectLockConfigurationInput) s3.PutObjectLockConfigurationRequest
 }
 
 // HeadBucketRequest is the fake method call to invoke the internal mock method
@@ -96,6 +118,26 @@ func (m MockBucketClient) DeleteBucketRequest(input *s3.DeleteBucketInput) s3.De
 	return m.MockDeleteBucketRequest(input)
 }
 
+// ListObjectVersionsRequest is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListObjectVersionsRequest(input *s3.ListObjectVersionsInput) s3.ListObjectVersionsRequest {
+	return m.MockListObjectVersionsRequest(input)
+}
+
+// DeleteObjectsRequest is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeleteObjectsRequest(input *s3.DeleteObjectsInput) s3.DeleteObjectsRequest {
+	return m.MockDeleteObjectsRequest(input)
+}
+
+// ListMultipartUploadsRequest is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListMultipartUploadsRequest(input *s3.ListMultipartUploadsInput) s3.ListMultipartUploadsRequest {
+	return m.MockListMultipartUploadsRequest(input)
+}
+
+// AbortMultipartUploadRequest is the fake method call to invoke the internal mock method
+func (m MockBucketClient) AbortMultipartUploadRequest(input *s3.AbortMultipartUploadInput) s3.AbortMultipartUploadRequest {
+	return m.MockAbortMultipartUploadRequest(input)
+}
+
 // PutBucketEncryptionRequest is the fake method call to invoke the internal mock method
 func (m MockBucketClient) PutBucketEncryptionRequest(input *s3.PutBucketEncryptionInput) s3.PutBucketEncryptionRequest {
 	return m.MockPutBucketEncryptionRequest(input)
@@ -221,6 +263,46 @@ func (m MockBucketClient) GetBucketAnalyticsConfigurationRequest(input *s3.GetBu
 	return m.MockGetBucketAnalyticsConfigurationRequest(input)
 }
 
+// ListBucketAnalyticsConfigurationsRequest is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListBucketAnalyticsConfigurationsRequest(input *s3.ListBucketAnalyticsConfigurationsInput) s3.ListBucketAnalyticsConfigurationsRequest {
+	return m.MockListBucketAnalyticsConfigurationsRequest(input)
+}
+
+// DeleteBucketAnalyticsConfigurationRequest is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeleteBucketAnalyticsConfigurationRequest(input *s3.DeleteBucketAnalyticsConfigurationInput) s3.DeleteBucketAnalyticsConfigurationRequest {
+	return m.MockDeleteBucketAnalyticsConfigurationRequest(input)
+}
+
+// PutBucketInventoryConfigurationRequest is the fake method call to invoke the internal mock method
+func (m MockBucketClient) PutBucketInventoryConfigurationRequest(input *s3.PutBucketInventoryConfigurationInput) s3.PutBucketInventoryConfigurationRequest {
+	return m.MockPutBucketInventoryConfigurationRequest(input)
+}
+
+// ListBucketInventoryConfigurationsRequest is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListBucketInventoryConfigurationsRequest(input *s3.ListBucketInventoryConfigurationsInput) s3.ListBucketInventoryConfigurationsRequest {
+	return m.MockListBucketInventoryConfigurationsRequest(input)
+}
+
+// DeleteBucketInventoryConfigurationRequest is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeleteBucketInventoryConfigurationRequest(input *s3.DeleteBucketInventoryConfigurationInput) s3.DeleteBucketInventoryConfigurationRequest {
+	return m.MockDeleteBucketInventoryConfigurationRequest(input)
+}
+
+// PutBucketMetricsConfigurationRequest is the fake method call to invoke the internal mock method
+func (m MockBucketClient) PutBucketMetricsConfigurationRequest(input *s3.PutBucketMetricsConfigurationInput) s3.PutBucketMetricsConfigurationRequest {
+	return m.MockPutBucketMetricsConfigurationRequest(input)
+}
+
+// ListBucketMetricsConfigurationsRequest is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListBucketMetricsConfigurationsRequest(input *s3.ListBucketMetricsConfigurationsInput) s3.ListBucketMetricsConfigurationsRequest {
+	return m.MockListBucketMetricsConfigurationsRequest(input)
+}
+
+// DeleteBucketMetricsConfigurationRequest is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeleteBucketMetricsConfigurationRequest(input *s3.DeleteBucketMetricsConfigurationInput) s3.DeleteBucketMetricsConfigurationRequest {
+	return m.MockDeleteBucketMetricsConfigurationRequest(input)
+}
+
 // PutBucketLifecycleConfigurationRequest is the fake method call to invoke the internal mock method
 func (m MockBucketClient) PutBucketLifecycleConfigurationRequest(input *s3.PutBucketLifecycleConfigurationInput) s3.PutBucketLifecycleConfigurationRequest {
 	return m.MockPutBucketLifecycleConfigurationRequest(input)
@@ -270,3 +352,57 @@ func (m MockBucketClient) PutPublicAccessBlockRequest(input *s3.PutPublicAccessB
 func (m MockBucketClient) DeletePublicAccessBlockRequest(input *s3.DeletePublicAccessBlockInput) s3.DeletePublicAccessBlockRequest {
 	return m.MockDeletePublicAccessBlockRequest(input)
 }
+
+// GetObjectLockConfigurationRequest is the fake method call to invoke the internal mock method
+func (m MockBucketClient) GetObjectLockConfigurationRequest(input *s3.GetObjectLockConfigurationInput) s3.GetObjectLockConfigurationRequest {
+	return m.MockGetObjectLockConfigurationRequest(input)
+}
+
+// PutObjectLockConfigurationRequest is the fake method call to invoke the internal mock method
+func (m MockBucketClient) PutObjectLockConfigurationRequest(input *s3.PutObjectLockConfigurationInput) s3.PutObjectLockConfigurationRequest {
+	return m.MockPutObjectLockConfigurationRequest(input)
+}
+
+// this ensures that the mock implements the V1 client interface
+var _ clientset.BucketClientV1 = (*MockBucketClientV1)(nil)
+
+// MockBucketClientV1 is a type that implements all the methods for BucketClientV1 interface
+type MockBucketClientV1 struct {
+	MockGetBucketOwnershipControls    func(*s3v1.GetBucketOwnershipControlsInput) (*s3v1.GetBucketOwnershipControlsOutput, error)
+	MockPutBucketOwnershipControls    func(*s3v1.PutBucketOwnershipControlsInput) (*s3v1.PutBucketOwnershipControlsOutput, error)
+	MockDeleteBucketOwnershipControls func(*s3v1.DeleteBucketOwnershipControlsInput) (*s3v1.DeleteBucketOwnershipControlsOutput, error)
+
+	MockListBucketIntelligentTieringConfigurations  func(*s3v1.ListBucketIntelligentTieringConfigurationsInput) (*s3v1.ListBucketIntelligentTieringConfigurationsOutput, error)
+	MockPutBucketIntelligentTieringConfiguration    func(*s3v1.PutBucketIntelligentTieringConfigurationInput) (*s3v1.PutBucketIntelligentTieringConfigurationOutput, error)
+	MockDeleteBucketIntelligentTieringConfiguration func(*s3v1.DeleteBucketIntelligentTieringConfigurationInput) (*s3v1.DeleteBucketIntelligentTieringConfigurationOutput, error)
+}
+
+// GetBucketOwnershipControlsWithContext is the fake method call to invoke the internal mock method
+func (m MockBucketClientV1) GetBucketOwnershipControlsWithContext(_ context.Context, input *s3v1.GetBucketOwnershipControlsInput, _ ...request.Option) (*s3v1.GetBucketOwnershipControlsOutput, error) {
+	return m.MockGetBucketOwnershipControls(input)
+}
+
+// PutBucketOwnershipControlsWithContext is the fake method call to invoke the internal mock method
+func (m MockBucketClientV1) PutBucketOwnershipControlsWithContext(_ context.Context, input *s3v1.PutBucketOwnershipControlsInput, _ ...request.Option) (*s3v1.PutBucketOwnershipControlsOutput, error) {
+	return m.MockPutBucketOwnershipControls(input)
+}
+
+// DeleteBucketOwnershipControlsWithContext is the fake method call to invoke the internal mock method
+func (m MockBucketClientV1) DeleteBucketOwnershipControlsWithContext(_ context.Context, input *s3v1.DeleteBucketOwnershipControlsInput, _ ...request.Option) (*s3v1.DeleteBucketOwnershipControlsOutput, error) {
+	return m.MockDeleteBucketOwnershipControls(input)
+}
+
+// ListBucketIntelligentTieringConfigurationsWithContext is the fake method call to invoke the internal mock method
+func (m MockBucketClientV1) ListBucketIntelligentTieringConfigurationsWithContext(_ context.Context, input *s3v1.ListBucketIntelligentTieringConfigurationsInput, _ ...request.Option) (*s3v1.ListBucketIntelligentTieringConfigurationsOutput, error) {
+	return m.MockListBucketIntelligentTieringConfigurations(input)
+}
+
+// PutBucketIntelligentTieringConfigurationWithContext is the fake method call to invoke the internal mock method
+func (m MockBucketClientV1) PutBucketIntelligentTieringConfigurationWithContext(_ context.Context, input *s3v1.PutBucketIntelligentTieringConfigurationInput, _ ...request.Option) (*s3v1.PutBucketIntelligentTieringConfigurationOutput, error) {
+	return m.MockPutBucketIntelligentTieringConfiguration(input)
+}
+
+// DeleteBucketIntelligentTieringConfigurationWithContext is the fake method call to invoke the internal mock method
+func (m MockBucketClientV1) DeleteBucketIntelligentTieringConfigurationWithContext(_ context.Context, input *s3v1.DeleteBucketIntelligentTieringConfigurationInput, _ ...request.Option) (*s3v1.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+	return m.MockDeleteBucketIntelligentTieringConfiguration(input)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.BucketObjectClient = (*MockBucketObjectClient)(nil)
+
+// MockBucketObjectClient is a type that implements all the methods for BucketObjectClient interface
+type MockBucketObjectClient struct {
+	MockHeadObjectRequest       func(*s3.HeadObjectInput) s3.HeadObjectRequest
+	MockGetObjectTaggingRequest func(*s3.GetObjectTaggingInput) s3.GetObjectTaggingRequest
+	MockPutObjectRequest        func(*s3.PutObjectInput) s3.PutObjectRequest
+	MockDeleteObjectRequest     func(*s3.DeleteObjectInput) s3.DeleteObjectRequest
+}
+
+// HeadObjectRequest mocks HeadObjectRequest method
+func (m *MockBucketObjectClient) HeadObjectRequest(input *s3.HeadObjectInput) s3.HeadObjectRequest {
+	return m.MockHeadObjectRequest(input)
+}
+
+// GetObjectTaggingRequest mocks GetObjectTaggingRequest method
+func (m *MockBucketObjectClient) GetObjectTaggingRequest(input *s3.GetObjectTaggingInput) s3.GetObjectTaggingRequest {
+	return m.MockGetObjectTaggingRequest(input)
+}
+
+// PutObjectRequest mocks PutObjectRequest method
+func (m *MockBucketObjectClient) PutObjectRequest(input *s3.PutObjectInput) s3.PutObjectRequest {
+	return m.MockPutObjectRequest(input)
+}
+
+// DeleteObjectRequest mocks DeleteObjectRequest method
+func (m *MockBucketObjectClient) DeleteObjectRequest(input *s3.DeleteObjectInput) s3.DeleteObjectRequest {
+	return m.MockDeleteObjectRequest(input)
+}
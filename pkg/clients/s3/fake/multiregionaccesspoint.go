@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3control"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.MultiRegionAccessPointClient = (*MockMultiRegionAccessPointClient)(nil)
+
+// MockMultiRegionAccessPointClient is a type that implements all the methods for MultiRegionAccessPointClient interface
+type MockMultiRegionAccessPointClient struct {
+	MockCreateMultiRegionAccessPoint            func(*s3control.CreateMultiRegionAccessPointInput) (*s3control.CreateMultiRegionAccessPointOutput, error)
+	MockGetMultiRegionAccessPoint               func(*s3control.GetMultiRegionAccessPointInput) (*s3control.GetMultiRegionAccessPointOutput, error)
+	MockDeleteMultiRegionAccessPoint            func(*s3control.DeleteMultiRegionAccessPointInput) (*s3control.DeleteMultiRegionAccessPointOutput, error)
+	MockDescribeMultiRegionAccessPointOperation func(*s3control.DescribeMultiRegionAccessPointOperationInput) (*s3control.DescribeMultiRegionAccessPointOperationOutput, error)
+	MockGetMultiRegionAccessPointPolicy         func(*s3control.GetMultiRegionAccessPointPolicyInput) (*s3control.GetMultiRegionAccessPointPolicyOutput, error)
+	MockPutMultiRegionAccessPointPolicy         func(*s3control.PutMultiRegionAccessPointPolicyInput) (*s3control.PutMultiRegionAccessPointPolicyOutput, error)
+}
+
+// CreateMultiRegionAccessPointWithContext calls the underlying MockCreateMultiRegionAccessPoint method.
+func (m *MockMultiRegionAccessPointClient) CreateMultiRegionAccessPointWithContext(_ context.Context, input *s3control.CreateMultiRegionAccessPointInput, _ ...request.Option) (*s3control.CreateMultiRegionAccessPointOutput, error) {
+	return m.MockCreateMultiRegionAccessPoint(input)
+}
+
+// GetMultiRegionAccessPointWithContext calls the underlying MockGetMultiRegionAccessPoint method.
+func (m *MockMultiRegionAccessPointClient) GetMultiRegionAccessPointWithContext(_ context.Context, input *s3control.GetMultiRegionAccessPointInput, _ ...request.Option) (*s3control.GetMultiRegionAccessPointOutput, error) {
+	return m.MockGetMultiRegionAccessPoint(input)
+}
+
+// DeleteMultiRegionAccessPointWithContext calls the underlying MockDeleteMultiRegionAccessPoint method.
+func (m *MockMultiRegionAccessPointClient) DeleteMultiRegionAccessPointWithContext(_ context.Context, input *s3control.DeleteMultiRegionAccessPointInput, _ ...request.Option) (*s3control.DeleteMultiRegionAccessPointOutput, error) {
+	return m.MockDeleteMultiRegionAccessPoint(input)
+}
+
+// DescribeMultiRegionAccessPointOperationWithContext calls the underlying MockDescribeMultiRegionAccessPointOperation method.
+func (m *MockMultiRegionAccessPointClient) DescribeMultiRegionAccessPointOperationWithContext(_ context.Context, input *s3control.DescribeMultiRegionAccessPointOperationInput, _ ...request.Option) (*s3control.DescribeMultiRegionAccessPointOperationOutput, error) {
+	return m.MockDescribeMultiRegionAccessPointOperation(input)
+}
+
+// GetMultiRegionAccessPointPolicyWithContext calls the underlying MockGetMultiRegionAccessPointPolicy method.
+func (m *MockMultiRegionAccessPointClient) GetMultiRegionAccessPointPolicyWithContext(_ context.Context, input *s3control.GetMultiRegionAccessPointPolicyInput, _ ...request.Option) (*s3control.GetMultiRegionAccessPointPolicyOutput, error) {
+	return m.MockGetMultiRegionAccessPointPolicy(input)
+}
+
+// PutMultiRegionAccessPointPolicyWithContext calls the underlying MockPutMultiRegionAccessPointPolicy method.
+func (m *MockMultiRegionAccessPointClient) PutMultiRegionAccessPointPolicyWithContext(_ context.Context, input *s3control.PutMultiRegionAccessPointPolicyInput, _ ...request.Option) (*s3control.PutMultiRegionAccessPointPolicyOutput, error) {
+	return m.MockPutMultiRegionAccessPointPolicy(input)
+}
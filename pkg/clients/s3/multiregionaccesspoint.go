@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+)
+
+const (
+	// MultiRegionAccessPointNotFoundErrCode is the error code returned by S3
+	// Control when a Multi-Region access point does not exist.
+	MultiRegionAccessPointNotFoundErrCode = "NoSuchMultiRegionAccessPoint"
+
+	// MultiRegionAccessPointControlRegion is the region that every request
+	// to manage Multi-Region access points must be sent to.
+	MultiRegionAccessPointControlRegion = "us-west-2"
+
+	// MultiRegionAccessPointRequestFailed is the status of an asynchronous
+	// Multi-Region access point request that did not succeed.
+	MultiRegionAccessPointRequestFailed = "FAILED"
+	// MultiRegionAccessPointRequestSucceeded is the status of an
+	// asynchronous Multi-Region access point request that succeeded.
+	MultiRegionAccessPointRequestSucceeded = "SUCCEEDED"
+)
+
+// MultiRegionAccessPointClient is the external client used for
+// MultiRegionAccessPoint Custom Resource.
+type MultiRegionAccessPointClient interface {
+	CreateMultiRegionAccessPointWithContext(ctx context.Context, input *s3control.CreateMultiRegionAccessPointInput, opts ...request.Option) (*s3control.CreateMultiRegionAccessPointOutput, error)
+	GetMultiRegionAccessPointWithContext(ctx context.Context, input *s3control.GetMultiRegionAccessPointInput, opts ...request.Option) (*s3control.GetMultiRegionAccessPointOutput, error)
+	DeleteMultiRegionAccessPointWithContext(ctx context.Context, input *s3control.DeleteMultiRegionAccessPointInput, opts ...request.Option) (*s3control.DeleteMultiRegionAccessPointOutput, error)
+	DescribeMultiRegionAccessPointOperationWithContext(ctx context.Context, input *s3control.DescribeMultiRegionAccessPointOperationInput, opts ...request.Option) (*s3control.DescribeMultiRegionAccessPointOperationOutput, error)
+	GetMultiRegionAccessPointPolicyWithContext(ctx context.Context, input *s3control.GetMultiRegionAccessPointPolicyInput, opts ...request.Option) (*s3control.GetMultiRegionAccessPointPolicyOutput, error)
+	PutMultiRegionAccessPointPolicyWithContext(ctx context.Context, input *s3control.PutMultiRegionAccessPointPolicyInput, opts ...request.Option) (*s3control.PutMultiRegionAccessPointPolicyOutput, error)
+}
+
+// NewMultiRegionAccessPointClient returns a new V1 client using the supplied
+// session.
+func NewMultiRegionAccessPointClient(sess *session.Session) MultiRegionAccessPointClient {
+	return s3control.New(sess)
+}
+
+// IsMultiRegionAccessPointNotFound returns true if the error code indicates
+// that the Multi-Region access point was not found
+func IsMultiRegionAccessPointNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == MultiRegionAccessPointNotFoundErrCode {
+		return true
+	}
+	return false
+}
+
+// GenerateCreateMultiRegionAccessPointInput returns the input to create the
+// Multi-Region access point with the supplied name.
+func GenerateCreateMultiRegionAccessPointInput(name string, p v1alpha3.MultiRegionAccessPointParameters) *s3control.CreateMultiRegionAccessPointInput {
+	details := &s3control.CreateMultiRegionAccessPointInput_{
+		Name:    aws.String(name),
+		Regions: make([]*s3control.Region, len(p.Regions)),
+	}
+	for i, r := range p.Regions {
+		details.Regions[i] = &s3control.Region{Bucket: r.BucketName}
+	}
+	if pab := p.PublicAccessBlockConfiguration; pab != nil {
+		details.PublicAccessBlock = &s3control.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       pab.BlockPublicAcls,
+			BlockPublicPolicy:     pab.BlockPublicPolicy,
+			IgnorePublicAcls:      pab.IgnorePublicAcls,
+			RestrictPublicBuckets: pab.RestrictPublicBuckets,
+		}
+	}
+	return &s3control.CreateMultiRegionAccessPointInput{
+		AccountId: p.AccountID,
+		Details:   details,
+	}
+}
+
+// MultiRegionAccessPointARN returns the ARN of the Multi-Region access point
+// with the supplied alias.
+func MultiRegionAccessPointARN(accountID, alias string) string {
+	return fmt.Sprintf("arn:aws:s3::%s:accesspoint/%s", accountID, alias)
+}
+
+// GenerateMultiRegionAccessPointObservation returns the observation of the
+// supplied Multi-Region access point.
+func GenerateMultiRegionAccessPointObservation(p v1alpha3.MultiRegionAccessPointParameters, ap s3control.MultiRegionAccessPointReport) v1alpha3.MultiRegionAccessPointObservation {
+	o := v1alpha3.MultiRegionAccessPointObservation{
+		Alias:  aws.StringValue(ap.Alias),
+		Status: aws.StringValue(ap.Status),
+	}
+	if o.Alias != "" {
+		o.ARN = MultiRegionAccessPointARN(aws.StringValue(p.AccountID), o.Alias)
+	}
+	if ap.CreatedAt != nil {
+		t := metav1.NewTime(*ap.CreatedAt)
+		o.CreatedAt = &t
+	}
+	return o
+}
+
+// FormatMultiRegionAccessPointPolicy returns the JSON policy document of the
+// supplied parameters, or nil if no policy is specified.
+func FormatMultiRegionAccessPointPolicy(p v1alpha3.MultiRegionAccessPointParameters) (*string, error) {
+	return FormatAccessPointPolicy(v1alpha3.AccessPointParameters{RawPolicy: p.RawPolicy, Policy: p.Policy})
+}
+
+// CurrentMultiRegionAccessPointPolicy returns the most recent policy of the
+// supplied policy document. A proposed policy takes precedence over the
+// established one since it is what the Multi-Region access point converges
+// to.
+func CurrentMultiRegionAccessPointPolicy(doc *s3control.MultiRegionAccessPointPolicyDocument) *string {
+	switch {
+	case doc == nil:
+		return nil
+	case doc.Proposed != nil && doc.Proposed.Policy != nil:
+		return doc.Proposed.Policy
+	case doc.Established != nil:
+		return doc.Established.Policy
+	}
+	return nil
+}
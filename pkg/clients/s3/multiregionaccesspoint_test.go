@@ -0,0 +1,189 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+func TestIsMultiRegionAccessPointNotFound(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"NotFound": {
+			err:  awserr.New(MultiRegionAccessPointNotFoundErrCode, "", nil),
+			want: true,
+		},
+		"OtherCode": {
+			err:  awserr.New(AccessPointNotFoundErrCode, "", nil),
+			want: false,
+		},
+		"OtherError": {
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsMultiRegionAccessPointNotFound(tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCreateMultiRegionAccessPointInput(t *testing.T) {
+	cases := map[string]struct {
+		params v1alpha3.MultiRegionAccessPointParameters
+		want   *s3control.CreateMultiRegionAccessPointInput
+	}{
+		"Minimal": {
+			params: v1alpha3.MultiRegionAccessPointParameters{
+				AccountID: aws.String("123456789012"),
+				Regions:   []v1alpha3.MultiRegionAccessPointRegion{{BucketName: aws.String("bucket-a")}},
+			},
+			want: &s3control.CreateMultiRegionAccessPointInput{
+				AccountId: aws.String("123456789012"),
+				Details: &s3control.CreateMultiRegionAccessPointInput_{
+					Name:    aws.String("mrap"),
+					Regions: []*s3control.Region{{Bucket: aws.String("bucket-a")}},
+				},
+			},
+		},
+		"Full": {
+			params: v1alpha3.MultiRegionAccessPointParameters{
+				AccountID: aws.String("123456789012"),
+				Regions: []v1alpha3.MultiRegionAccessPointRegion{
+					{BucketName: aws.String("bucket-a")},
+					{BucketName: aws.String("bucket-b")},
+				},
+				PublicAccessBlockConfiguration: &v1beta1.PublicAccessBlockConfiguration{
+					BlockPublicAcls:   aws.Bool(true),
+					BlockPublicPolicy: aws.Bool(true),
+				},
+			},
+			want: &s3control.CreateMultiRegionAccessPointInput{
+				AccountId: aws.String("123456789012"),
+				Details: &s3control.CreateMultiRegionAccessPointInput_{
+					Name: aws.String("mrap"),
+					Regions: []*s3control.Region{
+						{Bucket: aws.String("bucket-a")},
+						{Bucket: aws.String("bucket-b")},
+					},
+					PublicAccessBlock: &s3control.PublicAccessBlockConfiguration{
+						BlockPublicAcls:   aws.Bool(true),
+						BlockPublicPolicy: aws.Bool(true),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateMultiRegionAccessPointInput("mrap", tc.params)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateMultiRegionAccessPointObservation(t *testing.T) {
+	created := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+	createdTime := metav1.NewTime(created)
+
+	cases := map[string]struct {
+		ap   s3control.MultiRegionAccessPointReport
+		want v1alpha3.MultiRegionAccessPointObservation
+	}{
+		"Empty": {
+			ap:   s3control.MultiRegionAccessPointReport{},
+			want: v1alpha3.MultiRegionAccessPointObservation{},
+		},
+		"Full": {
+			ap: s3control.MultiRegionAccessPointReport{
+				Alias:     aws.String("mfzwi23gnjvgw.mrap"),
+				CreatedAt: &created,
+				Status:    aws.String(s3control.MultiRegionAccessPointStatusReady),
+			},
+			want: v1alpha3.MultiRegionAccessPointObservation{
+				ARN:       "arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap",
+				Alias:     "mfzwi23gnjvgw.mrap",
+				Status:    s3control.MultiRegionAccessPointStatusReady,
+				CreatedAt: &createdTime,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := v1alpha3.MultiRegionAccessPointParameters{AccountID: aws.String("123456789012")}
+			got := GenerateMultiRegionAccessPointObservation(p, tc.ap)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCurrentMultiRegionAccessPointPolicy(t *testing.T) {
+	cases := map[string]struct {
+		doc  *s3control.MultiRegionAccessPointPolicyDocument
+		want *string
+	}{
+		"NoDocument": {
+			want: nil,
+		},
+		"Established": {
+			doc: &s3control.MultiRegionAccessPointPolicyDocument{
+				Established: &s3control.EstablishedMultiRegionAccessPointPolicy{Policy: aws.String("established")},
+			},
+			want: aws.String("established"),
+		},
+		"Proposed": {
+			doc: &s3control.MultiRegionAccessPointPolicyDocument{
+				Established: &s3control.EstablishedMultiRegionAccessPointPolicy{Policy: aws.String("established")},
+				Proposed:    &s3control.ProposedMultiRegionAccessPointPolicy{Policy: aws.String("proposed")},
+			},
+			want: aws.String("proposed"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := CurrentMultiRegionAccessPointPolicy(tc.doc)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	awserrv1 "github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	stsv1 "github.com/aws/aws-sdk-go/service/sts"
+)
+
+const (
+	rateLimitHandlerName = "crossplane.AdaptiveRateLimit"
+	throttleHandlerName  = "crossplane.AdaptiveRateLimitThrottle"
+
+	// minRate is the lowest rate, in calls per second, that a throttled
+	// rate limiter slows down to.
+	minRate = 0.5
+
+	// throttleBeta is the factor that the rate of a rate limiter is
+	// multiplied with every time a call is throttled.
+	throttleBeta = 0.7
+
+	// measureSmoothing is the weight of the latest measurement in the
+	// exponentially weighted moving average of the call rate.
+	measureSmoothing = 0.2
+)
+
+// throttleErrorCodes are the error codes that AWS APIs return when a call is
+// throttled.
+var throttleErrorCodes = map[string]bool{
+	"Throttling":                             true,
+	"ThrottlingException":                    true,
+	"ThrottledException":                     true,
+	"RequestThrottled":                       true,
+	"RequestThrottledException":              true,
+	"RequestLimitExceeded":                   true,
+	"TooManyRequestsException":               true,
+	"ProvisionedThroughputExceededException": true,
+	"TransactionInProgressException":         true,
+	"BandwidthLimitExceeded":                 true,
+	"PriorRequestNotComplete":                true,
+	"EC2ThrottledException":                  true,
+	"SlowDown":                               true,
+}
+
+// rateLimiters holds the rate limiters shared by all controllers. AWS
+// throttles the calls to a service per account and region, so a rate limiter
+// is shared by all the clients that call the same service in the same region
+// of the same account.
+var rateLimiters = &rateLimiterRegistry{limiters: map[rateLimiterKey]*adaptiveRateLimiter{}}
+
+type rateLimiterKey struct {
+	account string
+	region  string
+	service string
+}
+
+type rateLimiterRegistry struct {
+	mu       sync.Mutex
+	limiters map[rateLimiterKey]*adaptiveRateLimiter
+}
+
+func (r *rateLimiterRegistry) get(k rateLimiterKey) *adaptiveRateLimiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, ok := r.limiters[k]
+	if !ok {
+		l = newAdaptiveRateLimiter(time.Now)
+		r.limiters[k] = l
+	}
+	return l
+}
+
+// An adaptiveRateLimiter is a token bucket whose fill rate adapts to the
+// throttling responses of an AWS API. It does not limit the calls until one
+// of them is throttled. Every throttled call then multiplicatively decreases
+// the rate, and every successful call increases it again by roughly one call
+// per second each second, until it no longer limits the calls.
+type adaptiveRateLimiter struct {
+	mu  sync.Mutex
+	now func() time.Time
+
+	enabled bool
+	rate    float64
+	tokens  float64
+	filled  time.Time
+
+	measured      float64
+	count         int
+	measuredSince time.Time
+}
+
+func newAdaptiveRateLimiter(now func() time.Time) *adaptiveRateLimiter {
+	return &adaptiveRateLimiter{now: now, measuredSince: now()}
+}
+
+// Wait blocks until the next call is allowed to be sent or the supplied
+// context is done.
+func (l *adaptiveRateLimiter) Wait(ctx context.Context) error {
+	d := l.reserve()
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// reserve takes a token for the next call and returns how long the call needs
+// to wait for it.
+func (l *adaptiveRateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.measure()
+	if !l.enabled {
+		return 0
+	}
+	l.refill()
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// Throttled slows down the rate limiter after a call was throttled.
+func (l *adaptiveRateLimiter) Throttled() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	base := l.rate
+	if !l.enabled {
+		base = l.measured
+		l.enabled = true
+		l.tokens = 0
+		l.filled = l.now()
+	}
+	l.refill()
+	l.rate = math.Max(minRate, base*throttleBeta)
+	l.tokens = math.Min(l.tokens, l.capacity())
+}
+
+// Succeeded gradually speeds up the rate limiter after a call succeeded.
+func (l *adaptiveRateLimiter) Succeeded() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.enabled {
+		return
+	}
+	l.refill()
+	l.rate += math.Min(1, 1/l.rate)
+	// The rate limiter is disabled once it allows twice as many calls as
+	// are being made, since it then no longer limits them.
+	if l.measured > 0 && l.rate > 2*l.measured {
+		l.enabled = false
+	}
+}
+
+func (l *adaptiveRateLimiter) capacity() float64 {
+	return math.Max(1, l.rate)
+}
+
+func (l *adaptiveRateLimiter) refill() {
+	now := l.now()
+	l.tokens = math.Min(l.capacity(), l.tokens+now.Sub(l.filled).Seconds()*l.rate)
+	l.filled = now
+}
+
+// measure records a call in the moving average of the call rate, which is
+// updated once a second.
+func (l *adaptiveRateLimiter) measure() {
+	l.count++
+	now := l.now()
+	elapsed := now.Sub(l.measuredSince).Seconds()
+	if elapsed < 1 {
+		return
+	}
+	l.measured = measureSmoothing*(float64(l.count)/elapsed) + (1-measureSmoothing)*l.measured
+	l.count = 0
+	l.measuredSince = now
+}
+
+// AddAdaptiveRateLimiting adds the handlers that limit the rate of the calls
+// sent with the supplied handlers to the rate that the AWS APIs of the given
+// account accept without throttling.
+func AddAdaptiveRateLimiting(h *aws.Handlers, account string) {
+	h.Sign.PushFrontNamed(aws.NamedHandler{
+		Name: rateLimitHandlerName,
+		Fn: func(r *aws.Request) {
+			l := rateLimiters.get(rateLimiterKey{account: account, region: r.Config.Region, service: r.Metadata.ServiceID})
+			if err := l.Wait(r.Context()); err != nil {
+				r.Error = &aws.RequestCanceledError{Err: err}
+			}
+		},
+	})
+	h.CompleteAttempt.PushBackNamed(aws.NamedHandler{
+		Name: throttleHandlerName,
+		Fn: func(r *aws.Request) {
+			l := rateLimiters.get(rateLimiterKey{account: account, region: r.Config.Region, service: r.Metadata.ServiceID})
+			err, ok := r.Error.(awserr.Error)
+			switch {
+			case r.Error == nil:
+				l.Succeeded()
+			case ok && throttleErrorCodes[err.Code()]:
+				l.Throttled()
+			}
+		},
+	})
+}
+
+// AddAdaptiveRateLimitingV1 adds the handlers that limit the rate of the
+// calls sent with the supplied V1 handlers to the rate that the AWS APIs of
+// the given account accept without throttling.
+func AddAdaptiveRateLimitingV1(h *request.Handlers, account string) {
+	h.Sign.PushFrontNamed(request.NamedHandler{
+		Name: rateLimitHandlerName,
+		Fn: func(r *request.Request) {
+			l := rateLimiters.get(rateLimiterKey{account: account, region: awsv1.StringValue(r.Config.Region), service: r.ClientInfo.ServiceID})
+			if err := l.Wait(r.Context()); err != nil {
+				r.Error = awserrv1.New(request.CanceledErrorCode, "request context canceled while rate limited", err)
+			}
+		},
+	})
+	h.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: throttleHandlerName,
+		Fn: func(r *request.Request) {
+			l := rateLimiters.get(rateLimiterKey{account: account, region: awsv1.StringValue(r.Config.Region), service: r.ClientInfo.ServiceID})
+			err, ok := r.Error.(awserrv1.Error)
+			switch {
+			case r.Error == nil:
+				l.Succeeded()
+			case ok && throttleErrorCodes[err.Code()]:
+				l.Throttled()
+			}
+		},
+	})
+}
+
+// getAccountID returns the ID of the account that the supplied config
+// authenticates to. If the account cannot be determined, the calls made with
+// the config are limited separately under the name of the ProviderConfig.
+func getAccountID(ctx context.Context, cfg aws.Config, providerConfig string) string {
+	resp, err := sts.New(cfg).GetCallerIdentityRequest(&sts.GetCallerIdentityInput{}).Send(ctx)
+	if err != nil || aws.StringValue(resp.Account) == "" {
+		return "providerconfig/" + providerConfig
+	}
+	return aws.StringValue(resp.Account)
+}
+
+// getAccountIDV1 returns the ID of the account that the supplied session
+// authenticates to. If the account cannot be determined, the calls made with
+// the session are limited separately under the name of the ProviderConfig.
+func getAccountIDV1(ctx context.Context, s *session.Session, providerConfig string) string {
+	resp, err := stsv1.New(s).GetCallerIdentityWithContext(ctx, &stsv1.GetCallerIdentityInput{})
+	if err != nil || awsv1.StringValue(resp.Account) == "" {
+		return "providerconfig/" + providerConfig
+	}
+	return awsv1.StringValue(resp.Account)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/google/go-cmp/cmp"
+)
+
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time { return c.t }
+
+func (c *fakeClock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func TestAdaptiveRateLimiter(t *testing.T) {
+	type want struct {
+		wait    time.Duration
+		enabled bool
+		rate    float64
+	}
+	cases := map[string]struct {
+		reason string
+		steps  func(l *adaptiveRateLimiter, c *fakeClock)
+		want   want
+	}{
+		"NotThrottled": {
+			reason: "Calls should not be limited until one of them is throttled.",
+			steps: func(l *adaptiveRateLimiter, _ *fakeClock) {
+				for i := 0; i < 100; i++ {
+					l.reserve()
+				}
+			},
+			want: want{},
+		},
+		"Throttled": {
+			reason: "A throttled call should enable the rate limiter with a decreased rate.",
+			steps: func(l *adaptiveRateLimiter, _ *fakeClock) {
+				l.Throttled()
+			},
+			want: want{wait: 2 * time.Second, enabled: true, rate: minRate},
+		},
+		"ThrottledWhileLimiting": {
+			reason: "Calls should wait for the tokens reserved by the previous calls.",
+			steps: func(l *adaptiveRateLimiter, _ *fakeClock) {
+				l.Throttled()
+				l.reserve()
+			},
+			want: want{wait: 4 * time.Second, enabled: true, rate: minRate},
+		},
+		"Refilled": {
+			reason: "Tokens should be refilled at the rate of the rate limiter.",
+			steps: func(l *adaptiveRateLimiter, c *fakeClock) {
+				l.Throttled()
+				c.advance(2 * time.Second)
+			},
+			want: want{enabled: true, rate: minRate},
+		},
+		"Recovering": {
+			reason: "A successful call should increase the rate of the rate limiter.",
+			steps: func(l *adaptiveRateLimiter, c *fakeClock) {
+				l.Throttled()
+				l.Succeeded()
+				c.advance(time.Second)
+			},
+			want: want{wait: 0, enabled: true, rate: minRate + 1},
+		},
+		"Recovered": {
+			reason: "The rate limiter should be disabled once it allows twice as many calls as are being made.",
+			steps: func(l *adaptiveRateLimiter, _ *fakeClock) {
+				l.measured = 1
+				l.Throttled()
+				l.Succeeded()
+				l.Succeeded()
+			},
+			want: want{enabled: false, rate: throttleBeta + 1 + 1/(throttleBeta+1)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &fakeClock{t: time.Unix(0, 0)}
+			l := newAdaptiveRateLimiter(c.now)
+			tc.steps(l, c)
+			got := want{wait: l.reserve(), enabled: l.enabled, rate: l.rate}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nreserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAdaptiveRateLimitingHandlers(t *testing.T) {
+	h := aws.Handlers{}
+	AddAdaptiveRateLimiting(&h, "123456789012")
+	r := &aws.Request{
+		Config:       aws.Config{Region: "us-east-1"},
+		Metadata:     aws.Metadata{ServiceID: "EC2"},
+		HTTPRequest:  &http.Request{},
+		HTTPResponse: &http.Response{StatusCode: http.StatusServiceUnavailable},
+		Error:        awserr.New("RequestLimitExceeded", "", nil),
+	}
+	r.SetContext(context.Background())
+	h.CompleteAttempt.Run(r)
+
+	l := rateLimiters.get(rateLimiterKey{account: "123456789012", region: "us-east-1", service: "EC2"})
+	if diff := cmp.Diff(true, l.enabled); diff != "" {
+		t.Errorf("enabled: -want, +got:\n%s", diff)
+	}
+	other := rateLimiters.get(rateLimiterKey{account: "123456789012", region: "eu-west-1", service: "EC2"})
+	if diff := cmp.Diff(false, other.enabled); diff != "" {
+		t.Errorf("enabled: -want, +got:\n%s", diff)
+	}
+}
@@ -68,7 +68,7 @@ func SetupCertificateAuthority(mgr ctrl.Manager, l logging.Logger, rl workqueue.
 		For(&v1alpha1.CertificateAuthority{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.CertificateAuthorityGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewClient}),
+			managed.WithExternalConnecter(awsclient.NewManagementPolicyConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewClient})),
 			managed.WithConnectionPublishers(),
 
 			// TODO: implement tag initializer
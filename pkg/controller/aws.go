@@ -22,6 +22,32 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 
+	acmv1alpha1 "github.com/crossplane/provider-aws/apis/acm/v1alpha1"
+	acmpcav1alpha1 "github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
+	apigatewayv2v1alpha1 "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
+	cachev1beta1 "github.com/crossplane/provider-aws/apis/cache/v1beta1"
+	cloudfrontv1alpha1 "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	databasev1beta1 "github.com/crossplane/provider-aws/apis/database/v1beta1"
+	dynamodbv1alpha1 "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	ecrv1alpha1 "github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	efsv1alpha1 "github.com/crossplane/provider-aws/apis/efs/v1alpha1"
+	eksv1beta1 "github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	elasticloadbalancingv1alpha1 "github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
+	identityv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	kmsv1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	lambdav1alpha1 "github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	notificationv1alpha1 "github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	rdsv1alpha1 "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	redshiftv1alpha1 "github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
+	route53v1alpha1 "github.com/crossplane/provider-aws/apis/route53/v1alpha1"
+	route53resolverv1alpha1 "github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	secretsmanagerv1alpha1 "github.com/crossplane/provider-aws/apis/secretsmanager/v1alpha1"
+	servicediscoveryv1alpha1 "github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+	sfnv1alpha1 "github.com/crossplane/provider-aws/apis/sfn/v1alpha1"
+	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	"github.com/crossplane/provider-aws/pkg/controller/acm"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthority"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthoritypermission"
@@ -48,18 +74,32 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/dynamodb/globaltable"
 	"github.com/crossplane/provider-aws/pkg/controller/dynamodb/table"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/address"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/instance"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/internetgateway"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/launchtemplate"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/natgateway"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/routetable"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/securitygroup"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/subnet"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/transitgateway"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/transitgatewayroute"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/transitgatewayroutetable"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/transitgatewayroutetableassociation"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/transitgatewayroutetablepropagation"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/transitgatewayvpcattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpc"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpccidrblock"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcendpoint"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcpeeringconnection"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcpeeringconnectionaccepter"
 	"github.com/crossplane/provider-aws/pkg/controller/ecr/repository"
 	"github.com/crossplane/provider-aws/pkg/controller/ecr/repositorypolicy"
 	"github.com/crossplane/provider-aws/pkg/controller/efs/filesystem"
 	"github.com/crossplane/provider-aws/pkg/controller/eks"
+	"github.com/crossplane/provider-aws/pkg/controller/eks/addon"
+	"github.com/crossplane/provider-aws/pkg/controller/eks/clusterauth"
 	"github.com/crossplane/provider-aws/pkg/controller/eks/fargateprofile"
+	"github.com/crossplane/provider-aws/pkg/controller/eks/identityproviderconfig"
 	"github.com/crossplane/provider-aws/pkg/controller/eks/nodegroup"
 	"github.com/crossplane/provider-aws/pkg/controller/elasticloadbalancing/elb"
 	"github.com/crossplane/provider-aws/pkg/controller/elasticloadbalancing/elbattachment"
@@ -86,7 +126,10 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/route53resolver/resolverendpoint"
 	"github.com/crossplane/provider-aws/pkg/controller/route53resolver/resolverrule"
 	"github.com/crossplane/provider-aws/pkg/controller/s3"
+	"github.com/crossplane/provider-aws/pkg/controller/s3/accesspoint"
+	"github.com/crossplane/provider-aws/pkg/controller/s3/bucketobject"
 	"github.com/crossplane/provider-aws/pkg/controller/s3/bucketpolicy"
+	"github.com/crossplane/provider-aws/pkg/controller/s3/multiregionaccesspoint"
 	"github.com/crossplane/provider-aws/pkg/controller/secretsmanager/secret"
 	"github.com/crossplane/provider-aws/pkg/controller/servicediscovery/httpnamespace"
 	"github.com/crossplane/provider-aws/pkg/controller/servicediscovery/privatednsnamespace"
@@ -96,50 +139,22 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/sqs/queue"
 )
 
-// Setup creates all AWS controllers with the supplied logger and adds them to
-// the supplied manager.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
-	for _, setup := range []func(ctrl.Manager, logging.Logger, workqueue.RateLimiter) error{
+type setupFunc func(ctrl.Manager, logging.Logger, workqueue.RateLimiter) error
+
+// setups contains the setup functions of all AWS controllers, keyed by the API
+// group of the managed resources they reconcile.
+var setups = map[string][]setupFunc{
+	awsv1beta1.Group: {
 		config.Setup,
-		cache.SetupReplicationGroup,
-		cachesubnetgroup.SetupCacheSubnetGroup,
-		cluster.SetupCacheCluster,
-		database.SetupRDSInstance,
-		eks.SetupCluster,
-		elb.SetupELB,
-		elbattachment.SetupELBAttachment,
-		nodegroup.SetupNodeGroup,
-		s3.SetupBucket,
-		bucketpolicy.SetupBucketPolicy,
-		iamaccesskey.SetupIAMAccessKey,
-		iamuser.SetupIAMUser,
-		iamgroup.SetupIAMGroup,
-		iampolicy.SetupIAMPolicy,
-		iamrole.SetupIAMRole,
-		iamgroupusermembership.SetupIAMGroupUserMembership,
-		iamuserpolicyattachment.SetupIAMUserPolicyAttachment,
-		iamgrouppolicyattachment.SetupIAMGroupPolicyAttachment,
-		iamrolepolicyattachment.SetupIAMRolePolicyAttachment,
-		vpc.SetupVPC,
-		subnet.SetupSubnet,
-		securitygroup.SetupSecurityGroup,
-		internetgateway.SetupInternetGateway,
-		natgateway.SetupNatGateway,
-		routetable.SetupRouteTable,
-		dbsubnetgroup.SetupDBSubnetGroup,
+	},
+	acmv1alpha1.Group: {
+		acm.SetupCertificate,
+	},
+	acmpcav1alpha1.Group: {
 		certificateauthority.SetupCertificateAuthority,
 		certificateauthoritypermission.SetupCertificateAuthorityPermission,
-		acm.SetupCertificate,
-		resourcerecordset.SetupResourceRecordSet,
-		hostedzone.SetupHostedZone,
-		secret.SetupSecret,
-		snstopic.SetupSNSTopic,
-		snssubscription.SetupSubscription,
-		queue.SetupQueue,
-		redshift.SetupCluster,
-		address.SetupAddress,
-		repository.SetupRepository,
-		repositorypolicy.SetupRepositoryPolicy,
+	},
+	apigatewayv2v1alpha1.Group: {
 		api.SetupAPI,
 		stage.SetupStage,
 		route.SetupRoute,
@@ -152,31 +167,155 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 		apimapping.SetupAPIMapping,
 		routeresponse.SetupRouteResponse,
 		vpclink.SetupVPCLink,
-		fargateprofile.SetupFargateProfile,
-		activity.SetupActivity,
-		statemachine.SetupStateMachine,
+	},
+	cachev1beta1.Group: {
+		cache.SetupReplicationGroup,
+		cachesubnetgroup.SetupCacheSubnetGroup,
+		cluster.SetupCacheCluster,
+	},
+	cloudfrontv1alpha1.Group: {
+		distribution.SetupDistribution,
+	},
+	databasev1beta1.Group: {
+		database.SetupRDSInstance,
+		dbsubnetgroup.SetupDBSubnetGroup,
+	},
+	dynamodbv1alpha1.Group: {
 		table.SetupTable,
 		backup.SetupBackup,
 		globaltable.SetupGlobalTable,
-		key.SetupKey,
+	},
+	ec2v1beta1.Group: {
+		vpc.SetupVPC,
+		subnet.SetupSubnet,
+		securitygroup.SetupSecurityGroup,
+		internetgateway.SetupInternetGateway,
+		natgateway.SetupNatGateway,
+		routetable.SetupRouteTable,
+		address.SetupAddress,
+		vpccidrblock.SetupVPCCIDRBlock,
+		instance.SetupInstance,
+		launchtemplate.SetupLaunchTemplate,
+		vpcendpoint.SetupVPCEndpoint,
+		vpcpeeringconnection.SetupVPCPeeringConnection,
+		vpcpeeringconnectionaccepter.SetupVPCPeeringConnectionAccepter,
+		transitgateway.SetupTransitGateway,
+		transitgatewayvpcattachment.SetupTransitGatewayVPCAttachment,
+		transitgatewayroutetable.SetupTransitGatewayRouteTable,
+		transitgatewayroutetableassociation.SetupTransitGatewayRouteTableAssociation,
+		transitgatewayroutetablepropagation.SetupTransitGatewayRouteTablePropagation,
+		transitgatewayroute.SetupTransitGatewayRoute,
+	},
+	ecrv1alpha1.Group: {
+		repository.SetupRepository,
+		repositorypolicy.SetupRepositoryPolicy,
+	},
+	efsv1alpha1.Group: {
 		filesystem.SetupFileSystem,
+	},
+	eksv1beta1.Group: {
+		eks.SetupCluster,
+		nodegroup.SetupNodeGroup,
+		fargateprofile.SetupFargateProfile,
+		addon.SetupAddon,
+		identityproviderconfig.SetupIdentityProviderConfig,
+		clusterauth.SetupClusterAuth,
+	},
+	elasticloadbalancingv1alpha1.Group: {
+		elb.SetupELB,
+		elbattachment.SetupELBAttachment,
+	},
+	identityv1beta1.Group: {
+		iamaccesskey.SetupIAMAccessKey,
+		iamuser.SetupIAMUser,
+		iamgroup.SetupIAMGroup,
+		iampolicy.SetupIAMPolicy,
+		iamrole.SetupIAMRole,
+		iamgroupusermembership.SetupIAMGroupUserMembership,
+		iamuserpolicyattachment.SetupIAMUserPolicyAttachment,
+		iamgrouppolicyattachment.SetupIAMGroupPolicyAttachment,
+		iamrolepolicyattachment.SetupIAMRolePolicyAttachment,
+		openidconnectprovider.SetupOpenIDConnectProvider,
+	},
+	kmsv1alpha1.Group: {
+		key.SetupKey,
+	},
+	lambdav1alpha1.Group: {
+		function.SetupFunction,
+	},
+	notificationv1alpha1.Group: {
+		snstopic.SetupSNSTopic,
+		snssubscription.SetupSubscription,
+	},
+	rdsv1alpha1.Group: {
 		dbcluster.SetupDBCluster,
 		dbparametergroup.SetupDBParameterGroup,
 		globalcluster.SetupGlobalCluster,
-		vpccidrblock.SetupVPCCIDRBlock,
+	},
+	redshiftv1alpha1.Group: {
+		redshift.SetupCluster,
+	},
+	route53v1alpha1.Group: {
+		resourcerecordset.SetupResourceRecordSet,
+		hostedzone.SetupHostedZone,
+	},
+	route53resolverv1alpha1.Group: {
+		resolverendpoint.SetupResolverEndpoint,
+		resolverrule.SetupResolverRule,
+	},
+	s3v1beta1.Group: {
+		s3.SetupBucket,
+		bucketpolicy.SetupBucketPolicy,
+		bucketobject.SetupBucketObject,
+		accesspoint.SetupAccessPoint,
+		multiregionaccesspoint.SetupMultiRegionAccessPoint,
+	},
+	secretsmanagerv1alpha1.Group: {
+		secret.SetupSecret,
+	},
+	servicediscoveryv1alpha1.Group: {
 		privatednsnamespace.SetupPrivateDNSNamespace,
 		publicdnsnamespace.SetupPublicDNSNamespace,
 		httpnamespace.SetupHTTPNamespace,
-		function.SetupFunction,
-		openidconnectprovider.SetupOpenIDConnectProvider,
-		distribution.SetupDistribution,
-		resolverendpoint.SetupResolverEndpoint,
-		resolverrule.SetupResolverRule,
-	} {
-		if err := setup(mgr, l, rl); err != nil {
-			return err
+	},
+	sfnv1alpha1.Group: {
+		activity.SetupActivity,
+		statemachine.SetupStateMachine,
+	},
+	sqsv1beta1.Group: {
+		queue.SetupQueue,
+	},
+}
+
+// Setup creates all AWS controllers with the supplied logger and adds them to
+// the supplied manager.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	return SetupGroups(mgr, l, rl, Groups())
+}
+
+// SetupGroups creates the AWS controllers of the supplied API groups with the
+// supplied logger and adds them to the supplied manager. The controllers of
+// the aws.crossplane.io API group are always created.
+func SetupGroups(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, groups []string) error {
+	enabled := setupsForGroups(groups)
+	for _, g := range Groups() {
+		for _, setup := range enabled[g] {
+			if err := setup(mgr, l, rl); err != nil {
+				return err
+			}
 		}
 	}
-
 	return nil
 }
+
+// setupsForGroups returns the setup functions of the controllers of the
+// supplied API groups and of the aws.crossplane.io API group.
+func setupsForGroups(groups []string) map[string][]setupFunc {
+	enabled := map[string][]setupFunc{awsv1beta1.Group: setups[awsv1beta1.Group]}
+	for _, g := range groups {
+		if fns, ok := setups[g]; ok {
+			enabled[g] = fns
+		}
+	}
+	return enabled
+}
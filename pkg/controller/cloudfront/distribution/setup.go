@@ -55,7 +55,7 @@ func SetupDistribution(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimi
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DistributionGroupVersionKind),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
-			managed.WithExternalConnecter(&connector{
+			managed.WithExternalConnecter(awsclients.NewManagementPolicyConnecter(&connector{
 				kube: mgr.GetClient(),
 				opts: []option{
 					func(e *external) {
@@ -71,7 +71,7 @@ func SetupDistribution(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimi
 						e.postUpdate = postUpdate
 					},
 				},
-			}),
+			})),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
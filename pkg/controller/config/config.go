@@ -20,6 +20,8 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	kevent "sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/crossplane/crossplane-runtime/pkg/event"
@@ -29,6 +31,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 )
 
 // Setup adds a controller that reconciles ProviderConfigs by accounting for
@@ -48,7 +51,18 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 		}).
 		For(&v1beta1.ProviderConfig{}).
 		Watches(&source.Kind{Type: &v1beta1.ProviderConfigUsage{}}, &resource.EnqueueRequestForProviderConfig{}).
+		Watches(&source.Kind{Type: &v1beta1.ProviderConfig{}}, evictConfigsOnDelete()).
 		Complete(providerconfig.NewReconciler(mgr, of,
 			providerconfig.WithLogger(l.WithValues("controller", name)),
 			providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
+
+// evictConfigsOnDelete returns an event handler that evicts the AWS configs
+// cached for a ProviderConfig once it is deleted.
+func evictConfigsOnDelete() handler.EventHandler {
+	return handler.Funcs{
+		DeleteFunc: func(e kevent.DeleteEvent, _ workqueue.RateLimitingInterface) {
+			awsclient.EvictConfigs(e.Object.GetUID())
+		},
+	}
+}
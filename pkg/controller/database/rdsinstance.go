@@ -59,6 +59,7 @@ const (
 // SetupRDSInstance adds a controller that reconciles RDSInstances.
 func SetupRDSInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 	name := managed.ControllerName(v1beta1.RDSInstanceGroupKind)
+	record := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
@@ -68,15 +69,16 @@ func SetupRDSInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimit
 		For(&v1beta1.RDSInstance{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.RDSInstanceGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: rds.NewClient}),
+			managed.WithExternalConnecter(awsclient.NewManagementPolicyConnecter(&connector{kube: mgr.GetClient(), record: record, newClientFn: rds.NewClient})),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithLogger(l.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+			managed.WithRecorder(record)))
 }
 
 type connector struct {
 	kube        client.Client
+	record      event.Recorder
 	newClientFn func(config *aws.Config) rds.Client
 }
 
@@ -89,12 +91,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{c.newClientFn(cfg), c.kube}, nil
+	return &external{client: c.newClientFn(cfg), kube: c.kube, record: c.record}, nil
 }
 
 type external struct {
 	client rds.Client
 	kube   client.Client
+	record event.Recorder
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -139,6 +142,13 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalObservation{}, awsclient.Wrap(err, errUpToDateFailed)
 	}
+	var drifted []string
+	if !upToDate {
+		if drifted, err = rds.GetDriftedFields(&cr.Spec.ForProvider, instance); err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errUpToDateFailed)
+		}
+	}
+	awsclient.RecordDrift(e.record, cr, drifted)
 
 	return managed.ExternalObservation{
 		ResourceExists:    true,
@@ -279,8 +289,9 @@ func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[t.Key] = t.Value
 	}
-	for k, v := range resource.GetExternalTags(mg) {
-		tagMap[k] = v
+	tagMap, err := awsclient.MergeTags(ctx, t.kube, mg, tagMap)
+	if err != nil {
+		return err
 	}
 	cr.Spec.ForProvider.Tags = make([]v1beta1.Tag, len(tagMap))
 	i := 0
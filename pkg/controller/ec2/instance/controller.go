@@ -0,0 +1,396 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an Instance resource"
+	errKubeUpdateFailed = "cannot update Instance custom resource"
+
+	errDescribe             = "failed to describe Instance"
+	errNotSingleItem        = "either no or multiple Instances retrieved for the given instanceId"
+	errDescribeUserData     = "failed to describe the user data of the Instance"
+	errGetUserDataSecret    = "failed to get the user data Secret"
+	errFmtNoUserDataKey     = "user data Secret does not have key %q"
+	errCreate               = "failed to create the Instance resource"
+	errDelete               = "failed to delete the Instance resource"
+	errModifySecurityGroups = "failed to modify the security groups of the Instance"
+	errModifyMetadata       = "failed to modify the metadata options of the Instance"
+	errModifyInstanceType   = "failed to modify the instance type of the Instance"
+	errModifyUserData       = "failed to modify the user data of the Instance"
+	errStop                 = "failed to stop the Instance"
+	errStart                = "failed to start the Instance"
+	errCreateTags           = "failed to create tags for the Instance resource"
+	errDeleteTags           = "failed to delete tags for the Instance resource"
+)
+
+// annotationKeyStoppedForUpdate is set on Instances that the controller
+// stopped to apply a change, so that they are started again even if a
+// previous attempt to start them failed.
+const annotationKeyStoppedForUpdate = "ec2.aws.crossplane.io/stopped-for-update"
+
+// SetupInstance adds a controller that reconciles Instances.
+func SetupInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.InstanceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Instance{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.InstanceGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewManagementPolicyConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewInstanceClient})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.InstanceClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.InstanceClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsInstanceNotFoundErr, err), errDescribe)
+	}
+
+	cr.Status.AtProvider = ec2.GenerateInstanceObservation(*observed)
+	if cr.Status.AtProvider.State == v1alpha1.InstanceStateTerminated {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	userData, currentUserData, err := e.getUserData(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeInstance(&cr.Spec.ForProvider, observed)
+
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.InstanceStateRunning:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.InstanceStatePending:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.InstanceStateShuttingDown:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ec2.IsInstanceUpToDate(cr.Spec.ForProvider, *observed, userData, currentUserData) && !stoppedForUpdate(cr),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails:       ec2.GenerateInstanceConnectionDetails(*observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	userData, err := e.getUserDataFromSecret(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	// The UID of the managed resource is used as the client token so that a
+	// retried launch does not result in a second instance.
+	resp, err := e.client.RunInstancesRequest(ec2.GenerateRunInstancesInput(string(cr.GetUID()), cr.Spec.ForProvider, userData)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	if len(resp.Instances) != 1 {
+		return managed.ExternalCreation{}, errors.New(errNotSingleItem)
+	}
+	meta.SetExternalName(cr, aws.StringValue(resp.Instances[0].InstanceId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+// Update applies the changes that can be made to a running instance. If the
+// instance type or the user data changed, the instance is stopped first, and
+// the change is applied once it is stopped. An instance that was stopped by
+// the controller is started until it is running again.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+	id := aws.String(meta.GetExternalName(cr))
+	p := cr.Spec.ForProvider
+
+	if !ec2.AreSecurityGroupsUpToDate(p.SecurityGroupIDs, observed.SecurityGroups) {
+		if _, err := e.client.ModifyInstanceAttributeRequest(&awsec2.ModifyInstanceAttributeInput{
+			InstanceId: id,
+			Groups:     p.SecurityGroupIDs,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifySecurityGroups)
+		}
+	}
+
+	if !ec2.IsMetadataOptionsUpToDate(p.MetadataOptions, observed.MetadataOptions) {
+		if _, err := e.client.ModifyInstanceMetadataOptionsRequest(&awsec2.ModifyInstanceMetadataOptionsInput{
+			InstanceId:              id,
+			HttpEndpoint:            awsec2.InstanceMetadataEndpointState(aws.StringValue(p.MetadataOptions.HTTPEndpoint)),
+			HttpTokens:              awsec2.HttpTokensState(aws.StringValue(p.MetadataOptions.HTTPTokens)),
+			HttpPutResponseHopLimit: p.MetadataOptions.HTTPPutResponseHopLimit,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyMetadata)
+		}
+	}
+
+	addTags, removeTags := awsclient.DiffEC2Tags(v1beta1.GenerateEC2Tags(p.Tags), observed.Tags)
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{*id},
+			Tags:      removeTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{*id},
+			Tags:      addTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+
+	userData, currentUserData, err := e.getUserData(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	requiresStop := ec2.InstanceRequiresStop(p, *observed, userData, currentUserData)
+
+	switch ec2.GenerateInstanceObservation(*observed).State {
+	case v1alpha1.InstanceStateRunning:
+		if !requiresStop {
+			if !stoppedForUpdate(cr) {
+				return managed.ExternalUpdate{}, nil
+			}
+			meta.RemoveAnnotations(cr, annotationKeyStoppedForUpdate)
+			return managed.ExternalUpdate{}, errors.Wrap(e.kube.Update(ctx, cr), errKubeUpdateFailed)
+		}
+		// The annotation is persisted before the instance is stopped so
+		// that it is started again even if this reconcile is lost.
+		meta.AddAnnotations(cr, map[string]string{annotationKeyStoppedForUpdate: "true"})
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+		_, err := e.client.StopInstancesRequest(&awsec2.StopInstancesInput{InstanceIds: []string{*id}}).Send(ctx)
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errStop)
+	case v1alpha1.InstanceStateStopped:
+	default:
+		// The instance is transitioning between states. The change is
+		// applied once it is stopped.
+		return managed.ExternalUpdate{}, nil
+	}
+
+	if p.InstanceType != string(observed.InstanceType) {
+		if _, err := e.client.ModifyInstanceAttributeRequest(&awsec2.ModifyInstanceAttributeInput{
+			InstanceId:   id,
+			InstanceType: &awsec2.AttributeValue{Value: aws.String(p.InstanceType)},
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyInstanceType)
+		}
+	}
+	if requiresStop && userData != nil {
+		// The SDK base64 encodes blob attributes, so the raw user data is
+		// sent.
+		if _, err := e.client.ModifyInstanceAttributeRequest(&awsec2.ModifyInstanceAttributeInput{
+			InstanceId: id,
+			UserData:   &awsec2.BlobAttributeValue{Value: []byte(*userData)},
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyUserData)
+		}
+	}
+
+	// Instances that were not stopped by the controller are left stopped.
+	if !stoppedForUpdate(cr) {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.StartInstancesRequest(&awsec2.StartInstancesInput{InstanceIds: []string{*id}}).Send(ctx)
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errStart)
+}
+
+// stoppedForUpdate returns true if the supplied Instance was stopped by the
+// controller to apply a change.
+func stoppedForUpdate(cr *v1alpha1.Instance) bool {
+	return cr.GetAnnotations()[annotationKeyStoppedForUpdate] == "true"
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Instance)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.State == v1alpha1.InstanceStateShuttingDown ||
+		cr.Status.AtProvider.State == v1alpha1.InstanceStateTerminated {
+		return nil
+	}
+
+	_, err := e.client.TerminateInstancesRequest(&awsec2.TerminateInstancesInput{
+		InstanceIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+
+	return awsclient.Wrap(resource.Ignore(ec2.IsInstanceNotFoundErr, err), errDelete)
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.Instance) (*awsec2.Instance, error) {
+	resp, err := e.client.DescribeInstancesRequest(&awsec2.DescribeInstancesInput{
+		InstanceIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(resp.Reservations) != 1 || len(resp.Reservations[0].Instances) != 1 {
+		return nil, errors.New(errNotSingleItem)
+	}
+	return &resp.Reservations[0].Instances[0], nil
+}
+
+// getUserData returns the desired raw user data and the base64 encoded user
+// data of the instance. Both are nil if the user data is not managed.
+func (e *external) getUserData(ctx context.Context, cr *v1alpha1.Instance) (*string, *string, error) {
+	userData, err := e.getUserDataFromSecret(ctx, cr)
+	if err != nil || userData == nil {
+		return nil, nil, err
+	}
+	resp, err := e.client.DescribeInstanceAttributeRequest(&awsec2.DescribeInstanceAttributeInput{
+		InstanceId: aws.String(meta.GetExternalName(cr)),
+		Attribute:  awsec2.InstanceAttributeNameUserData,
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, awsclient.Wrap(err, errDescribeUserData)
+	}
+	var current *string
+	if resp.UserData != nil {
+		current = resp.UserData.Value
+	}
+	return userData, current, nil
+}
+
+func (e *external) getUserDataFromSecret(ctx context.Context, cr *v1alpha1.Instance) (*string, error) {
+	ref := cr.Spec.ForProvider.UserDataSecretRef
+	if ref == nil {
+		return nil, nil
+	}
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetUserDataSecret)
+	}
+	v, ok := s.Data[ref.Key]
+	if !ok {
+		return nil, errors.Errorf(errFmtNoUserDataKey, ref.Key)
+	}
+	return aws.String(string(v)), nil
+}
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	tags, err := ec2.MergeTags(ctx, t.kube, mg, cr.Spec.ForProvider.Tags)
+	if err != nil {
+		return err
+	}
+	if tags == nil {
+		return nil
+	}
+	cr.Spec.ForProvider.Tags = tags
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
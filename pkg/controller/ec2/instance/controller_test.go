@@ -0,0 +1,679 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	instanceID   = "i-0123456789abcdef0"
+	instanceType = "t3.micro"
+	privateIP    = "10.0.0.10"
+	sgID         = "sg-1"
+	subnetID     = "subnet-1"
+	userData     = "#!/bin/sh"
+	errBoom      = errors.New("instance boomed")
+)
+
+type instanceModifier func(*v1alpha1.Instance)
+
+func withExternalName(name string) instanceModifier {
+	return func(r *v1alpha1.Instance) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) instanceModifier {
+	return func(r *v1alpha1.Instance) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withInstanceType(t string) instanceModifier {
+	return func(r *v1alpha1.Instance) { r.Spec.ForProvider.InstanceType = t }
+}
+
+func withUserDataSecretRef() instanceModifier {
+	return func(r *v1alpha1.Instance) {
+		r.Spec.ForProvider.UserDataSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: "user-data", Namespace: "default"},
+			Key:             "script",
+		}
+	}
+}
+
+func withState(s string) instanceModifier {
+	return func(r *v1alpha1.Instance) { r.Status.AtProvider.State = s }
+}
+
+func withStatus(s v1alpha1.InstanceObservation) instanceModifier {
+	return func(r *v1alpha1.Instance) { r.Status.AtProvider = s }
+}
+
+func instance(m ...instanceModifier) *v1alpha1.Instance {
+	cr := &v1alpha1.Instance{
+		Spec: v1alpha1.InstanceSpec{
+			ForProvider: v1alpha1.InstanceParameters{
+				ImageID:          "ami-1",
+				InstanceType:     instanceType,
+				SubnetID:         aws.String(subnetID),
+				SecurityGroupIDs: []string{sgID},
+				MetadataOptions: &v1alpha1.InstanceMetadataOptions{
+					HTTPEndpoint:            aws.String("enabled"),
+					HTTPTokens:              aws.String("required"),
+					HTTPPutResponseHopLimit: aws.Int64(1),
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(state awsec2.InstanceStateName) awsec2.Instance {
+	return awsec2.Instance{
+		InstanceId:       aws.String(instanceID),
+		InstanceType:     awsec2.InstanceType(instanceType),
+		SubnetId:         aws.String(subnetID),
+		SecurityGroups:   []awsec2.GroupIdentifier{{GroupId: aws.String(sgID)}},
+		PrivateIpAddress: aws.String(privateIP),
+		State:            &awsec2.InstanceState{Name: state},
+		MetadataOptions: &awsec2.InstanceMetadataOptionsResponse{
+			HttpEndpoint:            awsec2.InstanceMetadataEndpointStateEnabled,
+			HttpTokens:              awsec2.HttpTokensStateRequired,
+			HttpPutResponseHopLimit: aws.Int64(1),
+		},
+	}
+}
+
+func observation(state string) v1alpha1.InstanceObservation {
+	return v1alpha1.InstanceObservation{
+		InstanceID:       instanceID,
+		State:            state,
+		PrivateIPAddress: privateIP,
+	}
+}
+
+func request(err error, data interface{}) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: data}
+}
+
+func describe(i ...awsec2.Instance) func(*awsec2.DescribeInstancesInput) awsec2.DescribeInstancesRequest {
+	return func(*awsec2.DescribeInstancesInput) awsec2.DescribeInstancesRequest {
+		return awsec2.DescribeInstancesRequest{
+			Request: request(nil, &awsec2.DescribeInstancesOutput{Reservations: []awsec2.Reservation{{Instances: i}}}),
+		}
+	}
+}
+
+func describeUserData(v string) func(*awsec2.DescribeInstanceAttributeInput) awsec2.DescribeInstanceAttributeRequest {
+	return func(*awsec2.DescribeInstanceAttributeInput) awsec2.DescribeInstanceAttributeRequest {
+		return awsec2.DescribeInstanceAttributeRequest{
+			Request: request(nil, &awsec2.DescribeInstanceAttributeOutput{UserData: &awsec2.AttributeValue{Value: aws.String(v)}}),
+		}
+	}
+}
+
+func userDataSecret() client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			if key != (types.NamespacedName{Name: "user-data", Namespace: "default"}) {
+				return errBoom
+			}
+			obj.(*corev1.Secret).Data = map[string][]byte{"script": []byte(userData)}
+			return nil
+		},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	instance ec2.InstanceClient
+	kube     client.Client
+	cr       *v1alpha1.Instance
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Instance
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				instance: &fake.MockInstanceClient{},
+				cr:       instance(),
+			},
+			want: want{
+				cr: instance(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe: func(*awsec2.DescribeInstancesInput) awsec2.DescribeInstancesRequest {
+						return awsec2.DescribeInstancesRequest{
+							Request: request(awserr.New(ec2.InstanceIDNotFound, "", nil), nil),
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID)),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe: func(*awsec2.DescribeInstancesInput) awsec2.DescribeInstancesRequest {
+						return awsec2.DescribeInstancesRequest{
+							Request: request(errBoom, nil),
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr:  instance(withExternalName(instanceID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"Terminated": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe: describe(observed(awsec2.InstanceStateNameTerminated)),
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withStatus(observation(v1alpha1.InstanceStateTerminated))),
+			},
+		},
+		"RunningUpToDate": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe:          describe(observed(awsec2.InstanceStateNameRunning)),
+					MockDescribeAttribute: describeUserData("IyEvYmluL3No"),
+				},
+				kube: userDataSecret(),
+				cr:   instance(withExternalName(instanceID), withUserDataSecretRef()),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withUserDataSecretRef(),
+					withStatus(observation(v1alpha1.InstanceStateRunning)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						ec2.InstanceConnectionPrivateIPKey: []byte(privateIP),
+					},
+				},
+			},
+		},
+		"UserDataChanged": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe:          describe(observed(awsec2.InstanceStateNameRunning)),
+					MockDescribeAttribute: describeUserData("b2xk"),
+				},
+				kube: userDataSecret(),
+				cr:   instance(withExternalName(instanceID), withUserDataSecretRef()),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withUserDataSecretRef(),
+					withStatus(observation(v1alpha1.InstanceStateRunning)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						ec2.InstanceConnectionPrivateIPKey: []byte(privateIP),
+					},
+				},
+			},
+		},
+		"StoppedInstanceTypeChanged": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe: describe(observed(awsec2.InstanceStateNameStopped)),
+				},
+				cr: instance(withExternalName(instanceID), withInstanceType("t3.large")),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withInstanceType("t3.large"),
+					withStatus(observation(v1alpha1.InstanceStateStopped)),
+					withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						ec2.InstanceConnectionPrivateIPKey: []byte(privateIP),
+					},
+				},
+			},
+		},
+		"StoppedForUpdate": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe: describe(observed(awsec2.InstanceStateNameStopped)),
+				},
+				cr: instance(withExternalName(instanceID), withStoppedForUpdate()),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withStoppedForUpdate(),
+					withStatus(observation(v1alpha1.InstanceStateStopped)),
+					withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						ec2.InstanceConnectionPrivateIPKey: []byte(privateIP),
+					},
+				},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribe: describe(observed(awsec2.InstanceStateNamePending)),
+				},
+				cr: instance(withExternalName(instanceID), func(r *v1alpha1.Instance) {
+					r.Spec.ForProvider.SecurityGroupIDs = nil
+				}),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID),
+					withStatus(observation(v1alpha1.InstanceStatePending)),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						ec2.InstanceConnectionPrivateIPKey: []byte(privateIP),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.instance}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Instance
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockRun: func(input *awsec2.RunInstancesInput) awsec2.RunInstancesRequest {
+						if aws.StringValue(input.UserData) != "IyEvYmluL3No" {
+							return awsec2.RunInstancesRequest{Request: request(errBoom, nil)}
+						}
+						return awsec2.RunInstancesRequest{
+							Request: request(nil, &awsec2.RunInstancesOutput{Instances: []awsec2.Instance{{InstanceId: aws.String(instanceID)}}}),
+						}
+					},
+				},
+				kube: userDataSecret(),
+				cr:   instance(withUserDataSecretRef()),
+			},
+			want: want{
+				cr:     instance(withUserDataSecretRef(), withExternalName(instanceID)),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"UserDataSecretError": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				cr:   instance(withUserDataSecretRef()),
+			},
+			want: want{
+				cr:  instance(withUserDataSecretRef()),
+				err: errors.Wrap(errBoom, errGetUserDataSecret),
+			},
+		},
+		"CreateError": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockRun: func(*awsec2.RunInstancesInput) awsec2.RunInstancesRequest {
+						return awsec2.RunInstancesRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr:  instance(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.instance}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// calls records the mutating calls made to the instance client.
+type calls []string
+
+func (c *calls) client(i awsec2.Instance) *fake.MockInstanceClient {
+	return &fake.MockInstanceClient{
+		MockDescribe: describe(i),
+		MockStop: func(*awsec2.StopInstancesInput) awsec2.StopInstancesRequest {
+			*c = append(*c, "stop")
+			return awsec2.StopInstancesRequest{Request: request(nil, &awsec2.StopInstancesOutput{})}
+		},
+		MockStart: func(*awsec2.StartInstancesInput) awsec2.StartInstancesRequest {
+			*c = append(*c, "start")
+			return awsec2.StartInstancesRequest{Request: request(nil, &awsec2.StartInstancesOutput{})}
+		},
+		MockModifyAttribute: func(input *awsec2.ModifyInstanceAttributeInput) awsec2.ModifyInstanceAttributeRequest {
+			switch {
+			case input.InstanceType != nil:
+				*c = append(*c, "modify instanceType")
+			case input.UserData != nil:
+				*c = append(*c, "modify userData")
+			case input.Groups != nil:
+				*c = append(*c, "modify groups")
+			}
+			return awsec2.ModifyInstanceAttributeRequest{Request: request(nil, &awsec2.ModifyInstanceAttributeOutput{})}
+		},
+		MockModifyMetadataOptions: func(*awsec2.ModifyInstanceMetadataOptionsInput) awsec2.ModifyInstanceMetadataOptionsRequest {
+			*c = append(*c, "modify metadataOptions")
+			return awsec2.ModifyInstanceMetadataOptionsRequest{Request: request(nil, &awsec2.ModifyInstanceMetadataOptionsOutput{})}
+		},
+		MockCreateTags: func(*awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+			*c = append(*c, "create tags")
+			return awsec2.CreateTagsRequest{Request: request(nil, &awsec2.CreateTagsOutput{})}
+		},
+		MockDeleteTags: func(*awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+			*c = append(*c, "delete tags")
+			return awsec2.DeleteTagsRequest{Request: request(nil, &awsec2.DeleteTagsOutput{})}
+		},
+	}
+}
+
+func (c *calls) kube() client.Client {
+	return &test.MockClient{
+		MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+			*c = append(*c, "update")
+			return nil
+		},
+	}
+}
+
+func withStoppedForUpdate() instanceModifier {
+	return func(r *v1alpha1.Instance) {
+		meta.AddAnnotations(r, map[string]string{annotationKeyStoppedForUpdate: "true"})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr    *v1alpha1.Instance
+		calls calls
+		err   error
+	}
+
+	cases := map[string]struct {
+		observed awsec2.Instance
+		start    error
+		cr       *v1alpha1.Instance
+		want
+	}{
+		"NoStopRequired": {
+			observed: observed(awsec2.InstanceStateNameRunning),
+			cr: instance(withExternalName(instanceID), func(r *v1alpha1.Instance) {
+				r.Spec.ForProvider.SecurityGroupIDs = []string{sgID, "sg-2"}
+				r.Spec.ForProvider.MetadataOptions.HTTPTokens = aws.String("optional")
+			}),
+			want: want{
+				cr: instance(withExternalName(instanceID), func(r *v1alpha1.Instance) {
+					r.Spec.ForProvider.SecurityGroupIDs = []string{sgID, "sg-2"}
+					r.Spec.ForProvider.MetadataOptions.HTTPTokens = aws.String("optional")
+				}),
+				calls: calls{"modify groups", "modify metadataOptions"},
+			},
+		},
+		"StopRunningInstance": {
+			observed: observed(awsec2.InstanceStateNameRunning),
+			cr:       instance(withExternalName(instanceID), withInstanceType("t3.large")),
+			want: want{
+				cr:    instance(withExternalName(instanceID), withInstanceType("t3.large"), withStoppedForUpdate()),
+				calls: calls{"update", "stop"},
+			},
+		},
+		"WaitForStoppingInstance": {
+			observed: observed(awsec2.InstanceStateNameStopping),
+			cr:       instance(withExternalName(instanceID), withInstanceType("t3.large"), withStoppedForUpdate()),
+			want: want{
+				cr: instance(withExternalName(instanceID), withInstanceType("t3.large"), withStoppedForUpdate()),
+			},
+		},
+		"ModifyAndStartStoppedInstance": {
+			observed: observed(awsec2.InstanceStateNameStopped),
+			cr:       instance(withExternalName(instanceID), withInstanceType("t3.large"), withStoppedForUpdate()),
+			want: want{
+				cr:    instance(withExternalName(instanceID), withInstanceType("t3.large"), withStoppedForUpdate()),
+				calls: calls{"modify instanceType", "start"},
+			},
+		},
+		"StartFailed": {
+			observed: observed(awsec2.InstanceStateNameStopped),
+			start:    errBoom,
+			cr:       instance(withExternalName(instanceID), withInstanceType("t3.large"), withStoppedForUpdate()),
+			want: want{
+				cr:    instance(withExternalName(instanceID), withInstanceType("t3.large"), withStoppedForUpdate()),
+				calls: calls{"modify instanceType", "start"},
+				err:   awsclient.Wrap(errBoom, errStart),
+			},
+		},
+		"RetryStartOfModifiedInstance": {
+			observed: observed(awsec2.InstanceStateNameStopped),
+			cr:       instance(withExternalName(instanceID), withStoppedForUpdate()),
+			want: want{
+				cr:    instance(withExternalName(instanceID), withStoppedForUpdate()),
+				calls: calls{"start"},
+			},
+		},
+		"ModifyInstanceStoppedByUser": {
+			observed: observed(awsec2.InstanceStateNameStopped),
+			cr:       instance(withExternalName(instanceID), withInstanceType("t3.large")),
+			want: want{
+				cr:    instance(withExternalName(instanceID), withInstanceType("t3.large")),
+				calls: calls{"modify instanceType"},
+			},
+		},
+		"ClearAnnotationOfStartedInstance": {
+			observed: observed(awsec2.InstanceStateNameRunning),
+			cr:       instance(withExternalName(instanceID), withStoppedForUpdate()),
+			want: want{
+				cr: instance(withExternalName(instanceID), func(r *v1alpha1.Instance) {
+					r.SetAnnotations(map[string]string{meta.AnnotationKeyExternalName: instanceID})
+				}),
+				calls: calls{"update"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var c calls
+			ic := c.client(tc.observed)
+			start := ic.MockStart
+			ic.MockStart = func(in *awsec2.StartInstancesInput) awsec2.StartInstancesRequest {
+				req := start(in)
+				req.Request = request(tc.start, &awsec2.StartInstancesOutput{})
+				return req
+			}
+			e := &external{client: ic, kube: c.kube()}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, c); diff != "" {
+				t.Errorf("calls: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Instance
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockTerminate: func(*awsec2.TerminateInstancesInput) awsec2.TerminateInstancesRequest {
+						return awsec2.TerminateInstancesRequest{Request: request(nil, &awsec2.TerminateInstancesOutput{})}
+					},
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyShuttingDown": {
+			args: args{
+				instance: &fake.MockInstanceClient{},
+				cr:       instance(withExternalName(instanceID), withState(v1alpha1.InstanceStateShuttingDown)),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withState(v1alpha1.InstanceStateShuttingDown), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockTerminate: func(*awsec2.TerminateInstancesInput) awsec2.TerminateInstancesRequest {
+						return awsec2.TerminateInstancesRequest{Request: request(awserr.New(ec2.InstanceIDNotFound, "", nil), nil)}
+					},
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"TerminateError": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockTerminate: func(*awsec2.TerminateInstancesInput) awsec2.TerminateInstancesRequest {
+						return awsec2.TerminateInstancesRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr:  instance(withExternalName(instanceID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.instance}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -43,6 +43,7 @@ import (
 
 const (
 	errUnexpectedObject    = "The managed resource is not an InternetGateway resource"
+	errKubeUpdateFailed    = "cannot update InternetGateway custom resource"
 	errDescribe            = "failed to describe InternetGateway"
 	errNotSingleItem       = "either no or multiple InternetGateways retrieved for the given internetGatewayId"
 	errMultipleAttachments = "multiple Attachments retrieved for the given internetGatewayId"
@@ -66,9 +67,9 @@ func SetupInternetGateway(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateL
 		For(&v1beta1.InternetGateway{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.InternetGatewayGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewInternetGatewayClient}),
+			managed.WithExternalConnecter(awsclient.NewManagementPolicyConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewInternetGatewayClient})),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithConnectionPublishers(),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -239,3 +240,23 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	return awsclient.Wrap(resource.Ignore(ec2.IsInternetGatewayNotFoundErr, err), errDelete)
 }
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.InternetGateway)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	tags, err := ec2.MergeTags(ctx, t.kube, mg, cr.Spec.ForProvider.Tags)
+	if err != nil {
+		return err
+	}
+	if tags == nil {
+		return nil
+	}
+	cr.Spec.ForProvider.Tags = tags
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
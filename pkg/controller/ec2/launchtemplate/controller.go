@@ -0,0 +1,301 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package launchtemplate
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a LaunchTemplate resource"
+	errKubeUpdateFailed = "cannot update LaunchTemplate custom resource"
+
+	errDescribe         = "failed to describe LaunchTemplate"
+	errNotSingleItem    = "either no or multiple LaunchTemplates retrieved for the given name"
+	errDescribeVersions = "failed to describe the versions of the LaunchTemplate"
+	errCreate           = "failed to create the LaunchTemplate resource"
+	errCreateVersion    = "failed to create a version of the LaunchTemplate"
+	errModify           = "failed to modify the default version of the LaunchTemplate"
+	errDeleteVersions   = "failed to delete old versions of the LaunchTemplate"
+	errDelete           = "failed to delete the LaunchTemplate resource"
+	errCreateTags       = "failed to create tags for the LaunchTemplate resource"
+	errDeleteTags       = "failed to delete tags for the LaunchTemplate resource"
+)
+
+// SetupLaunchTemplate adds a controller that reconciles LaunchTemplates.
+func SetupLaunchTemplate(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.LaunchTemplateGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.LaunchTemplate{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LaunchTemplateGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewManagementPolicyConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewLaunchTemplateClient})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.LaunchTemplateClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LaunchTemplate)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.LaunchTemplateClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.LaunchTemplate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	versions, err := e.describeVersions(ctx, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeVersions)
+	}
+
+	cr.Status.AtProvider = ec2.GenerateLaunchTemplateObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsLaunchTemplateUpToDate(cr.Spec.ForProvider, *observed, versions),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.LaunchTemplate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	input := &awsec2.CreateLaunchTemplateInput{
+		ClientToken:        aws.String(string(cr.GetUID())),
+		LaunchTemplateName: aws.String(meta.GetExternalName(cr)),
+		LaunchTemplateData: ec2.GenerateRequestLaunchTemplateData(cr.Spec.ForProvider.LaunchTemplateData),
+	}
+	if len(cr.Spec.ForProvider.Tags) != 0 {
+		input.TagSpecifications = []awsec2.TagSpecification{
+			{
+				ResourceType: awsec2.ResourceTypeLaunchTemplate,
+				Tags:         v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+			},
+		}
+	}
+	_, err := e.client.CreateLaunchTemplateRequest(input).Send(ctx)
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+// Update creates a new version of the launch template if its data changed,
+// makes the latest version the default version, deletes the versions that
+// exceed the retention count and updates the tags of the launch template.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.LaunchTemplate)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalUpdate{}, errors.New(errNotSingleItem)
+	}
+	versions, err := e.describeVersions(ctx, observed)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribeVersions)
+	}
+	p := cr.Spec.ForProvider
+
+	latest := ec2.LatestLaunchTemplateVersion(*observed, versions)
+	if latest == nil || !ec2.IsLaunchTemplateDataUpToDate(p.LaunchTemplateData, latest.LaunchTemplateData) {
+		resp, err := e.client.CreateLaunchTemplateVersionRequest(&awsec2.CreateLaunchTemplateVersionInput{
+			LaunchTemplateId:   observed.LaunchTemplateId,
+			LaunchTemplateData: ec2.GenerateRequestLaunchTemplateData(p.LaunchTemplateData),
+		}).Send(ctx)
+		if err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateVersion)
+		}
+		if resp.LaunchTemplateVersion != nil {
+			versions = append(versions, *resp.LaunchTemplateVersion)
+			observed.LatestVersionNumber = resp.LaunchTemplateVersion.VersionNumber
+		}
+	}
+
+	if aws.Int64Value(observed.DefaultVersionNumber) != aws.Int64Value(observed.LatestVersionNumber) {
+		if _, err := e.client.ModifyLaunchTemplateRequest(&awsec2.ModifyLaunchTemplateInput{
+			LaunchTemplateId: observed.LaunchTemplateId,
+			DefaultVersion:   aws.String(strconv.FormatInt(aws.Int64Value(observed.LatestVersionNumber), 10)),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModify)
+		}
+		for i := range versions {
+			versions[i].DefaultVersion = aws.Bool(aws.Int64Value(versions[i].VersionNumber) == aws.Int64Value(observed.LatestVersionNumber))
+		}
+	}
+
+	if prune := ec2.LaunchTemplateVersionsToPrune(versions, p.VersionRetentionCount); len(prune) != 0 {
+		if _, err := e.client.DeleteLaunchTemplateVersionsRequest(&awsec2.DeleteLaunchTemplateVersionsInput{
+			LaunchTemplateId: observed.LaunchTemplateId,
+			Versions:         prune,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteVersions)
+		}
+	}
+
+	addTags, removeTags := awsclient.DiffEC2Tags(v1beta1.GenerateEC2Tags(p.Tags), observed.Tags)
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{aws.StringValue(observed.LaunchTemplateId)},
+			Tags:      removeTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{aws.StringValue(observed.LaunchTemplateId)},
+			Tags:      addTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.LaunchTemplate)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteLaunchTemplateRequest(&awsec2.DeleteLaunchTemplateInput{
+		LaunchTemplateName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return awsclient.Wrap(resource.Ignore(ec2.IsLaunchTemplateNotFoundErr, err), errDelete)
+}
+
+// describe returns the launch template, or nil if the launch template does
+// not exist.
+func (e *external) describe(ctx context.Context, cr *v1alpha1.LaunchTemplate) (*awsec2.LaunchTemplate, error) {
+	resp, err := e.client.DescribeLaunchTemplatesRequest(&awsec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateNames: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if ec2.IsLaunchTemplateNotFoundErr(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(resp.LaunchTemplates) != 1 {
+		return nil, errors.New(errNotSingleItem)
+	}
+	return &resp.LaunchTemplates[0], nil
+}
+
+// describeVersions returns all versions of the launch template.
+func (e *external) describeVersions(ctx context.Context, t *awsec2.LaunchTemplate) ([]awsec2.LaunchTemplateVersion, error) {
+	var versions []awsec2.LaunchTemplateVersion
+	input := &awsec2.DescribeLaunchTemplateVersionsInput{LaunchTemplateId: t.LaunchTemplateId}
+	for {
+		resp, err := e.client.DescribeLaunchTemplateVersionsRequest(input).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, resp.LaunchTemplateVersions...)
+		if aws.StringValue(resp.NextToken) == "" {
+			return versions, nil
+		}
+		input.NextToken = resp.NextToken
+	}
+}
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.LaunchTemplate)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	tags, err := ec2.MergeTags(ctx, t.kube, mg, cr.Spec.ForProvider.Tags)
+	if err != nil {
+		return err
+	}
+	if tags == nil {
+		return nil
+	}
+	cr.Spec.ForProvider.Tags = tags
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
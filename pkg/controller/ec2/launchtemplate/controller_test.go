@@ -0,0 +1,471 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package launchtemplate
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	templateName = "sample-template"
+	templateID   = "lt-0123456789abcdef0"
+	imageID      = "ami-1"
+	errBoom      = errors.New("launch template boomed")
+)
+
+type templateModifier func(*v1alpha1.LaunchTemplate)
+
+func withConditions(c ...xpv1.Condition) templateModifier {
+	return func(r *v1alpha1.LaunchTemplate) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withInstanceType(t string) templateModifier {
+	return func(r *v1alpha1.LaunchTemplate) { r.Spec.ForProvider.LaunchTemplateData.InstanceType = aws.String(t) }
+}
+
+func withRetention(n int64) templateModifier {
+	return func(r *v1alpha1.LaunchTemplate) { r.Spec.ForProvider.VersionRetentionCount = aws.Int64(n) }
+}
+
+func withStatus(s v1alpha1.LaunchTemplateObservation) templateModifier {
+	return func(r *v1alpha1.LaunchTemplate) { r.Status.AtProvider = s }
+}
+
+func launchTemplate(m ...templateModifier) *v1alpha1.LaunchTemplate {
+	cr := &v1alpha1.LaunchTemplate{
+		Spec: v1alpha1.LaunchTemplateSpec{
+			ForProvider: v1alpha1.LaunchTemplateParameters{
+				LaunchTemplateData: v1alpha1.LaunchTemplateData{
+					ImageID:      aws.String(imageID),
+					InstanceType: aws.String("t3.micro"),
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, templateName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(latest, def int64) awsec2.LaunchTemplate {
+	return awsec2.LaunchTemplate{
+		LaunchTemplateId:     aws.String(templateID),
+		LaunchTemplateName:   aws.String(templateName),
+		LatestVersionNumber:  aws.Int64(latest),
+		DefaultVersionNumber: aws.Int64(def),
+	}
+}
+
+func version(n int64, def bool, instanceType string) awsec2.LaunchTemplateVersion {
+	return awsec2.LaunchTemplateVersion{
+		VersionNumber:  aws.Int64(n),
+		DefaultVersion: aws.Bool(def),
+		LaunchTemplateData: &awsec2.ResponseLaunchTemplateData{
+			ImageId:      aws.String(imageID),
+			InstanceType: awsec2.InstanceType(instanceType),
+		},
+	}
+}
+
+func request(err error, data interface{}) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: data}
+}
+
+func describe(t ...awsec2.LaunchTemplate) func(*awsec2.DescribeLaunchTemplatesInput) awsec2.DescribeLaunchTemplatesRequest {
+	return func(*awsec2.DescribeLaunchTemplatesInput) awsec2.DescribeLaunchTemplatesRequest {
+		return awsec2.DescribeLaunchTemplatesRequest{
+			Request: request(nil, &awsec2.DescribeLaunchTemplatesOutput{LaunchTemplates: t}),
+		}
+	}
+}
+
+func describeVersions(v ...awsec2.LaunchTemplateVersion) func(*awsec2.DescribeLaunchTemplateVersionsInput) awsec2.DescribeLaunchTemplateVersionsRequest {
+	return func(*awsec2.DescribeLaunchTemplateVersionsInput) awsec2.DescribeLaunchTemplateVersionsRequest {
+		return awsec2.DescribeLaunchTemplateVersionsRequest{
+			Request: request(nil, &awsec2.DescribeLaunchTemplateVersionsOutput{LaunchTemplateVersions: v}),
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client ec2.LaunchTemplateClient
+	cr     *v1alpha1.LaunchTemplate
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LaunchTemplate
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockDescribe: func(*awsec2.DescribeLaunchTemplatesInput) awsec2.DescribeLaunchTemplatesRequest {
+						return awsec2.DescribeLaunchTemplatesRequest{
+							Request: request(awserr.New(ec2.LaunchTemplateNotFound, "", nil), nil),
+						}
+					},
+				},
+				cr: launchTemplate(),
+			},
+			want: want{
+				cr: launchTemplate(),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockDescribe: func(*awsec2.DescribeLaunchTemplatesInput) awsec2.DescribeLaunchTemplatesRequest {
+						return awsec2.DescribeLaunchTemplatesRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: launchTemplate(),
+			},
+			want: want{
+				cr:  launchTemplate(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"DescribeVersionsError": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockDescribe: describe(observed(1, 1)),
+					MockDescribeVersions: func(*awsec2.DescribeLaunchTemplateVersionsInput) awsec2.DescribeLaunchTemplateVersionsRequest {
+						return awsec2.DescribeLaunchTemplateVersionsRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: launchTemplate(),
+			},
+			want: want{
+				cr:  launchTemplate(),
+				err: awsclient.Wrap(errBoom, errDescribeVersions),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockDescribe:         describe(observed(2, 2)),
+					MockDescribeVersions: describeVersions(version(1, false, "t3.small"), version(2, true, "t3.micro")),
+				},
+				cr: launchTemplate(),
+			},
+			want: want{
+				cr: launchTemplate(withConditions(xpv1.Available()), withStatus(v1alpha1.LaunchTemplateObservation{
+					LaunchTemplateID:     templateID,
+					LatestVersionNumber:  2,
+					DefaultVersionNumber: 2,
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DataChanged": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockDescribe:         describe(observed(1, 1)),
+					MockDescribeVersions: describeVersions(version(1, true, "t3.micro")),
+				},
+				cr: launchTemplate(withInstanceType("t3.large")),
+			},
+			want: want{
+				cr: launchTemplate(withInstanceType("t3.large"), withConditions(xpv1.Available()), withStatus(v1alpha1.LaunchTemplateObservation{
+					LaunchTemplateID:     templateID,
+					LatestVersionNumber:  1,
+					DefaultVersionNumber: 1,
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LaunchTemplate
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockCreate: func(input *awsec2.CreateLaunchTemplateInput) awsec2.CreateLaunchTemplateRequest {
+						if aws.StringValue(input.LaunchTemplateName) != templateName {
+							return awsec2.CreateLaunchTemplateRequest{Request: request(errBoom, nil)}
+						}
+						return awsec2.CreateLaunchTemplateRequest{Request: request(nil, &awsec2.CreateLaunchTemplateOutput{})}
+					},
+				},
+				cr: launchTemplate(),
+			},
+			want: want{
+				cr: launchTemplate(),
+			},
+		},
+		"CreateError": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockCreate: func(*awsec2.CreateLaunchTemplateInput) awsec2.CreateLaunchTemplateRequest {
+						return awsec2.CreateLaunchTemplateRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: launchTemplate(),
+			},
+			want: want{
+				cr:  launchTemplate(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// calls records the calls that are made to the mock client during an update.
+type calls struct {
+	createVersion  bool
+	defaultVersion string
+	deleted        []string
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		calls calls
+		err   error
+	}
+
+	cases := map[string]struct {
+		args     args
+		versions []awsec2.LaunchTemplateVersion
+		want     want
+	}{
+		"NewVersion": {
+			args: args{
+				cr: launchTemplate(withInstanceType("t3.large"), withRetention(2)),
+			},
+			versions: []awsec2.LaunchTemplateVersion{version(1, false, "t3.small"), version(2, true, "t3.micro")},
+			want: want{
+				calls: calls{createVersion: true, defaultVersion: "3", deleted: []string{"1"}},
+			},
+		},
+		"DefaultVersionOnly": {
+			args: args{
+				cr: launchTemplate(),
+			},
+			versions: []awsec2.LaunchTemplateVersion{version(1, true, "t3.small"), version(2, false, "t3.micro")},
+			want: want{
+				calls: calls{defaultVersion: "2"},
+			},
+		},
+		"CreateVersionError": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockDescribe:         describe(observed(1, 1)),
+					MockDescribeVersions: describeVersions(version(1, true, "t3.micro")),
+					MockCreateVersion: func(*awsec2.CreateLaunchTemplateVersionInput) awsec2.CreateLaunchTemplateVersionRequest {
+						return awsec2.CreateLaunchTemplateVersionRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: launchTemplate(withInstanceType("t3.large")),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errCreateVersion),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := calls{}
+			client := tc.args.client
+			if client == nil {
+				var latest, def int64
+				for _, v := range tc.versions {
+					latest = aws.Int64Value(v.VersionNumber)
+					if aws.BoolValue(v.DefaultVersion) {
+						def = latest
+					}
+				}
+				client = &fake.MockLaunchTemplateClient{
+					MockDescribe:         describe(observed(latest, def)),
+					MockDescribeVersions: describeVersions(tc.versions...),
+					MockCreateVersion: func(*awsec2.CreateLaunchTemplateVersionInput) awsec2.CreateLaunchTemplateVersionRequest {
+						got.createVersion = true
+						v := version(latest+1, false, "t3.large")
+						return awsec2.CreateLaunchTemplateVersionRequest{
+							Request: request(nil, &awsec2.CreateLaunchTemplateVersionOutput{LaunchTemplateVersion: &v}),
+						}
+					},
+					MockModify: func(input *awsec2.ModifyLaunchTemplateInput) awsec2.ModifyLaunchTemplateRequest {
+						got.defaultVersion = aws.StringValue(input.DefaultVersion)
+						return awsec2.ModifyLaunchTemplateRequest{Request: request(nil, &awsec2.ModifyLaunchTemplateOutput{})}
+					},
+					MockDeleteVersions: func(input *awsec2.DeleteLaunchTemplateVersionsInput) awsec2.DeleteLaunchTemplateVersionsRequest {
+						got.deleted = input.Versions
+						return awsec2.DeleteLaunchTemplateVersionsRequest{Request: request(nil, &awsec2.DeleteLaunchTemplateVersionsOutput{})}
+					},
+				}
+			}
+			e := &external{client: client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, got, cmp.AllowUnexported(calls{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.LaunchTemplate
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockDelete: func(*awsec2.DeleteLaunchTemplateInput) awsec2.DeleteLaunchTemplateRequest {
+						return awsec2.DeleteLaunchTemplateRequest{Request: request(nil, &awsec2.DeleteLaunchTemplateOutput{})}
+					},
+				},
+				cr: launchTemplate(),
+			},
+			want: want{
+				cr: launchTemplate(withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockDelete: func(*awsec2.DeleteLaunchTemplateInput) awsec2.DeleteLaunchTemplateRequest {
+						return awsec2.DeleteLaunchTemplateRequest{Request: request(awserr.New(ec2.LaunchTemplateNotFound, "", nil), nil)}
+					},
+				},
+				cr: launchTemplate(),
+			},
+			want: want{
+				cr: launchTemplate(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockDelete: func(*awsec2.DeleteLaunchTemplateInput) awsec2.DeleteLaunchTemplateRequest {
+						return awsec2.DeleteLaunchTemplateRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: launchTemplate(),
+			},
+			want: want{
+				cr:  launchTemplate(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
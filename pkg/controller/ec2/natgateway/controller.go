@@ -26,6 +26,7 @@ import (
 
 const (
 	errUnexpectedObject = "The managed resource is not an NATGateway resource"
+	errKubeUpdateFailed = "cannot update NATGateway custom resource"
 	errDescribe         = "failed to describe NATGateway"
 	errNotSingleItem    = "either no or multiple NATGateways retrieved for the given natGatewayId"
 	errCreate           = "failed to create the NATGateway resource"
@@ -46,9 +47,9 @@ func SetupNatGateway(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 		For(&v1beta1.NATGateway{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.NATGatewayGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewNatGatewayClient}),
+			managed.WithExternalConnecter(awsclient.NewManagementPolicyConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewNatGatewayClient})),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithConnectionPublishers(),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -206,3 +207,23 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	return awsclient.Wrap(resource.Ignore(ec2.IsNatGatewayNotFoundErr, err), errDelete)
 }
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.NATGateway)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	tags, err := ec2.MergeTags(ctx, t.kube, mg, cr.Spec.ForProvider.Tags)
+	if err != nil {
+		return err
+	}
+	if tags == nil {
+		return nil
+	}
+	cr.Spec.ForProvider.Tags = tags
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
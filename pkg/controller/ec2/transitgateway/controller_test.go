@@ -0,0 +1,433 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgateway
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	tgwID   = "tgw-0123456789abcdef0"
+	errBoom = errors.New("transit gateway boomed")
+)
+
+type tgwModifier func(*v1beta1.TransitGateway)
+
+func withExternalName(name string) tgwModifier {
+	return func(r *v1beta1.TransitGateway) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) tgwModifier {
+	return func(r *v1beta1.TransitGateway) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1beta1.TransitGatewayParameters) tgwModifier {
+	return func(r *v1beta1.TransitGateway) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1beta1.TransitGatewayObservation) tgwModifier {
+	return func(r *v1beta1.TransitGateway) { r.Status.AtProvider = s }
+}
+
+func transitGateway(m ...tgwModifier) *v1beta1.TransitGateway {
+	cr := &v1beta1.TransitGateway{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func request(err error, data interface{}) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: data}
+}
+
+func describe(tgw ...awsec2.TransitGateway) func(*awsec2.DescribeTransitGatewaysInput) awsec2.DescribeTransitGatewaysRequest {
+	return func(*awsec2.DescribeTransitGatewaysInput) awsec2.DescribeTransitGatewaysRequest {
+		return awsec2.DescribeTransitGatewaysRequest{
+			Request: request(nil, &awsec2.DescribeTransitGatewaysOutput{TransitGateways: tgw}),
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client ec2.TransitGatewayClient
+	cr     *v1beta1.TransitGateway
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.TransitGateway
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{},
+				cr:     transitGateway(),
+			},
+			want: want{
+				cr: transitGateway(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribe: func(*awsec2.DescribeTransitGatewaysInput) awsec2.DescribeTransitGatewaysRequest {
+						return awsec2.DescribeTransitGatewaysRequest{
+							Request: request(awserr.New(ec2.TransitGatewayIDNotFound, "", nil), nil),
+						}
+					},
+				},
+				cr: transitGateway(withExternalName(tgwID)),
+			},
+			want: want{
+				cr: transitGateway(withExternalName(tgwID)),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribe: func(*awsec2.DescribeTransitGatewaysInput) awsec2.DescribeTransitGatewaysRequest {
+						return awsec2.DescribeTransitGatewaysRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: transitGateway(withExternalName(tgwID)),
+			},
+			want: want{
+				cr:  transitGateway(withExternalName(tgwID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribe: describe(awsec2.TransitGateway{
+						TransitGatewayId: aws.String(tgwID),
+						State:            awsec2.TransitGatewayStateDeleted,
+					}),
+				},
+				cr: transitGateway(withExternalName(tgwID)),
+			},
+			want: want{
+				cr: transitGateway(withExternalName(tgwID), withStatus(v1beta1.TransitGatewayObservation{
+					TransitGatewayID: tgwID,
+					State:            v1beta1.TransitGatewayStateDeleted,
+				})),
+			},
+		},
+		"AvailableAndLateInitialized": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribe: describe(awsec2.TransitGateway{
+						TransitGatewayId: aws.String(tgwID),
+						State:            awsec2.TransitGatewayStateAvailable,
+						Options: &awsec2.TransitGatewayOptions{
+							DnsSupport: awsec2.DnsSupportValueEnable,
+						},
+					}),
+				},
+				cr: transitGateway(withExternalName(tgwID)),
+			},
+			want: want{
+				cr: transitGateway(withExternalName(tgwID),
+					withSpec(v1beta1.TransitGatewayParameters{DNSSupport: aws.String("enable")}),
+					withConditions(xpv1.Available()),
+					withStatus(v1beta1.TransitGatewayObservation{
+						TransitGatewayID: tgwID,
+						State:            v1beta1.TransitGatewayStateAvailable,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"TagsOutdated": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribe: describe(awsec2.TransitGateway{
+						TransitGatewayId: aws.String(tgwID),
+						State:            awsec2.TransitGatewayStatePending,
+					}),
+				},
+				cr: transitGateway(withExternalName(tgwID), withSpec(v1beta1.TransitGatewayParameters{
+					Tags: []v1beta1.Tag{{Key: "k", Value: "v"}},
+				})),
+			},
+			want: want{
+				cr: transitGateway(withExternalName(tgwID),
+					withSpec(v1beta1.TransitGatewayParameters{
+						Tags: []v1beta1.Tag{{Key: "k", Value: "v"}},
+					}),
+					withConditions(xpv1.Creating()),
+					withStatus(v1beta1.TransitGatewayObservation{
+						TransitGatewayID: tgwID,
+						State:            v1beta1.TransitGatewayStatePending,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.TransitGateway
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockCreate: func(*awsec2.CreateTransitGatewayInput) awsec2.CreateTransitGatewayRequest {
+						return awsec2.CreateTransitGatewayRequest{
+							Request: request(nil, &awsec2.CreateTransitGatewayOutput{
+								TransitGateway: &awsec2.TransitGateway{TransitGatewayId: aws.String(tgwID)},
+							}),
+						}
+					},
+				},
+				cr: transitGateway(),
+			},
+			want: want{
+				cr:     transitGateway(withExternalName(tgwID)),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockCreate: func(*awsec2.CreateTransitGatewayInput) awsec2.CreateTransitGatewayRequest {
+						return awsec2.CreateTransitGatewayRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: transitGateway(),
+			},
+			want: want{
+				cr:  transitGateway(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SyncTags": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribe: describe(awsec2.TransitGateway{
+						TransitGatewayId: aws.String(tgwID),
+						Tags:             []awsec2.Tag{{Key: aws.String("old"), Value: aws.String("v")}},
+					}),
+					MockDeleteTags: func(*awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+						return awsec2.DeleteTagsRequest{Request: request(nil, &awsec2.DeleteTagsOutput{})}
+					},
+					MockCreateTags: func(*awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{Request: request(nil, &awsec2.CreateTagsOutput{})}
+					},
+				},
+				cr: transitGateway(withExternalName(tgwID), withSpec(v1beta1.TransitGatewayParameters{
+					Tags: []v1beta1.Tag{{Key: "new", Value: "v"}},
+				})),
+			},
+		},
+		"CreateTagsFailed": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribe: describe(awsec2.TransitGateway{TransitGatewayId: aws.String(tgwID)}),
+					MockCreateTags: func(*awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: transitGateway(withExternalName(tgwID), withSpec(v1beta1.TransitGatewayParameters{
+					Tags: []v1beta1.Tag{{Key: "new", Value: "v"}},
+				})),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errCreateTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.TransitGateway
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDelete: func(*awsec2.DeleteTransitGatewayInput) awsec2.DeleteTransitGatewayRequest {
+						return awsec2.DeleteTransitGatewayRequest{Request: request(nil, &awsec2.DeleteTransitGatewayOutput{})}
+					},
+				},
+				cr: transitGateway(withExternalName(tgwID)),
+			},
+			want: want{
+				cr: transitGateway(withExternalName(tgwID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{},
+				cr: transitGateway(withExternalName(tgwID), withStatus(v1beta1.TransitGatewayObservation{
+					State: v1beta1.TransitGatewayStateDeleting,
+				})),
+			},
+			want: want{
+				cr: transitGateway(withExternalName(tgwID), withConditions(xpv1.Deleting()),
+					withStatus(v1beta1.TransitGatewayObservation{State: v1beta1.TransitGatewayStateDeleting})),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDelete: func(*awsec2.DeleteTransitGatewayInput) awsec2.DeleteTransitGatewayRequest {
+						return awsec2.DeleteTransitGatewayRequest{
+							Request: request(awserr.New(ec2.TransitGatewayIDNotFound, "", nil), nil),
+						}
+					},
+				},
+				cr: transitGateway(withExternalName(tgwID)),
+			},
+			want: want{
+				cr: transitGateway(withExternalName(tgwID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDelete: func(*awsec2.DeleteTransitGatewayInput) awsec2.DeleteTransitGatewayRequest {
+						return awsec2.DeleteTransitGatewayRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: transitGateway(withExternalName(tgwID)),
+			},
+			want: want{
+				cr:  transitGateway(withExternalName(tgwID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayroute
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a TransitGatewayRoute resource"
+
+	errDescribe = "failed to search the routes of the TransitGatewayRouteTable"
+	errCreate   = "failed to create the TransitGatewayRoute resource"
+	errReplace  = "failed to replace the TransitGatewayRoute resource"
+	errDelete   = "failed to delete the TransitGatewayRoute resource"
+)
+
+// SetupTransitGatewayRoute adds a controller that reconciles
+// TransitGatewayRoutes.
+func SetupTransitGatewayRoute(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1beta1.TransitGatewayRouteGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1beta1.TransitGatewayRoute{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.TransitGatewayRouteGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewManagementPolicyConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewTransitGatewayRouteClient})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.TransitGatewayRouteClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.TransitGatewayRoute)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.TransitGatewayRouteClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1beta1.TransitGatewayRoute)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The route has no ID of its own and is identified by the route table
+	// and the destination.
+	if aws.StringValue(cr.Spec.ForProvider.TransitGatewayRouteTableID) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	resp, err := e.client.SearchTransitGatewayRoutesRequest(ec2.GenerateSearchTransitGatewayRoutesInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsTransitGatewayRouteNotFoundErr, err), errDescribe)
+	}
+	if len(resp.Routes) == 0 {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+	observed := resp.Routes[0]
+
+	cr.Status.AtProvider = ec2.GenerateTransitGatewayRouteObservation(observed)
+
+	switch cr.Status.AtProvider.State {
+	case v1beta1.TransitGatewayRouteStateActive:
+		cr.SetConditions(xpv1.Available())
+	case v1beta1.TransitGatewayRouteStateBlackhole:
+		// A route becomes a blackhole route if its attachment is deleted.
+		if aws.BoolValue(cr.Spec.ForProvider.Blackhole) {
+			cr.SetConditions(xpv1.Available())
+		} else {
+			cr.SetConditions(xpv1.Unavailable())
+		}
+	case v1beta1.TransitGatewayRouteStatePending:
+		cr.SetConditions(xpv1.Creating())
+	case v1beta1.TransitGatewayRouteStateDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	case v1beta1.TransitGatewayRouteStateDeleted:
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsTransitGatewayRouteUpToDate(cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1beta1.TransitGatewayRoute)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.CreateTransitGatewayRouteRequest(ec2.GenerateCreateTransitGatewayRouteInput(cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1beta1.TransitGatewayRoute)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.ReplaceTransitGatewayRouteRequest(ec2.GenerateReplaceTransitGatewayRouteInput(cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errReplace)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1beta1.TransitGatewayRoute)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.State == v1beta1.TransitGatewayRouteStateDeleting {
+		return nil
+	}
+
+	_, err := e.client.DeleteTransitGatewayRouteRequest(&awsec2.DeleteTransitGatewayRouteInput{
+		TransitGatewayRouteTableId: cr.Spec.ForProvider.TransitGatewayRouteTableID,
+		DestinationCidrBlock:       aws.String(cr.Spec.ForProvider.DestinationCIDRBlock),
+	}).Send(ctx)
+	return awsclient.Wrap(resource.Ignore(ec2.IsTransitGatewayRouteNotFoundErr, err), errDelete)
+}
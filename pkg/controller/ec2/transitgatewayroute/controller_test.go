@@ -0,0 +1,383 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayroute
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	rtbID        = "tgw-rtb-0123456789abcdef0"
+	attachmentID = "tgw-attach-0123456789abcdef0"
+	cidr         = "10.10.0.0/16"
+	errBoom      = errors.New("transit gateway route boomed")
+)
+
+type routeModifier func(*v1beta1.TransitGatewayRoute)
+
+func withConditions(c ...xpv1.Condition) routeModifier {
+	return func(r *v1beta1.TransitGatewayRoute) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withBlackhole() routeModifier {
+	return func(r *v1beta1.TransitGatewayRoute) {
+		r.Spec.ForProvider.Blackhole = aws.Bool(true)
+		r.Spec.ForProvider.TransitGatewayAttachmentID = nil
+	}
+}
+
+func withStatus(s v1beta1.TransitGatewayRouteObservation) routeModifier {
+	return func(r *v1beta1.TransitGatewayRoute) { r.Status.AtProvider = s }
+}
+
+func route(m ...routeModifier) *v1beta1.TransitGatewayRoute {
+	cr := &v1beta1.TransitGatewayRoute{
+		Spec: v1beta1.TransitGatewayRouteSpec{
+			ForProvider: v1beta1.TransitGatewayRouteParameters{
+				DestinationCIDRBlock:       cidr,
+				TransitGatewayRouteTableID: aws.String(rtbID),
+				TransitGatewayAttachmentID: aws.String(attachmentID),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func request(err error, data interface{}) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: data}
+}
+
+func search(r ...awsec2.TransitGatewayRoute) func(*awsec2.SearchTransitGatewayRoutesInput) awsec2.SearchTransitGatewayRoutesRequest {
+	return func(*awsec2.SearchTransitGatewayRoutesInput) awsec2.SearchTransitGatewayRoutesRequest {
+		return awsec2.SearchTransitGatewayRoutesRequest{
+			Request: request(nil, &awsec2.SearchTransitGatewayRoutesOutput{Routes: r}),
+		}
+	}
+}
+
+func observed(state awsec2.TransitGatewayRouteState, attachment string) awsec2.TransitGatewayRoute {
+	r := awsec2.TransitGatewayRoute{
+		DestinationCidrBlock: aws.String(cidr),
+		State:                state,
+		Type:                 awsec2.TransitGatewayRouteTypeStatic,
+	}
+	if attachment != "" {
+		r.TransitGatewayAttachments = []awsec2.TransitGatewayRouteAttachment{
+			{TransitGatewayAttachmentId: aws.String(attachment)},
+		}
+	}
+	return r
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client ec2.TransitGatewayRouteClient
+	cr     *v1beta1.TransitGatewayRoute
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.TransitGatewayRoute
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"RouteTableNotFound": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteClient{
+					MockSearch: func(*awsec2.SearchTransitGatewayRoutesInput) awsec2.SearchTransitGatewayRoutesRequest {
+						return awsec2.SearchTransitGatewayRoutesRequest{
+							Request: request(awserr.New(ec2.RouteTableIDNotFound, "", nil), nil),
+						}
+					},
+				},
+				cr: route(),
+			},
+			want: want{
+				cr: route(),
+			},
+		},
+		"SearchError": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteClient{
+					MockSearch: func(*awsec2.SearchTransitGatewayRoutesInput) awsec2.SearchTransitGatewayRoutesRequest {
+						return awsec2.SearchTransitGatewayRoutesRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: route(),
+			},
+			want: want{
+				cr:  route(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"NoRoute": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteClient{
+					MockSearch: search(),
+				},
+				cr: route(),
+			},
+			want: want{
+				cr: route(),
+			},
+		},
+		"Active": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteClient{
+					MockSearch: search(observed(awsec2.TransitGatewayRouteStateActive, attachmentID)),
+				},
+				cr: route(),
+			},
+			want: want{
+				cr: route(withConditions(xpv1.Available()), withStatus(v1beta1.TransitGatewayRouteObservation{
+					State: v1beta1.TransitGatewayRouteStateActive,
+					Type:  "static",
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AttachmentChanged": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteClient{
+					MockSearch: search(observed(awsec2.TransitGatewayRouteStateActive, "tgw-attach-other")),
+				},
+				cr: route(),
+			},
+			want: want{
+				cr: route(withConditions(xpv1.Available()), withStatus(v1beta1.TransitGatewayRouteObservation{
+					State: v1beta1.TransitGatewayRouteStateActive,
+					Type:  "static",
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"DesiredBlackhole": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteClient{
+					MockSearch: search(observed(awsec2.TransitGatewayRouteStateBlackhole, "")),
+				},
+				cr: route(withBlackhole()),
+			},
+			want: want{
+				cr: route(withBlackhole(), withConditions(xpv1.Available()), withStatus(v1beta1.TransitGatewayRouteObservation{
+					State: v1beta1.TransitGatewayRouteStateBlackhole,
+					Type:  "static",
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AttachmentDeleted": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteClient{
+					MockSearch: search(observed(awsec2.TransitGatewayRouteStateBlackhole, "")),
+				},
+				cr: route(),
+			},
+			want: want{
+				cr: route(withConditions(xpv1.Unavailable()), withStatus(v1beta1.TransitGatewayRouteObservation{
+					State: v1beta1.TransitGatewayRouteStateBlackhole,
+					Type:  "static",
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		input *awsec2.ReplaceTransitGatewayRouteInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cr: route(),
+			},
+			want: want{
+				input: &awsec2.ReplaceTransitGatewayRouteInput{
+					TransitGatewayRouteTableId: aws.String(rtbID),
+					DestinationCidrBlock:       aws.String(cidr),
+					TransitGatewayAttachmentId: aws.String(attachmentID),
+				},
+			},
+		},
+		"ReplaceFailed": {
+			args: args{
+				cr: route(withBlackhole()),
+			},
+			want: want{
+				input: &awsec2.ReplaceTransitGatewayRouteInput{
+					TransitGatewayRouteTableId: aws.String(rtbID),
+					DestinationCidrBlock:       aws.String(cidr),
+					Blackhole:                  aws.Bool(true),
+				},
+				err: awsclient.Wrap(errBoom, errReplace),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsec2.ReplaceTransitGatewayRouteInput
+			e := &external{client: &fake.MockTransitGatewayRouteClient{
+				MockReplace: func(i *awsec2.ReplaceTransitGatewayRouteInput) awsec2.ReplaceTransitGatewayRouteRequest {
+					input = i
+					if tc.want.err != nil {
+						return awsec2.ReplaceTransitGatewayRouteRequest{Request: request(errBoom, nil)}
+					}
+					return awsec2.ReplaceTransitGatewayRouteRequest{Request: request(nil, &awsec2.ReplaceTransitGatewayRouteOutput{})}
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.TransitGatewayRoute
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteClient{
+					MockDelete: func(*awsec2.DeleteTransitGatewayRouteInput) awsec2.DeleteTransitGatewayRouteRequest {
+						return awsec2.DeleteTransitGatewayRouteRequest{Request: request(nil, &awsec2.DeleteTransitGatewayRouteOutput{})}
+					},
+				},
+				cr: route(),
+			},
+			want: want{
+				cr: route(withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteClient{
+					MockDelete: func(*awsec2.DeleteTransitGatewayRouteInput) awsec2.DeleteTransitGatewayRouteRequest {
+						return awsec2.DeleteTransitGatewayRouteRequest{
+							Request: request(awserr.New(ec2.RouteNotFound, "", nil), nil),
+						}
+					},
+				},
+				cr: route(),
+			},
+			want: want{
+				cr: route(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteClient{
+					MockDelete: func(*awsec2.DeleteTransitGatewayRouteInput) awsec2.DeleteTransitGatewayRouteRequest {
+						return awsec2.DeleteTransitGatewayRouteRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: route(),
+			},
+			want: want{
+				cr:  route(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
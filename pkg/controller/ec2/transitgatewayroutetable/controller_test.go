@@ -0,0 +1,556 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayroutetable
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	tgwID   = "tgw-0123456789abcdef0"
+	rtbID   = "tgw-rtb-0123456789abcdef0"
+	errBoom = errors.New("route table boomed")
+)
+
+type rtbModifier func(*v1beta1.TransitGatewayRouteTable)
+
+func withExternalName(name string) rtbModifier {
+	return func(r *v1beta1.TransitGatewayRouteTable) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) rtbModifier {
+	return func(r *v1beta1.TransitGatewayRouteTable) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withTags(tags ...v1beta1.Tag) rtbModifier {
+	return func(r *v1beta1.TransitGatewayRouteTable) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withStatus(s v1beta1.TransitGatewayRouteTableObservation) rtbModifier {
+	return func(r *v1beta1.TransitGatewayRouteTable) { r.Status.AtProvider = s }
+}
+
+func routeTable(m ...rtbModifier) *v1beta1.TransitGatewayRouteTable {
+	cr := &v1beta1.TransitGatewayRouteTable{
+		Spec: v1beta1.TransitGatewayRouteTableSpec{
+			ForProvider: v1beta1.TransitGatewayRouteTableParameters{
+				TransitGatewayID: aws.String(tgwID),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func request(err error, data interface{}) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: data}
+}
+
+func describe(rt ...awsec2.TransitGatewayRouteTable) func(*awsec2.DescribeTransitGatewayRouteTablesInput) awsec2.DescribeTransitGatewayRouteTablesRequest {
+	return func(*awsec2.DescribeTransitGatewayRouteTablesInput) awsec2.DescribeTransitGatewayRouteTablesRequest {
+		return awsec2.DescribeTransitGatewayRouteTablesRequest{
+			Request: request(nil, &awsec2.DescribeTransitGatewayRouteTablesOutput{TransitGatewayRouteTables: rt}),
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client ec2.TransitGatewayRouteTableClient
+	cr     *v1beta1.TransitGatewayRouteTable
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.TransitGatewayRouteTable
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableClient{},
+				cr:     routeTable(),
+			},
+			want: want{
+				cr: routeTable(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableClient{
+					MockDescribe: func(*awsec2.DescribeTransitGatewayRouteTablesInput) awsec2.DescribeTransitGatewayRouteTablesRequest {
+						return awsec2.DescribeTransitGatewayRouteTablesRequest{
+							Request: request(awserr.New(ec2.RouteTableIDNotFound, "", nil), nil),
+						}
+					},
+				},
+				cr: routeTable(withExternalName(rtbID)),
+			},
+			want: want{
+				cr: routeTable(withExternalName(rtbID)),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableClient{
+					MockDescribe: func(*awsec2.DescribeTransitGatewayRouteTablesInput) awsec2.DescribeTransitGatewayRouteTablesRequest {
+						return awsec2.DescribeTransitGatewayRouteTablesRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: routeTable(withExternalName(rtbID)),
+			},
+			want: want{
+				cr:  routeTable(withExternalName(rtbID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"NotSingleItem": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableClient{
+					MockDescribe: describe(),
+				},
+				cr: routeTable(withExternalName(rtbID)),
+			},
+			want: want{
+				cr:  routeTable(withExternalName(rtbID)),
+				err: awsclient.Wrap(errors.New(errNotSingleItem), errDescribe),
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableClient{
+					MockDescribe: describe(awsec2.TransitGatewayRouteTable{
+						TransitGatewayRouteTableId: aws.String(rtbID),
+						State:                      awsec2.TransitGatewayRouteTableStateDeleted,
+					}),
+				},
+				cr: routeTable(withExternalName(rtbID)),
+			},
+			want: want{
+				cr: routeTable(withExternalName(rtbID), withStatus(v1beta1.TransitGatewayRouteTableObservation{
+					TransitGatewayRouteTableID: rtbID,
+					State:                      v1beta1.TransitGatewayRouteTableStateDeleted,
+				})),
+			},
+		},
+		"Available": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableClient{
+					MockDescribe: describe(awsec2.TransitGatewayRouteTable{
+						TransitGatewayRouteTableId:   aws.String(rtbID),
+						State:                        awsec2.TransitGatewayRouteTableStateAvailable,
+						DefaultAssociationRouteTable: aws.Bool(true),
+						Tags:                         []awsec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+					}),
+				},
+				cr: routeTable(withExternalName(rtbID), withTags(v1beta1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: routeTable(withExternalName(rtbID), withTags(v1beta1.Tag{Key: "k", Value: "v"}),
+					withConditions(xpv1.Available()),
+					withStatus(v1beta1.TransitGatewayRouteTableObservation{
+						TransitGatewayRouteTableID:   rtbID,
+						State:                        v1beta1.TransitGatewayRouteTableStateAvailable,
+						DefaultAssociationRouteTable: true,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsOutdated": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableClient{
+					MockDescribe: describe(awsec2.TransitGatewayRouteTable{
+						TransitGatewayRouteTableId: aws.String(rtbID),
+						State:                      awsec2.TransitGatewayRouteTableStatePending,
+					}),
+				},
+				cr: routeTable(withExternalName(rtbID), withTags(v1beta1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: routeTable(withExternalName(rtbID), withTags(v1beta1.Tag{Key: "k", Value: "v"}),
+					withConditions(xpv1.Creating()),
+					withStatus(v1beta1.TransitGatewayRouteTableObservation{
+						TransitGatewayRouteTableID: rtbID,
+						State:                      v1beta1.TransitGatewayRouteTableStatePending,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.TransitGatewayRouteTable
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableClient{
+					MockCreate: func(*awsec2.CreateTransitGatewayRouteTableInput) awsec2.CreateTransitGatewayRouteTableRequest {
+						return awsec2.CreateTransitGatewayRouteTableRequest{
+							Request: request(nil, &awsec2.CreateTransitGatewayRouteTableOutput{
+								TransitGatewayRouteTable: &awsec2.TransitGatewayRouteTable{TransitGatewayRouteTableId: aws.String(rtbID)},
+							}),
+						}
+					},
+				},
+				cr: routeTable(),
+			},
+			want: want{
+				cr:     routeTable(withExternalName(rtbID)),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"NoRouteTableReturned": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableClient{
+					MockCreate: func(*awsec2.CreateTransitGatewayRouteTableInput) awsec2.CreateTransitGatewayRouteTableRequest {
+						return awsec2.CreateTransitGatewayRouteTableRequest{
+							Request: request(nil, &awsec2.CreateTransitGatewayRouteTableOutput{}),
+						}
+					},
+				},
+				cr: routeTable(),
+			},
+			want: want{
+				cr:  routeTable(),
+				err: errors.New(errNotSingleItem),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableClient{
+					MockCreate: func(*awsec2.CreateTransitGatewayRouteTableInput) awsec2.CreateTransitGatewayRouteTableRequest {
+						return awsec2.CreateTransitGatewayRouteTableRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: routeTable(),
+			},
+			want: want{
+				cr:  routeTable(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SyncTags": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableClient{
+					MockDescribe: describe(awsec2.TransitGatewayRouteTable{
+						TransitGatewayRouteTableId: aws.String(rtbID),
+						Tags:                       []awsec2.Tag{{Key: aws.String("old"), Value: aws.String("v")}},
+					}),
+					MockDeleteTags: func(*awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+						return awsec2.DeleteTagsRequest{Request: request(nil, &awsec2.DeleteTagsOutput{})}
+					},
+					MockCreateTags: func(*awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{Request: request(nil, &awsec2.CreateTagsOutput{})}
+					},
+				},
+				cr: routeTable(withExternalName(rtbID), withTags(v1beta1.Tag{Key: "new", Value: "v"})),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableClient{
+					MockDescribe: func(*awsec2.DescribeTransitGatewayRouteTablesInput) awsec2.DescribeTransitGatewayRouteTablesRequest {
+						return awsec2.DescribeTransitGatewayRouteTablesRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: routeTable(withExternalName(rtbID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"DeleteTagsFailed": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableClient{
+					MockDescribe: describe(awsec2.TransitGatewayRouteTable{
+						TransitGatewayRouteTableId: aws.String(rtbID),
+						Tags:                       []awsec2.Tag{{Key: aws.String("old"), Value: aws.String("v")}},
+					}),
+					MockDeleteTags: func(*awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+						return awsec2.DeleteTagsRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: routeTable(withExternalName(rtbID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDeleteTags),
+			},
+		},
+		"CreateTagsFailed": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableClient{
+					MockDescribe: describe(awsec2.TransitGatewayRouteTable{TransitGatewayRouteTableId: aws.String(rtbID)}),
+					MockCreateTags: func(*awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: routeTable(withExternalName(rtbID), withTags(v1beta1.Tag{Key: "new", Value: "v"})),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errCreateTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.TransitGatewayRouteTable
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableClient{
+					MockDelete: func(*awsec2.DeleteTransitGatewayRouteTableInput) awsec2.DeleteTransitGatewayRouteTableRequest {
+						return awsec2.DeleteTransitGatewayRouteTableRequest{Request: request(nil, &awsec2.DeleteTransitGatewayRouteTableOutput{})}
+					},
+				},
+				cr: routeTable(withExternalName(rtbID)),
+			},
+			want: want{
+				cr: routeTable(withExternalName(rtbID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableClient{},
+				cr: routeTable(withExternalName(rtbID), withStatus(v1beta1.TransitGatewayRouteTableObservation{
+					State: v1beta1.TransitGatewayRouteTableStateDeleting,
+				})),
+			},
+			want: want{
+				cr: routeTable(withExternalName(rtbID), withConditions(xpv1.Deleting()),
+					withStatus(v1beta1.TransitGatewayRouteTableObservation{State: v1beta1.TransitGatewayRouteTableStateDeleting})),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableClient{
+					MockDelete: func(*awsec2.DeleteTransitGatewayRouteTableInput) awsec2.DeleteTransitGatewayRouteTableRequest {
+						return awsec2.DeleteTransitGatewayRouteTableRequest{
+							Request: request(awserr.New(ec2.RouteTableIDNotFound, "", nil), nil),
+						}
+					},
+				},
+				cr: routeTable(withExternalName(rtbID)),
+			},
+			want: want{
+				cr: routeTable(withExternalName(rtbID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableClient{
+					MockDelete: func(*awsec2.DeleteTransitGatewayRouteTableInput) awsec2.DeleteTransitGatewayRouteTableRequest {
+						return awsec2.DeleteTransitGatewayRouteTableRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: routeTable(withExternalName(rtbID)),
+			},
+			want: want{
+				cr:  routeTable(withExternalName(rtbID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInitialize(t *testing.T) {
+	type args struct {
+		cr   *v1beta1.TransitGatewayRouteTable
+		kube client.Client
+	}
+	type want struct {
+		cr  *v1beta1.TransitGatewayRouteTable
+		err error
+	}
+
+	externalTags := func() []v1beta1.Tag {
+		tags := []v1beta1.Tag{}
+		for k, v := range resource.GetExternalTags(routeTable()) {
+			tags = append(tags, v1beta1.Tag{Key: k, Value: v})
+		}
+		return tags
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cr:   routeTable(withTags(v1beta1.Tag{Key: "foo", Value: "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			},
+			want: want{
+				cr: routeTable(withTags(append(externalTags(), v1beta1.Tag{Key: "foo", Value: "bar"})...)),
+			},
+		},
+		"NoTags": {
+			args: args{
+				cr:   routeTable(),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			},
+			want: want{
+				cr: routeTable(),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				cr:   routeTable(withTags(v1beta1.Tag{Key: "foo", Value: "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &tagger{kube: tc.kube}
+			err := e.Initialize(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, cmpopts.SortSlices(func(a, b v1beta1.Tag) bool { return a.Key > b.Key })); err == nil && diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
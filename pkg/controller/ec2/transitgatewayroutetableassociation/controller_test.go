@@ -0,0 +1,329 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayroutetableassociation
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	rtbID        = "tgw-rtb-0123456789abcdef0"
+	attachmentID = "tgw-attach-0123456789abcdef0"
+	errBoom      = errors.New("association boomed")
+)
+
+type associationModifier func(*v1beta1.TransitGatewayRouteTableAssociation)
+
+func withConditions(c ...xpv1.Condition) associationModifier {
+	return func(r *v1beta1.TransitGatewayRouteTableAssociation) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1beta1.TransitGatewayRouteTableAssociationParameters) associationModifier {
+	return func(r *v1beta1.TransitGatewayRouteTableAssociation) { r.Spec.ForProvider = p }
+}
+
+func withState(s string) associationModifier {
+	return func(r *v1beta1.TransitGatewayRouteTableAssociation) {
+		r.Status.AtProvider = v1beta1.TransitGatewayRouteTableAttachmentObservation{
+			State:        s,
+			ResourceID:   "vpc-1",
+			ResourceType: "vpc",
+		}
+	}
+}
+
+func association(m ...associationModifier) *v1beta1.TransitGatewayRouteTableAssociation {
+	cr := &v1beta1.TransitGatewayRouteTableAssociation{
+		Spec: v1beta1.TransitGatewayRouteTableAssociationSpec{
+			ForProvider: v1beta1.TransitGatewayRouteTableAssociationParameters{
+				TransitGatewayRouteTableID: aws.String(rtbID),
+				TransitGatewayAttachmentID: aws.String(attachmentID),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func request(err error, data interface{}) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: data}
+}
+
+func associations(state awsec2.TransitGatewayAssociationState) func(*awsec2.GetTransitGatewayRouteTableAssociationsInput) awsec2.GetTransitGatewayRouteTableAssociationsRequest {
+	return func(*awsec2.GetTransitGatewayRouteTableAssociationsInput) awsec2.GetTransitGatewayRouteTableAssociationsRequest {
+		return awsec2.GetTransitGatewayRouteTableAssociationsRequest{
+			Request: request(nil, &awsec2.GetTransitGatewayRouteTableAssociationsOutput{
+				Associations: []awsec2.TransitGatewayRouteTableAssociation{{
+					TransitGatewayAttachmentId: aws.String(attachmentID),
+					ResourceId:                 aws.String("vpc-1"),
+					ResourceType:               awsec2.TransitGatewayAttachmentResourceTypeVpc,
+					State:                      state,
+				}},
+			}),
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client ec2.TransitGatewayRouteTableAssociationClient
+	cr     *v1beta1.TransitGatewayRouteTableAssociation
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.TransitGatewayRouteTableAssociation
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ReferencesNotResolved": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableAssociationClient{},
+				cr:     association(withSpec(v1beta1.TransitGatewayRouteTableAssociationParameters{})),
+			},
+			want: want{
+				cr: association(withSpec(v1beta1.TransitGatewayRouteTableAssociationParameters{})),
+			},
+		},
+		"RouteTableNotFound": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableAssociationClient{
+					MockGetAssociations: func(*awsec2.GetTransitGatewayRouteTableAssociationsInput) awsec2.GetTransitGatewayRouteTableAssociationsRequest {
+						return awsec2.GetTransitGatewayRouteTableAssociationsRequest{
+							Request: request(awserr.New(ec2.RouteTableIDNotFound, "", nil), nil),
+						}
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr: association(),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableAssociationClient{
+					MockGetAssociations: func(*awsec2.GetTransitGatewayRouteTableAssociationsInput) awsec2.GetTransitGatewayRouteTableAssociationsRequest {
+						return awsec2.GetTransitGatewayRouteTableAssociationsRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr:  association(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"NotAssociated": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableAssociationClient{
+					MockGetAssociations: func(*awsec2.GetTransitGatewayRouteTableAssociationsInput) awsec2.GetTransitGatewayRouteTableAssociationsRequest {
+						return awsec2.GetTransitGatewayRouteTableAssociationsRequest{
+							Request: request(nil, &awsec2.GetTransitGatewayRouteTableAssociationsOutput{}),
+						}
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr: association(),
+			},
+		},
+		"Disassociated": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableAssociationClient{
+					MockGetAssociations: associations(awsec2.TransitGatewayAssociationStateDisassociated),
+				},
+				cr: association(),
+			},
+			want: want{
+				cr: association(withState(v1beta1.TransitGatewayAssociationStateDisassociated)),
+			},
+		},
+		"Associated": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableAssociationClient{
+					MockGetAssociations: associations(awsec2.TransitGatewayAssociationStateAssociated),
+				},
+				cr: association(),
+			},
+			want: want{
+				cr: association(withState(v1beta1.TransitGatewayAssociationStateAssociated), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableAssociationClient{
+					MockAssociate: func(*awsec2.AssociateTransitGatewayRouteTableInput) awsec2.AssociateTransitGatewayRouteTableRequest {
+						return awsec2.AssociateTransitGatewayRouteTableRequest{
+							Request: request(nil, &awsec2.AssociateTransitGatewayRouteTableOutput{}),
+						}
+					},
+				},
+				cr: association(),
+			},
+		},
+		"AssociateFailed": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableAssociationClient{
+					MockAssociate: func(*awsec2.AssociateTransitGatewayRouteTableInput) awsec2.AssociateTransitGatewayRouteTableRequest {
+						return awsec2.AssociateTransitGatewayRouteTableRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errAssociate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.TransitGatewayRouteTableAssociation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableAssociationClient{
+					MockDisassociate: func(*awsec2.DisassociateTransitGatewayRouteTableInput) awsec2.DisassociateTransitGatewayRouteTableRequest {
+						return awsec2.DisassociateTransitGatewayRouteTableRequest{
+							Request: request(nil, &awsec2.DisassociateTransitGatewayRouteTableOutput{}),
+						}
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr: association(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDisassociating": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableAssociationClient{},
+				cr:     association(withState(v1beta1.TransitGatewayAssociationStateDisassociating)),
+			},
+			want: want{
+				cr: association(withState(v1beta1.TransitGatewayAssociationStateDisassociating), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DisassociateFailed": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTableAssociationClient{
+					MockDisassociate: func(*awsec2.DisassociateTransitGatewayRouteTableInput) awsec2.DisassociateTransitGatewayRouteTableRequest {
+						return awsec2.DisassociateTransitGatewayRouteTableRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr:  association(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
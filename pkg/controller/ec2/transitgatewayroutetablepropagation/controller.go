@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayroutetablepropagation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a TransitGatewayRouteTablePropagation resource"
+
+	errDescribe = "failed to describe the propagations of the TransitGatewayRouteTable"
+	errEnable   = "failed to enable the propagation of the attachment to the TransitGatewayRouteTable"
+	errDelete   = "failed to disable the propagation of the attachment to the TransitGatewayRouteTable"
+)
+
+// SetupTransitGatewayRouteTablePropagation adds a controller that reconciles
+// TransitGatewayRouteTablePropagations.
+func SetupTransitGatewayRouteTablePropagation(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1beta1.TransitGatewayRouteTablePropagationGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1beta1.TransitGatewayRouteTablePropagation{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.TransitGatewayRouteTablePropagationGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewManagementPolicyConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewTransitGatewayRouteTablePropagationClient})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.TransitGatewayRouteTablePropagationClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.TransitGatewayRouteTablePropagation)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.TransitGatewayRouteTablePropagationClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1beta1.TransitGatewayRouteTablePropagation)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The propagation has no ID of its own and is identified by the route
+	// table and the attachment.
+	rtbID := aws.StringValue(cr.Spec.ForProvider.TransitGatewayRouteTableID)
+	attachmentID := aws.StringValue(cr.Spec.ForProvider.TransitGatewayAttachmentID)
+	if rtbID == "" || attachmentID == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	resp, err := e.client.GetTransitGatewayRouteTablePropagationsRequest(&awsec2.GetTransitGatewayRouteTablePropagationsInput{
+		TransitGatewayRouteTableId: aws.String(rtbID),
+		Filters:                    ec2.TransitGatewayAttachmentFilter(attachmentID),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsTransitGatewayRouteTableNotFoundErr, err), errDescribe)
+	}
+	if len(resp.TransitGatewayRouteTablePropagations) == 0 {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	cr.Status.AtProvider = ec2.GenerateTransitGatewayRouteTablePropagationObservation(resp.TransitGatewayRouteTablePropagations[0])
+
+	switch cr.Status.AtProvider.State {
+	case v1beta1.TransitGatewayPropagationStateEnabled:
+		cr.SetConditions(xpv1.Available())
+	case v1beta1.TransitGatewayPropagationStateEnabling:
+		cr.SetConditions(xpv1.Creating())
+	case v1beta1.TransitGatewayPropagationStateDisabling:
+		cr.SetConditions(xpv1.Deleting())
+	case v1beta1.TransitGatewayPropagationStateDisabled:
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1beta1.TransitGatewayRouteTablePropagation)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.EnableTransitGatewayRouteTablePropagationRequest(&awsec2.EnableTransitGatewayRouteTablePropagationInput{
+		TransitGatewayRouteTableId: cr.Spec.ForProvider.TransitGatewayRouteTableID,
+		TransitGatewayAttachmentId: cr.Spec.ForProvider.TransitGatewayAttachmentID,
+	}).Send(ctx)
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errEnable)
+}
+
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	// The propagation cannot be modified.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1beta1.TransitGatewayRouteTablePropagation)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.State == v1beta1.TransitGatewayPropagationStateDisabling {
+		return nil
+	}
+
+	_, err := e.client.DisableTransitGatewayRouteTablePropagationRequest(&awsec2.DisableTransitGatewayRouteTablePropagationInput{
+		TransitGatewayRouteTableId: cr.Spec.ForProvider.TransitGatewayRouteTableID,
+		TransitGatewayAttachmentId: cr.Spec.ForProvider.TransitGatewayAttachmentID,
+	}).Send(ctx)
+	return awsclient.Wrap(resource.Ignore(ec2.IsTransitGatewayRouteTableNotFoundErr, err), errDelete)
+}
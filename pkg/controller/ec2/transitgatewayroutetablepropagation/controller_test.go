@@ -0,0 +1,329 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayroutetablepropagation
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	rtbID        = "tgw-rtb-0123456789abcdef0"
+	attachmentID = "tgw-attach-0123456789abcdef0"
+	errBoom      = errors.New("propagation boomed")
+)
+
+type propagationModifier func(*v1beta1.TransitGatewayRouteTablePropagation)
+
+func withConditions(c ...xpv1.Condition) propagationModifier {
+	return func(r *v1beta1.TransitGatewayRouteTablePropagation) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1beta1.TransitGatewayRouteTablePropagationParameters) propagationModifier {
+	return func(r *v1beta1.TransitGatewayRouteTablePropagation) { r.Spec.ForProvider = p }
+}
+
+func withState(s string) propagationModifier {
+	return func(r *v1beta1.TransitGatewayRouteTablePropagation) {
+		r.Status.AtProvider = v1beta1.TransitGatewayRouteTableAttachmentObservation{
+			State:        s,
+			ResourceID:   "vpc-1",
+			ResourceType: "vpc",
+		}
+	}
+}
+
+func propagation(m ...propagationModifier) *v1beta1.TransitGatewayRouteTablePropagation {
+	cr := &v1beta1.TransitGatewayRouteTablePropagation{
+		Spec: v1beta1.TransitGatewayRouteTablePropagationSpec{
+			ForProvider: v1beta1.TransitGatewayRouteTablePropagationParameters{
+				TransitGatewayRouteTableID: aws.String(rtbID),
+				TransitGatewayAttachmentID: aws.String(attachmentID),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func request(err error, data interface{}) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: data}
+}
+
+func propagations(state awsec2.TransitGatewayPropagationState) func(*awsec2.GetTransitGatewayRouteTablePropagationsInput) awsec2.GetTransitGatewayRouteTablePropagationsRequest {
+	return func(*awsec2.GetTransitGatewayRouteTablePropagationsInput) awsec2.GetTransitGatewayRouteTablePropagationsRequest {
+		return awsec2.GetTransitGatewayRouteTablePropagationsRequest{
+			Request: request(nil, &awsec2.GetTransitGatewayRouteTablePropagationsOutput{
+				TransitGatewayRouteTablePropagations: []awsec2.TransitGatewayRouteTablePropagation{{
+					TransitGatewayAttachmentId: aws.String(attachmentID),
+					ResourceId:                 aws.String("vpc-1"),
+					ResourceType:               awsec2.TransitGatewayAttachmentResourceTypeVpc,
+					State:                      state,
+				}},
+			}),
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client ec2.TransitGatewayRouteTablePropagationClient
+	cr     *v1beta1.TransitGatewayRouteTablePropagation
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.TransitGatewayRouteTablePropagation
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ReferencesNotResolved": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTablePropagationClient{},
+				cr:     propagation(withSpec(v1beta1.TransitGatewayRouteTablePropagationParameters{})),
+			},
+			want: want{
+				cr: propagation(withSpec(v1beta1.TransitGatewayRouteTablePropagationParameters{})),
+			},
+		},
+		"RouteTableNotFound": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTablePropagationClient{
+					MockGetPropagations: func(*awsec2.GetTransitGatewayRouteTablePropagationsInput) awsec2.GetTransitGatewayRouteTablePropagationsRequest {
+						return awsec2.GetTransitGatewayRouteTablePropagationsRequest{
+							Request: request(awserr.New(ec2.RouteTableIDNotFound, "", nil), nil),
+						}
+					},
+				},
+				cr: propagation(),
+			},
+			want: want{
+				cr: propagation(),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTablePropagationClient{
+					MockGetPropagations: func(*awsec2.GetTransitGatewayRouteTablePropagationsInput) awsec2.GetTransitGatewayRouteTablePropagationsRequest {
+						return awsec2.GetTransitGatewayRouteTablePropagationsRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: propagation(),
+			},
+			want: want{
+				cr:  propagation(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"NotEnabled": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTablePropagationClient{
+					MockGetPropagations: func(*awsec2.GetTransitGatewayRouteTablePropagationsInput) awsec2.GetTransitGatewayRouteTablePropagationsRequest {
+						return awsec2.GetTransitGatewayRouteTablePropagationsRequest{
+							Request: request(nil, &awsec2.GetTransitGatewayRouteTablePropagationsOutput{}),
+						}
+					},
+				},
+				cr: propagation(),
+			},
+			want: want{
+				cr: propagation(),
+			},
+		},
+		"Disabled": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTablePropagationClient{
+					MockGetPropagations: propagations(awsec2.TransitGatewayPropagationStateDisabled),
+				},
+				cr: propagation(),
+			},
+			want: want{
+				cr: propagation(withState(v1beta1.TransitGatewayPropagationStateDisabled)),
+			},
+		},
+		"Enabled": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTablePropagationClient{
+					MockGetPropagations: propagations(awsec2.TransitGatewayPropagationStateEnabled),
+				},
+				cr: propagation(),
+			},
+			want: want{
+				cr: propagation(withState(v1beta1.TransitGatewayPropagationStateEnabled), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTablePropagationClient{
+					MockEnable: func(*awsec2.EnableTransitGatewayRouteTablePropagationInput) awsec2.EnableTransitGatewayRouteTablePropagationRequest {
+						return awsec2.EnableTransitGatewayRouteTablePropagationRequest{
+							Request: request(nil, &awsec2.EnableTransitGatewayRouteTablePropagationOutput{}),
+						}
+					},
+				},
+				cr: propagation(),
+			},
+		},
+		"EnableFailed": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTablePropagationClient{
+					MockEnable: func(*awsec2.EnableTransitGatewayRouteTablePropagationInput) awsec2.EnableTransitGatewayRouteTablePropagationRequest {
+						return awsec2.EnableTransitGatewayRouteTablePropagationRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: propagation(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errEnable),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.TransitGatewayRouteTablePropagation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTablePropagationClient{
+					MockDisable: func(*awsec2.DisableTransitGatewayRouteTablePropagationInput) awsec2.DisableTransitGatewayRouteTablePropagationRequest {
+						return awsec2.DisableTransitGatewayRouteTablePropagationRequest{
+							Request: request(nil, &awsec2.DisableTransitGatewayRouteTablePropagationOutput{}),
+						}
+					},
+				},
+				cr: propagation(),
+			},
+			want: want{
+				cr: propagation(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDisabling": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTablePropagationClient{},
+				cr:     propagation(withState(v1beta1.TransitGatewayPropagationStateDisabling)),
+			},
+			want: want{
+				cr: propagation(withState(v1beta1.TransitGatewayPropagationStateDisabling), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DisableFailed": {
+			args: args{
+				client: &fake.MockTransitGatewayRouteTablePropagationClient{
+					MockDisable: func(*awsec2.DisableTransitGatewayRouteTablePropagationInput) awsec2.DisableTransitGatewayRouteTablePropagationRequest {
+						return awsec2.DisableTransitGatewayRouteTablePropagationRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: propagation(),
+			},
+			want: want{
+				cr:  propagation(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayvpcattachment
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	attachmentID = "tgw-attach-0123456789abcdef0"
+	errBoom      = errors.New("transit gateway attachment boomed")
+)
+
+type attachmentModifier func(*v1beta1.TransitGatewayVPCAttachment)
+
+func withConditions(c ...xpv1.Condition) attachmentModifier {
+	return func(r *v1beta1.TransitGatewayVPCAttachment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSubnets(s ...string) attachmentModifier {
+	return func(r *v1beta1.TransitGatewayVPCAttachment) { r.Spec.ForProvider.SubnetIDs = s }
+}
+
+func withStatus(s v1beta1.TransitGatewayVPCAttachmentObservation) attachmentModifier {
+	return func(r *v1beta1.TransitGatewayVPCAttachment) { r.Status.AtProvider = s }
+}
+
+func attachment(m ...attachmentModifier) *v1beta1.TransitGatewayVPCAttachment {
+	cr := &v1beta1.TransitGatewayVPCAttachment{
+		Spec: v1beta1.TransitGatewayVPCAttachmentSpec{
+			ForProvider: v1beta1.TransitGatewayVPCAttachmentParameters{
+				TransitGatewayID: aws.String("tgw-1"),
+				VPCID:            aws.String("vpc-1"),
+				SubnetIDs:        []string{"subnet-1"},
+			},
+		},
+	}
+	meta.SetExternalName(cr, attachmentID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(state awsec2.TransitGatewayAttachmentState, subnets ...string) awsec2.TransitGatewayVpcAttachment {
+	return awsec2.TransitGatewayVpcAttachment{
+		TransitGatewayAttachmentId: aws.String(attachmentID),
+		TransitGatewayId:           aws.String("tgw-1"),
+		VpcId:                      aws.String("vpc-1"),
+		VpcOwnerId:                 aws.String("123456789012"),
+		SubnetIds:                  subnets,
+		State:                      state,
+	}
+}
+
+func request(err error, data interface{}) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: data}
+}
+
+func describe(a ...awsec2.TransitGatewayVpcAttachment) func(*awsec2.DescribeTransitGatewayVpcAttachmentsInput) awsec2.DescribeTransitGatewayVpcAttachmentsRequest {
+	return func(*awsec2.DescribeTransitGatewayVpcAttachmentsInput) awsec2.DescribeTransitGatewayVpcAttachmentsRequest {
+		return awsec2.DescribeTransitGatewayVpcAttachmentsRequest{
+			Request: request(nil, &awsec2.DescribeTransitGatewayVpcAttachmentsOutput{TransitGatewayVpcAttachments: a}),
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client ec2.TransitGatewayVPCAttachmentClient
+	cr     *v1beta1.TransitGatewayVPCAttachment
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.TransitGatewayVPCAttachment
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"DescribeError": {
+			args: args{
+				client: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribe: func(*awsec2.DescribeTransitGatewayVpcAttachmentsInput) awsec2.DescribeTransitGatewayVpcAttachmentsRequest {
+						return awsec2.DescribeTransitGatewayVpcAttachmentsRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:  attachment(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"Available": {
+			args: args{
+				client: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribe: describe(observed(awsec2.TransitGatewayAttachmentStateAvailable, "subnet-1")),
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(withConditions(xpv1.Available()), withStatus(v1beta1.TransitGatewayVPCAttachmentObservation{
+					TransitGatewayAttachmentID: attachmentID,
+					State:                      v1beta1.TransitGatewayAttachmentStateAvailable,
+					VPCOwnerID:                 "123456789012",
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SubnetsChanged": {
+			args: args{
+				client: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribe: describe(observed(awsec2.TransitGatewayAttachmentStateAvailable, "subnet-1")),
+				},
+				cr: attachment(withSubnets("subnet-1", "subnet-2")),
+			},
+			want: want{
+				cr: attachment(withSubnets("subnet-1", "subnet-2"), withConditions(xpv1.Available()), withStatus(v1beta1.TransitGatewayVPCAttachmentObservation{
+					TransitGatewayAttachmentID: attachmentID,
+					State:                      v1beta1.TransitGatewayAttachmentStateAvailable,
+					VPCOwnerID:                 "123456789012",
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"ModifyingIsNotCompared": {
+			args: args{
+				client: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribe: describe(observed(awsec2.TransitGatewayAttachmentStateModifying, "subnet-1")),
+				},
+				cr: attachment(withSubnets("subnet-1", "subnet-2")),
+			},
+			want: want{
+				cr: attachment(withSubnets("subnet-1", "subnet-2"), withConditions(xpv1.Creating()), withStatus(v1beta1.TransitGatewayVPCAttachmentObservation{
+					TransitGatewayAttachmentID: attachmentID,
+					State:                      v1beta1.TransitGatewayAttachmentStateModifying,
+					VPCOwnerID:                 "123456789012",
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ModifySubnets": {
+			args: args{
+				client: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribe: describe(observed(awsec2.TransitGatewayAttachmentStateAvailable, "subnet-1")),
+					MockModify: func(i *awsec2.ModifyTransitGatewayVpcAttachmentInput) awsec2.ModifyTransitGatewayVpcAttachmentRequest {
+						if diff := cmp.Diff([]string{"subnet-2"}, i.AddSubnetIds); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.ModifyTransitGatewayVpcAttachmentRequest{Request: request(nil, &awsec2.ModifyTransitGatewayVpcAttachmentOutput{})}
+					},
+				},
+				cr: attachment(withSubnets("subnet-1", "subnet-2")),
+			},
+		},
+		"ModifyFailed": {
+			args: args{
+				client: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribe: describe(observed(awsec2.TransitGatewayAttachmentStateAvailable, "subnet-1")),
+					MockModify: func(*awsec2.ModifyTransitGatewayVpcAttachmentInput) awsec2.ModifyTransitGatewayVpcAttachmentRequest {
+						return awsec2.ModifyTransitGatewayVpcAttachmentRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: attachment(withSubnets("subnet-2")),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
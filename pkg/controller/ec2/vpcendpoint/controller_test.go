@@ -0,0 +1,431 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcendpoint
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	endpointID  = "vpce-0123456789abcdef0"
+	serviceName = "com.amazonaws.us-east-1.s3"
+	policy      = `{"Statement":[]}`
+	errBoom     = errors.New("vpc endpoint boomed")
+)
+
+type endpointModifier func(*v1alpha1.VPCEndpoint)
+
+func withExternalName(name string) endpointModifier {
+	return func(r *v1alpha1.VPCEndpoint) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) endpointModifier {
+	return func(r *v1alpha1.VPCEndpoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withRouteTableIDs(ids ...string) endpointModifier {
+	return func(r *v1alpha1.VPCEndpoint) { r.Spec.ForProvider.RouteTableIDs = ids }
+}
+
+func withStatus(s v1alpha1.VPCEndpointObservation) endpointModifier {
+	return func(r *v1alpha1.VPCEndpoint) { r.Status.AtProvider = s }
+}
+
+func vpcEndpoint(m ...endpointModifier) *v1alpha1.VPCEndpoint {
+	cr := &v1alpha1.VPCEndpoint{
+		Spec: v1alpha1.VPCEndpointSpec{
+			ForProvider: v1alpha1.VPCEndpointParameters{
+				VPCID:           aws.String("vpc-1"),
+				ServiceName:     serviceName,
+				VPCEndpointType: aws.String(v1alpha1.VPCEndpointTypeGateway),
+				PolicyDocument:  aws.String(policy),
+				RouteTableIDs:   []string{"rtb-1"},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(state awsec2.State) awsec2.VpcEndpoint {
+	return awsec2.VpcEndpoint{
+		VpcEndpointId:   aws.String(endpointID),
+		VpcEndpointType: awsec2.VpcEndpointTypeGateway,
+		ServiceName:     aws.String(serviceName),
+		PolicyDocument:  aws.String(policy),
+		RouteTableIds:   []string{"rtb-1"},
+		State:           state,
+	}
+}
+
+func request(err error, data interface{}) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: data}
+}
+
+func describe(e ...awsec2.VpcEndpoint) func(*awsec2.DescribeVpcEndpointsInput) awsec2.DescribeVpcEndpointsRequest {
+	return func(*awsec2.DescribeVpcEndpointsInput) awsec2.DescribeVpcEndpointsRequest {
+		return awsec2.DescribeVpcEndpointsRequest{
+			Request: request(nil, &awsec2.DescribeVpcEndpointsOutput{VpcEndpoints: e}),
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client ec2.VPCEndpointClient
+	cr     *v1alpha1.VPCEndpoint
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.VPCEndpoint
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{},
+				cr:     vpcEndpoint(),
+			},
+			want: want{
+				cr: vpcEndpoint(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockDescribe: func(*awsec2.DescribeVpcEndpointsInput) awsec2.DescribeVpcEndpointsRequest {
+						return awsec2.DescribeVpcEndpointsRequest{
+							Request: request(awserr.New(ec2.VPCEndpointIDNotFound, "", nil), nil),
+						}
+					},
+				},
+				cr: vpcEndpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr: vpcEndpoint(withExternalName(endpointID)),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockDescribe: func(*awsec2.DescribeVpcEndpointsInput) awsec2.DescribeVpcEndpointsRequest {
+						return awsec2.DescribeVpcEndpointsRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: vpcEndpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr:  vpcEndpoint(withExternalName(endpointID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockDescribe: describe(observed("deleted")),
+				},
+				cr: vpcEndpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr: vpcEndpoint(withExternalName(endpointID), withStatus(v1alpha1.VPCEndpointObservation{
+					VPCEndpointID: endpointID,
+					State:         "deleted",
+				})),
+			},
+		},
+		"AvailableUpToDate": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockDescribe: describe(observed("available")),
+				},
+				cr: vpcEndpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr: vpcEndpoint(withExternalName(endpointID), withConditions(xpv1.Available()), withStatus(v1alpha1.VPCEndpointObservation{
+					VPCEndpointID: endpointID,
+					State:         "available",
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PendingRouteTableAdded": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockDescribe: describe(observed("pending")),
+				},
+				cr: vpcEndpoint(withExternalName(endpointID), withRouteTableIDs("rtb-1", "rtb-2")),
+			},
+			want: want{
+				cr: vpcEndpoint(withExternalName(endpointID), withRouteTableIDs("rtb-1", "rtb-2"),
+					withConditions(xpv1.Creating()), withStatus(v1alpha1.VPCEndpointObservation{
+						VPCEndpointID: endpointID,
+						State:         "pending",
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.VPCEndpoint
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockCreate: func(input *awsec2.CreateVpcEndpointInput) awsec2.CreateVpcEndpointRequest {
+						if aws.StringValue(input.ServiceName) != serviceName {
+							return awsec2.CreateVpcEndpointRequest{Request: request(errBoom, nil)}
+						}
+						return awsec2.CreateVpcEndpointRequest{
+							Request: request(nil, &awsec2.CreateVpcEndpointOutput{VpcEndpoint: &awsec2.VpcEndpoint{VpcEndpointId: aws.String(endpointID)}}),
+						}
+					},
+				},
+				cr: vpcEndpoint(),
+			},
+			want: want{
+				cr:     vpcEndpoint(withExternalName(endpointID)),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateError": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockCreate: func(*awsec2.CreateVpcEndpointInput) awsec2.CreateVpcEndpointRequest {
+						return awsec2.CreateVpcEndpointRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: vpcEndpoint(),
+			},
+			want: want{
+				cr:  vpcEndpoint(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ModifyRouteTables": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockDescribe: describe(observed("available")),
+					MockModify: func(input *awsec2.ModifyVpcEndpointInput) awsec2.ModifyVpcEndpointRequest {
+						if diff := cmp.Diff([]string{"rtb-2"}, input.AddRouteTableIds); diff != "" {
+							return awsec2.ModifyVpcEndpointRequest{Request: request(errBoom, nil)}
+						}
+						return awsec2.ModifyVpcEndpointRequest{Request: request(nil, &awsec2.ModifyVpcEndpointOutput{})}
+					},
+				},
+				cr: vpcEndpoint(withExternalName(endpointID), withRouteTableIDs("rtb-1", "rtb-2")),
+			},
+		},
+		"ModifyError": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockDescribe: describe(observed("available")),
+					MockModify: func(*awsec2.ModifyVpcEndpointInput) awsec2.ModifyVpcEndpointRequest {
+						return awsec2.ModifyVpcEndpointRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: vpcEndpoint(withExternalName(endpointID), withRouteTableIDs("rtb-2")),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.VPCEndpoint
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockDelete: func(*awsec2.DeleteVpcEndpointsInput) awsec2.DeleteVpcEndpointsRequest {
+						return awsec2.DeleteVpcEndpointsRequest{Request: request(nil, &awsec2.DeleteVpcEndpointsOutput{})}
+					},
+				},
+				cr: vpcEndpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr: vpcEndpoint(withExternalName(endpointID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{},
+				cr:     vpcEndpoint(withExternalName(endpointID), withStatus(v1alpha1.VPCEndpointObservation{State: "deleting"})),
+			},
+			want: want{
+				cr: vpcEndpoint(withExternalName(endpointID), withStatus(v1alpha1.VPCEndpointObservation{State: "deleting"}),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"Unsuccessful": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockDelete: func(*awsec2.DeleteVpcEndpointsInput) awsec2.DeleteVpcEndpointsRequest {
+						return awsec2.DeleteVpcEndpointsRequest{Request: request(nil, &awsec2.DeleteVpcEndpointsOutput{
+							Unsuccessful: []awsec2.UnsuccessfulItem{{
+								ResourceId: aws.String(endpointID),
+								Error:      &awsec2.UnsuccessfulItemError{Code: aws.String("Boom"), Message: aws.String(errBoom.Error())},
+							}},
+						})}
+					},
+				},
+				cr: vpcEndpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr:  vpcEndpoint(withExternalName(endpointID), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockDelete: func(*awsec2.DeleteVpcEndpointsInput) awsec2.DeleteVpcEndpointsRequest {
+						return awsec2.DeleteVpcEndpointsRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: vpcEndpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr:  vpcEndpoint(withExternalName(endpointID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,453 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcpeeringconnection
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	connectionID = "pcx-0123456789abcdef0"
+	ownerID      = "123456789012"
+	peerRegion   = "eu-west-1"
+	errBoom      = errors.New("vpc peering connection boomed")
+)
+
+type connectionModifier func(*v1beta1.VPCPeeringConnection)
+
+func withExternalName(name string) connectionModifier {
+	return func(r *v1beta1.VPCPeeringConnection) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) connectionModifier {
+	return func(r *v1beta1.VPCPeeringConnection) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withRequesterOptions(allow bool) connectionModifier {
+	return func(r *v1beta1.VPCPeeringConnection) {
+		r.Spec.ForProvider.RequesterOptions = &v1beta1.VPCPeeringConnectionOptions{
+			AllowDNSResolutionFromRemoteVPC: awsclient.Bool(allow, awsclient.FieldRequired),
+		}
+	}
+}
+
+func withStatus(s v1beta1.VPCPeeringConnectionObservation) connectionModifier {
+	return func(r *v1beta1.VPCPeeringConnection) { r.Status.AtProvider = s }
+}
+
+func peeringConnection(m ...connectionModifier) *v1beta1.VPCPeeringConnection {
+	cr := &v1beta1.VPCPeeringConnection{
+		Spec: v1beta1.VPCPeeringConnectionSpec{
+			ForProvider: v1beta1.VPCPeeringConnectionParameters{
+				VPCID:       aws.String("vpc-1"),
+				PeerVPCID:   aws.String("vpc-2"),
+				PeerOwnerID: aws.String(ownerID),
+				PeerRegion:  aws.String(peerRegion),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(code awsec2.VpcPeeringConnectionStateReasonCode) awsec2.VpcPeeringConnection {
+	return awsec2.VpcPeeringConnection{
+		VpcPeeringConnectionId: aws.String(connectionID),
+		Status:                 &awsec2.VpcPeeringConnectionStateReason{Code: code},
+		RequesterVpcInfo:       &awsec2.VpcPeeringConnectionVpcInfo{VpcId: aws.String("vpc-1")},
+		AccepterVpcInfo: &awsec2.VpcPeeringConnectionVpcInfo{
+			VpcId:   aws.String("vpc-2"),
+			OwnerId: aws.String(ownerID),
+			Region:  aws.String(peerRegion),
+		},
+	}
+}
+
+func request(err error, data interface{}) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: data}
+}
+
+func describe(c ...awsec2.VpcPeeringConnection) func(*awsec2.DescribeVpcPeeringConnectionsInput) awsec2.DescribeVpcPeeringConnectionsRequest {
+	return func(*awsec2.DescribeVpcPeeringConnectionsInput) awsec2.DescribeVpcPeeringConnectionsRequest {
+		return awsec2.DescribeVpcPeeringConnectionsRequest{
+			Request: request(nil, &awsec2.DescribeVpcPeeringConnectionsOutput{VpcPeeringConnections: c}),
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client ec2.VPCPeeringConnectionClient
+	cr     *v1beta1.VPCPeeringConnection
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.VPCPeeringConnection
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{},
+				cr:     peeringConnection(),
+			},
+			want: want{
+				cr: peeringConnection(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: func(*awsec2.DescribeVpcPeeringConnectionsInput) awsec2.DescribeVpcPeeringConnectionsRequest {
+						return awsec2.DescribeVpcPeeringConnectionsRequest{
+							Request: request(awserr.New(ec2.VPCPeeringConnectionIDNotFound, "", nil), nil),
+						}
+					},
+				},
+				cr: peeringConnection(withExternalName(connectionID)),
+			},
+			want: want{
+				cr: peeringConnection(withExternalName(connectionID)),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: func(*awsec2.DescribeVpcPeeringConnectionsInput) awsec2.DescribeVpcPeeringConnectionsRequest {
+						return awsec2.DescribeVpcPeeringConnectionsRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: peeringConnection(withExternalName(connectionID)),
+			},
+			want: want{
+				cr:  peeringConnection(withExternalName(connectionID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(observed(awsec2.VpcPeeringConnectionStateReasonCodeDeleted)),
+				},
+				cr: peeringConnection(withExternalName(connectionID)),
+			},
+			want: want{
+				cr: peeringConnection(withExternalName(connectionID), withStatus(v1beta1.VPCPeeringConnectionObservation{
+					VPCPeeringConnectionID: connectionID,
+					Status:                 v1beta1.VPCPeeringConnectionStatusDeleted,
+				})),
+			},
+		},
+		"PendingAcceptance": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(observed(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance)),
+				},
+				cr: peeringConnection(withExternalName(connectionID), withRequesterOptions(true)),
+			},
+			want: want{
+				cr: peeringConnection(withExternalName(connectionID), withRequesterOptions(true),
+					withConditions(xpv1.Creating()), withStatus(v1beta1.VPCPeeringConnectionObservation{
+						VPCPeeringConnectionID: connectionID,
+						Status:                 v1beta1.VPCPeeringConnectionStatusPendingAcceptance,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ActiveOptionsOutdated": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(observed(awsec2.VpcPeeringConnectionStateReasonCodeActive)),
+				},
+				cr: peeringConnection(withExternalName(connectionID), withRequesterOptions(true)),
+			},
+			want: want{
+				cr: peeringConnection(withExternalName(connectionID), withRequesterOptions(true),
+					withConditions(xpv1.Available()), withStatus(v1beta1.VPCPeeringConnectionObservation{
+						VPCPeeringConnectionID: connectionID,
+						Status:                 v1beta1.VPCPeeringConnectionStatusActive,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.VPCPeeringConnection
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockCreate: func(input *awsec2.CreateVpcPeeringConnectionInput) awsec2.CreateVpcPeeringConnectionRequest {
+						if aws.StringValue(input.PeerRegion) != peerRegion {
+							return awsec2.CreateVpcPeeringConnectionRequest{Request: request(errBoom, nil)}
+						}
+						return awsec2.CreateVpcPeeringConnectionRequest{
+							Request: request(nil, &awsec2.CreateVpcPeeringConnectionOutput{
+								VpcPeeringConnection: &awsec2.VpcPeeringConnection{VpcPeeringConnectionId: aws.String(connectionID)},
+							}),
+						}
+					},
+				},
+				cr: peeringConnection(),
+			},
+			want: want{
+				cr:     peeringConnection(withExternalName(connectionID)),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateError": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockCreate: func(*awsec2.CreateVpcPeeringConnectionInput) awsec2.CreateVpcPeeringConnectionRequest {
+						return awsec2.CreateVpcPeeringConnectionRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: peeringConnection(),
+			},
+			want: want{
+				cr:  peeringConnection(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ModifyRequesterOptions": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(observed(awsec2.VpcPeeringConnectionStateReasonCodeActive)),
+					MockModifyOptions: func(input *awsec2.ModifyVpcPeeringConnectionOptionsInput) awsec2.ModifyVpcPeeringConnectionOptionsRequest {
+						if input.RequesterPeeringConnectionOptions == nil || !aws.BoolValue(input.RequesterPeeringConnectionOptions.AllowDnsResolutionFromRemoteVpc) {
+							return awsec2.ModifyVpcPeeringConnectionOptionsRequest{Request: request(errBoom, nil)}
+						}
+						return awsec2.ModifyVpcPeeringConnectionOptionsRequest{Request: request(nil, &awsec2.ModifyVpcPeeringConnectionOptionsOutput{})}
+					},
+				},
+				cr: peeringConnection(withExternalName(connectionID), withRequesterOptions(true)),
+			},
+		},
+		"ModifyError": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(observed(awsec2.VpcPeeringConnectionStateReasonCodeActive)),
+					MockModifyOptions: func(*awsec2.ModifyVpcPeeringConnectionOptionsInput) awsec2.ModifyVpcPeeringConnectionOptionsRequest {
+						return awsec2.ModifyVpcPeeringConnectionOptionsRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: peeringConnection(withExternalName(connectionID), withRequesterOptions(true)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errModifyOptions),
+			},
+		},
+		"CreateTagsError": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(observed(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance)),
+					MockCreateTags: func(*awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: peeringConnection(withExternalName(connectionID), func(r *v1beta1.VPCPeeringConnection) {
+					r.Spec.ForProvider.Tags = []v1beta1.Tag{{Key: "Name", Value: "peering"}}
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errCreateTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.VPCPeeringConnection
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDelete: func(*awsec2.DeleteVpcPeeringConnectionInput) awsec2.DeleteVpcPeeringConnectionRequest {
+						return awsec2.DeleteVpcPeeringConnectionRequest{Request: request(nil, &awsec2.DeleteVpcPeeringConnectionOutput{})}
+					},
+				},
+				cr: peeringConnection(withExternalName(connectionID)),
+			},
+			want: want{
+				cr: peeringConnection(withExternalName(connectionID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{},
+				cr: peeringConnection(withExternalName(connectionID),
+					withStatus(v1beta1.VPCPeeringConnectionObservation{Status: v1beta1.VPCPeeringConnectionStatusDeleting})),
+			},
+			want: want{
+				cr: peeringConnection(withExternalName(connectionID),
+					withStatus(v1beta1.VPCPeeringConnectionObservation{Status: v1beta1.VPCPeeringConnectionStatusDeleting}),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDelete: func(*awsec2.DeleteVpcPeeringConnectionInput) awsec2.DeleteVpcPeeringConnectionRequest {
+						return awsec2.DeleteVpcPeeringConnectionRequest{
+							Request: request(awserr.New(ec2.VPCPeeringConnectionIDNotFound, "", nil), nil),
+						}
+					},
+				},
+				cr: peeringConnection(withExternalName(connectionID)),
+			},
+			want: want{
+				cr: peeringConnection(withExternalName(connectionID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDelete: func(*awsec2.DeleteVpcPeeringConnectionInput) awsec2.DeleteVpcPeeringConnectionRequest {
+						return awsec2.DeleteVpcPeeringConnectionRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: peeringConnection(withExternalName(connectionID)),
+			},
+			want: want{
+				cr:  peeringConnection(withExternalName(connectionID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcpeeringconnectionaccepter
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a VPCPeeringConnectionAccepter resource"
+	errKubeUpdateFailed = "cannot update VPCPeeringConnectionAccepter custom resource"
+
+	errNoConnectionID = "vpcPeeringConnectionId is not set"
+	errDescribe       = "failed to describe VPCPeeringConnection"
+	errNotSingleItem  = "either no or multiple VPCPeeringConnections retrieved for the given vpcPeeringConnectionId"
+	errAccept         = "failed to accept the VPCPeeringConnection"
+	errModifyOptions  = "failed to modify the accepter options of the VPCPeeringConnection"
+	errDelete         = "failed to delete the VPCPeeringConnection"
+	errCreateTags     = "failed to create tags for the VPCPeeringConnection"
+	errDeleteTags     = "failed to delete tags for the VPCPeeringConnection"
+)
+
+// SetupVPCPeeringConnectionAccepter adds a controller that reconciles
+// VPCPeeringConnectionAccepters.
+func SetupVPCPeeringConnectionAccepter(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1beta1.VPCPeeringConnectionAccepterGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1beta1.VPCPeeringConnectionAccepter{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.VPCPeeringConnectionAccepterGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewManagementPolicyConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewVPCPeeringConnectionClient})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.VPCPeeringConnectionClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.VPCPeeringConnectionAccepter)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.VPCPeeringConnectionClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1beta1.VPCPeeringConnectionAccepter)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	id := aws.StringValue(cr.Spec.ForProvider.VPCPeeringConnectionID)
+	if id == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, id)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsVPCPeeringConnectionNotFoundErr, err), errDescribe)
+	}
+
+	cr.Status.AtProvider = ec2.GenerateVPCPeeringConnectionObservation(*observed)
+
+	// A connection that still waits for acceptance is treated as not
+	// existing so that it is accepted in Create.
+	switch cr.Status.AtProvider.Status {
+	case v1beta1.VPCPeeringConnectionStatusPendingAcceptance, v1beta1.VPCPeeringConnectionStatusDeleted:
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	case v1beta1.VPCPeeringConnectionStatusActive:
+		cr.SetConditions(xpv1.Available())
+	case v1beta1.VPCPeeringConnectionStatusProvisioning:
+		cr.SetConditions(xpv1.Creating())
+	case v1beta1.VPCPeeringConnectionStatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsVPCPeeringConnectionAccepterUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1beta1.VPCPeeringConnectionAccepter)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	id := aws.StringValue(cr.Spec.ForProvider.VPCPeeringConnectionID)
+	if id == "" {
+		return managed.ExternalCreation{}, errors.New(errNoConnectionID)
+	}
+
+	if _, err := e.client.AcceptVpcPeeringConnectionRequest(&awsec2.AcceptVpcPeeringConnectionInput{
+		VpcPeeringConnectionId: aws.String(id),
+	}).Send(ctx); err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errAccept)
+	}
+	meta.SetExternalName(cr, id)
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1beta1.VPCPeeringConnectionAccepter)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	id := aws.StringValue(cr.Spec.ForProvider.VPCPeeringConnectionID)
+	observed, err := e.describe(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+
+	if ec2.IsVPCPeeringConnectionActive(*observed) && !ec2.IsVPCPeeringConnectionOptionsUpToDate(cr.Spec.ForProvider.AccepterOptions, observed.AccepterVpcInfo) {
+		if _, err := e.client.ModifyVpcPeeringConnectionOptionsRequest(&awsec2.ModifyVpcPeeringConnectionOptionsInput{
+			VpcPeeringConnectionId:           aws.String(id),
+			AccepterPeeringConnectionOptions: ec2.GeneratePeeringConnectionOptionsRequest(cr.Spec.ForProvider.AccepterOptions),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyOptions)
+		}
+	}
+
+	// Tags are only managed if any are set, see
+	// ec2.IsVPCPeeringConnectionAccepterUpToDate.
+	if len(cr.Spec.ForProvider.Tags) == 0 {
+		return managed.ExternalUpdate{}, nil
+	}
+	addTags, removeTags := awsclient.DiffEC2Tags(v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{id},
+			Tags:      removeTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags:      addTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1beta1.VPCPeeringConnectionAccepter)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.Status == v1beta1.VPCPeeringConnectionStatusDeleting {
+		return nil
+	}
+
+	_, err := e.client.DeleteVpcPeeringConnectionRequest(&awsec2.DeleteVpcPeeringConnectionInput{
+		VpcPeeringConnectionId: cr.Spec.ForProvider.VPCPeeringConnectionID,
+	}).Send(ctx)
+	return awsclient.Wrap(resource.Ignore(ec2.IsVPCPeeringConnectionNotFoundErr, err), errDelete)
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2.VpcPeeringConnection, error) {
+	resp, err := e.client.DescribeVpcPeeringConnectionsRequest(&awsec2.DescribeVpcPeeringConnectionsInput{
+		VpcPeeringConnectionIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(resp.VpcPeeringConnections) != 1 {
+		return nil, errors.New(errNotSingleItem)
+	}
+	return &resp.VpcPeeringConnections[0], nil
+}
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.VPCPeeringConnectionAccepter)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	tags, err := ec2.MergeTags(ctx, t.kube, mg, cr.Spec.ForProvider.Tags)
+	if err != nil {
+		return err
+	}
+	if tags == nil {
+		return nil
+	}
+	cr.Spec.ForProvider.Tags = tags
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
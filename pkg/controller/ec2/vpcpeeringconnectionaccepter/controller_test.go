@@ -0,0 +1,419 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcpeeringconnectionaccepter
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	connectionID = "pcx-0123456789abcdef0"
+	errBoom      = errors.New("vpc peering connection boomed")
+)
+
+type accepterModifier func(*v1beta1.VPCPeeringConnectionAccepter)
+
+func withExternalName(name string) accepterModifier {
+	return func(r *v1beta1.VPCPeeringConnectionAccepter) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) accepterModifier {
+	return func(r *v1beta1.VPCPeeringConnectionAccepter) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withConnectionID(id *string) accepterModifier {
+	return func(r *v1beta1.VPCPeeringConnectionAccepter) { r.Spec.ForProvider.VPCPeeringConnectionID = id }
+}
+
+func withAccepterOptions(allow bool) accepterModifier {
+	return func(r *v1beta1.VPCPeeringConnectionAccepter) {
+		r.Spec.ForProvider.AccepterOptions = &v1beta1.VPCPeeringConnectionOptions{
+			AllowDNSResolutionFromRemoteVPC: awsclient.Bool(allow, awsclient.FieldRequired),
+		}
+	}
+}
+
+func withStatus(s v1beta1.VPCPeeringConnectionObservation) accepterModifier {
+	return func(r *v1beta1.VPCPeeringConnectionAccepter) { r.Status.AtProvider = s }
+}
+
+func accepter(m ...accepterModifier) *v1beta1.VPCPeeringConnectionAccepter {
+	cr := &v1beta1.VPCPeeringConnectionAccepter{
+		Spec: v1beta1.VPCPeeringConnectionAccepterSpec{
+			ForProvider: v1beta1.VPCPeeringConnectionAccepterParameters{
+				VPCPeeringConnectionID: aws.String(connectionID),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(code awsec2.VpcPeeringConnectionStateReasonCode) awsec2.VpcPeeringConnection {
+	return awsec2.VpcPeeringConnection{
+		VpcPeeringConnectionId: aws.String(connectionID),
+		Status:                 &awsec2.VpcPeeringConnectionStateReason{Code: code},
+		AccepterVpcInfo:        &awsec2.VpcPeeringConnectionVpcInfo{VpcId: aws.String("vpc-2")},
+		Tags:                   []awsec2.Tag{{Key: aws.String("Name"), Value: aws.String("requester")}},
+	}
+}
+
+func request(err error, data interface{}) *aws.Request {
+	return &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: data}
+}
+
+func describe(c ...awsec2.VpcPeeringConnection) func(*awsec2.DescribeVpcPeeringConnectionsInput) awsec2.DescribeVpcPeeringConnectionsRequest {
+	return func(*awsec2.DescribeVpcPeeringConnectionsInput) awsec2.DescribeVpcPeeringConnectionsRequest {
+		return awsec2.DescribeVpcPeeringConnectionsRequest{
+			Request: request(nil, &awsec2.DescribeVpcPeeringConnectionsOutput{VpcPeeringConnections: c}),
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client ec2.VPCPeeringConnectionClient
+	cr     *v1beta1.VPCPeeringConnectionAccepter
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.VPCPeeringConnectionAccepter
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ConnectionIDEmpty": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{},
+				cr:     accepter(withConnectionID(nil)),
+			},
+			want: want{
+				cr: accepter(withConnectionID(nil)),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: func(*awsec2.DescribeVpcPeeringConnectionsInput) awsec2.DescribeVpcPeeringConnectionsRequest {
+						return awsec2.DescribeVpcPeeringConnectionsRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr:  accepter(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"PendingAcceptance": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(observed(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance)),
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr: accepter(withStatus(v1beta1.VPCPeeringConnectionObservation{
+					VPCPeeringConnectionID: connectionID,
+					Status:                 v1beta1.VPCPeeringConnectionStatusPendingAcceptance,
+				})),
+			},
+		},
+		"ActiveUpToDate": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(observed(awsec2.VpcPeeringConnectionStateReasonCodeActive)),
+				},
+				cr: accepter(withExternalName(connectionID)),
+			},
+			want: want{
+				cr: accepter(withExternalName(connectionID), withConditions(xpv1.Available()),
+					withStatus(v1beta1.VPCPeeringConnectionObservation{
+						VPCPeeringConnectionID: connectionID,
+						Status:                 v1beta1.VPCPeeringConnectionStatusActive,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ActiveOptionsOutdated": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(observed(awsec2.VpcPeeringConnectionStateReasonCodeActive)),
+				},
+				cr: accepter(withExternalName(connectionID), withAccepterOptions(true)),
+			},
+			want: want{
+				cr: accepter(withExternalName(connectionID), withAccepterOptions(true), withConditions(xpv1.Available()),
+					withStatus(v1beta1.VPCPeeringConnectionObservation{
+						VPCPeeringConnectionID: connectionID,
+						Status:                 v1beta1.VPCPeeringConnectionStatusActive,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.VPCPeeringConnectionAccepter
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockAccept: func(input *awsec2.AcceptVpcPeeringConnectionInput) awsec2.AcceptVpcPeeringConnectionRequest {
+						if aws.StringValue(input.VpcPeeringConnectionId) != connectionID {
+							return awsec2.AcceptVpcPeeringConnectionRequest{Request: request(errBoom, nil)}
+						}
+						return awsec2.AcceptVpcPeeringConnectionRequest{Request: request(nil, &awsec2.AcceptVpcPeeringConnectionOutput{})}
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr:     accepter(withExternalName(connectionID)),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"NoConnectionID": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{},
+				cr:     accepter(withConnectionID(nil)),
+			},
+			want: want{
+				cr:  accepter(withConnectionID(nil)),
+				err: errors.New(errNoConnectionID),
+			},
+		},
+		"AcceptError": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockAccept: func(*awsec2.AcceptVpcPeeringConnectionInput) awsec2.AcceptVpcPeeringConnectionRequest {
+						return awsec2.AcceptVpcPeeringConnectionRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr:  accepter(),
+				err: awsclient.Wrap(errBoom, errAccept),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ModifyAccepterOptions": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(observed(awsec2.VpcPeeringConnectionStateReasonCodeActive)),
+					MockModifyOptions: func(input *awsec2.ModifyVpcPeeringConnectionOptionsInput) awsec2.ModifyVpcPeeringConnectionOptionsRequest {
+						if input.RequesterPeeringConnectionOptions != nil || input.AccepterPeeringConnectionOptions == nil {
+							return awsec2.ModifyVpcPeeringConnectionOptionsRequest{Request: request(errBoom, nil)}
+						}
+						return awsec2.ModifyVpcPeeringConnectionOptionsRequest{Request: request(nil, &awsec2.ModifyVpcPeeringConnectionOptionsOutput{})}
+					},
+				},
+				cr: accepter(withExternalName(connectionID), withAccepterOptions(true)),
+			},
+		},
+		"ModifyError": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(observed(awsec2.VpcPeeringConnectionStateReasonCodeActive)),
+					MockModifyOptions: func(*awsec2.ModifyVpcPeeringConnectionOptionsInput) awsec2.ModifyVpcPeeringConnectionOptionsRequest {
+						return awsec2.ModifyVpcPeeringConnectionOptionsRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: accepter(withExternalName(connectionID), withAccepterOptions(true)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errModifyOptions),
+			},
+		},
+		"NoTagsKeepsExternalTags": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(observed(awsec2.VpcPeeringConnectionStateReasonCodeActive)),
+				},
+				cr: accepter(withExternalName(connectionID)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.VPCPeeringConnectionAccepter
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDelete: func(*awsec2.DeleteVpcPeeringConnectionInput) awsec2.DeleteVpcPeeringConnectionRequest {
+						return awsec2.DeleteVpcPeeringConnectionRequest{Request: request(nil, &awsec2.DeleteVpcPeeringConnectionOutput{})}
+					},
+				},
+				cr: accepter(withExternalName(connectionID)),
+			},
+			want: want{
+				cr: accepter(withExternalName(connectionID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDelete: func(*awsec2.DeleteVpcPeeringConnectionInput) awsec2.DeleteVpcPeeringConnectionRequest {
+						return awsec2.DeleteVpcPeeringConnectionRequest{
+							Request: request(awserr.New(ec2.VPCPeeringConnectionIDNotFound, "", nil), nil),
+						}
+					},
+				},
+				cr: accepter(withExternalName(connectionID)),
+			},
+			want: want{
+				cr: accepter(withExternalName(connectionID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDelete: func(*awsec2.DeleteVpcPeeringConnectionInput) awsec2.DeleteVpcPeeringConnectionRequest {
+						return awsec2.DeleteVpcPeeringConnectionRequest{Request: request(errBoom, nil)}
+					},
+				},
+				cr: accepter(withExternalName(connectionID)),
+			},
+			want: want{
+				cr:  accepter(withExternalName(connectionID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,647 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"context"
+	"testing"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/eks"
+	"github.com/crossplane/provider-aws/pkg/clients/eks/fake"
+)
+
+var (
+	addonName    = "vpc-cni"
+	version      = "v1.7.5-eksbuild.2"
+	otherVersion = "v1.8.0-eksbuild.1"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	eks  eks.AddonClient
+	kube client.Client
+	cr   *v1alpha1.Addon
+}
+
+type addonModifier func(*v1alpha1.Addon)
+
+func withConditions(c ...xpv1.Condition) addonModifier {
+	return func(r *v1alpha1.Addon) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withTags(tagMaps ...map[string]string) addonModifier {
+	tags := map[string]string{}
+	for _, tagMap := range tagMaps {
+		for k, v := range tagMap {
+			tags[k] = v
+		}
+	}
+	return func(r *v1alpha1.Addon) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withVersion(v *string) addonModifier {
+	return func(r *v1alpha1.Addon) { r.Spec.ForProvider.AddonVersion = v }
+}
+
+func withStatus(s v1alpha1.AddonStatusType) addonModifier {
+	return func(r *v1alpha1.Addon) { r.Status.AtProvider.Status = s }
+}
+
+func withObservedVersion(v string) addonModifier {
+	return func(r *v1alpha1.Addon) { r.Status.AtProvider.AddonVersion = v }
+}
+
+func withIssues(i ...v1alpha1.AddonIssue) addonModifier {
+	return func(r *v1alpha1.Addon) { r.Status.AtProvider.Health.Issues = i }
+}
+
+func addon(m ...addonModifier) *v1alpha1.Addon {
+	cr := &v1alpha1.Addon{
+		Spec: v1alpha1.AddonSpec{
+			ForProvider: v1alpha1.AddonParameters{
+				AddonName: addonName,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Addon
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *awseks.DescribeAddonInput) (*awseks.DescribeAddonOutput, error) {
+						return &awseks.DescribeAddonOutput{
+							Addon: &awseks.Addon{
+								AddonVersion: &version,
+								Status:       awsv1.String(awseks.AddonStatusActive),
+							},
+						}, nil
+					},
+				},
+				cr: addon(withVersion(&version)),
+			},
+			want: want{
+				cr: addon(
+					withVersion(&version),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.AddonStatusActive),
+					withObservedVersion(version)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DegradedWithIssues": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *awseks.DescribeAddonInput) (*awseks.DescribeAddonOutput, error) {
+						return &awseks.DescribeAddonOutput{
+							Addon: &awseks.Addon{
+								AddonVersion: &version,
+								Health: &awseks.AddonHealth{
+									Issues: []*awseks.AddonIssue{{
+										Code:    awsv1.String(awseks.AddonIssueCodeConfigurationConflict),
+										Message: awsv1.String("conflict"),
+									}},
+								},
+								Status: awsv1.String(awseks.AddonStatusDegraded),
+							},
+						}, nil
+					},
+				},
+				cr: addon(withVersion(&version)),
+			},
+			want: want{
+				cr: addon(
+					withVersion(&version),
+					withConditions(xpv1.Unavailable()),
+					withStatus(v1alpha1.AddonStatusDegraded),
+					withObservedVersion(version),
+					withIssues(v1alpha1.AddonIssue{Code: awseks.AddonIssueCodeConfigurationConflict, Message: "conflict"})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NeedsUpgrade": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *awseks.DescribeAddonInput) (*awseks.DescribeAddonOutput, error) {
+						return &awseks.DescribeAddonOutput{
+							Addon: &awseks.Addon{
+								AddonVersion: &version,
+								Status:       awsv1.String(awseks.AddonStatusActive),
+							},
+						}, nil
+					},
+				},
+				cr: addon(withVersion(&otherVersion)),
+			},
+			want: want{
+				cr: addon(
+					withVersion(&otherVersion),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.AddonStatusActive),
+					withObservedVersion(version)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *awseks.DescribeAddonInput) (*awseks.DescribeAddonOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr:  addon(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"NotFound": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *awseks.DescribeAddonInput) (*awseks.DescribeAddonOutput, error) {
+						return nil, errors.New(awseks.ErrCodeResourceNotFoundException)
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr: addon(),
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *awseks.DescribeAddonInput) (*awseks.DescribeAddonOutput, error) {
+						return &awseks.DescribeAddonOutput{
+							Addon: &awseks.Addon{
+								AddonVersion: &version,
+								Status:       awsv1.String(awseks.AddonStatusCreating),
+							},
+						}, nil
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr: addon(
+					withVersion(&version),
+					withConditions(xpv1.Creating()),
+					withStatus(v1alpha1.AddonStatusCreating),
+					withObservedVersion(version)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitFailedKubeUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *awseks.DescribeAddonInput) (*awseks.DescribeAddonOutput, error) {
+						return &awseks.DescribeAddonOutput{
+							Addon: &awseks.Addon{
+								AddonVersion: &version,
+								Status:       awsv1.String(awseks.AddonStatusCreating),
+							},
+						}, nil
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr:  addon(withVersion(&version)),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eks}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Addon
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockCreateAddon: func(_ *awseks.CreateAddonInput) (*awseks.CreateAddonOutput, error) {
+						return &awseks.CreateAddonOutput{}, nil
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr: addon(withConditions(xpv1.Creating())),
+			},
+		},
+		"SuccessfulNoNeedForCreate": {
+			args: args{
+				cr: addon(withStatus(v1alpha1.AddonStatusCreating)),
+			},
+			want: want{
+				cr: addon(
+					withStatus(v1alpha1.AddonStatusCreating),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockCreateAddon: func(_ *awseks.CreateAddonInput) (*awseks.CreateAddonOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr:  addon(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eks}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Addon
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAddTags": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *awseks.DescribeAddonInput) (*awseks.DescribeAddonOutput, error) {
+						return &awseks.DescribeAddonOutput{
+							Addon: &awseks.Addon{},
+						}, nil
+					},
+					MockTagResource: func(_ *awseks.TagResourceInput) (*awseks.TagResourceOutput, error) {
+						return &awseks.TagResourceOutput{}, nil
+					},
+				},
+				cr: addon(withTags(map[string]string{"foo": "bar"})),
+			},
+			want: want{
+				cr: addon(withTags(map[string]string{"foo": "bar"})),
+			},
+		},
+		"SuccessfulRemoveTags": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *awseks.DescribeAddonInput) (*awseks.DescribeAddonOutput, error) {
+						return &awseks.DescribeAddonOutput{
+							Addon: &awseks.Addon{
+								Tags: awsv1.StringMap(map[string]string{"foo": "bar"}),
+							},
+						}, nil
+					},
+					MockUntagResource: func(_ *awseks.UntagResourceInput) (*awseks.UntagResourceOutput, error) {
+						return &awseks.UntagResourceOutput{}, nil
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr: addon(),
+			},
+		},
+		"SuccessfulUpgrade": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *awseks.DescribeAddonInput) (*awseks.DescribeAddonOutput, error) {
+						return &awseks.DescribeAddonOutput{
+							Addon: &awseks.Addon{
+								AddonVersion: &version,
+							},
+						}, nil
+					},
+					MockUpdateAddon: func(input *awseks.UpdateAddonInput) (*awseks.UpdateAddonOutput, error) {
+						if awsv1.StringValue(input.AddonVersion) != otherVersion {
+							return nil, errBoom
+						}
+						return &awseks.UpdateAddonOutput{}, nil
+					},
+				},
+				cr: addon(withVersion(&otherVersion)),
+			},
+			want: want{
+				cr: addon(withVersion(&otherVersion)),
+			},
+		},
+		"AlreadyModifying": {
+			args: args{
+				cr: addon(withStatus(v1alpha1.AddonStatusUpdating)),
+			},
+			want: want{
+				cr: addon(withStatus(v1alpha1.AddonStatusUpdating)),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *awseks.DescribeAddonInput) (*awseks.DescribeAddonOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr:  addon(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"FailedUpgrade": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *awseks.DescribeAddonInput) (*awseks.DescribeAddonOutput, error) {
+						return &awseks.DescribeAddonOutput{
+							Addon: &awseks.Addon{
+								AddonVersion: &version,
+							},
+						}, nil
+					},
+					MockUpdateAddon: func(_ *awseks.UpdateAddonInput) (*awseks.UpdateAddonOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: addon(withVersion(&otherVersion)),
+			},
+			want: want{
+				cr:  addon(withVersion(&otherVersion)),
+				err: awsclient.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+		"FailedAddTags": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddon: func(_ *awseks.DescribeAddonInput) (*awseks.DescribeAddonOutput, error) {
+						return &awseks.DescribeAddonOutput{
+							Addon: &awseks.Addon{},
+						}, nil
+					},
+					MockTagResource: func(_ *awseks.TagResourceInput) (*awseks.TagResourceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: addon(withTags(map[string]string{"foo": "bar"})),
+			},
+			want: want{
+				cr:  addon(withTags(map[string]string{"foo": "bar"})),
+				err: awsclient.Wrap(errBoom, errAddTagsFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eks}
+			u, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, u); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Addon
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDeleteAddon: func(_ *awseks.DeleteAddonInput) (*awseks.DeleteAddonOutput, error) {
+						return &awseks.DeleteAddonOutput{}, nil
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr: addon(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: addon(withStatus(v1alpha1.AddonStatusDeleting)),
+			},
+			want: want{
+				cr: addon(withStatus(v1alpha1.AddonStatusDeleting),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDeleteAddon: func(_ *awseks.DeleteAddonInput) (*awseks.DeleteAddonOutput, error) {
+						return nil, errors.New(awseks.ErrCodeResourceNotFoundException)
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr: addon(withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDeleteAddon: func(_ *awseks.DeleteAddonInput) (*awseks.DeleteAddonOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr:  addon(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eks}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInitialize(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Addon
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cr:   addon(withTags(map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			},
+			want: want{
+				cr: addon(withTags(resource.GetExternalTags(addon()), map[string]string{"foo": "bar"})),
+			},
+		},
+		"NoTags": {
+			args: args{
+				cr:   addon(),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			},
+			want: want{
+				cr: addon(),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				cr:   addon(withTags(map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &tagger{kube: tc.kube}
+			err := e.Initialize(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); err == nil && diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,10 +19,14 @@ package eks
 import (
 	"context"
 	"reflect"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -45,19 +49,26 @@ const (
 	errNotEKSCluster    = "managed resource is not an EKS cluster custom resource"
 	errKubeUpdateFailed = "cannot update EKS cluster custom resource"
 
+	errCreateSession = "cannot create a new session"
+
 	errCreateFailed        = "cannot create EKS cluster"
 	errUpdateConfigFailed  = "cannot update EKS cluster configuration"
 	errUpdateVersionFailed = "cannot update EKS cluster version"
+	errAssociateEncryption = "cannot associate encryption configuration with EKS cluster"
+	errModifyEncryption    = "encryption configuration of EKS cluster cannot be changed once it is set"
+	errDescribeUpdate      = "cannot describe EKS cluster update"
 	errAddTagsFailed       = "cannot add tags to EKS cluster"
 	errDeleteFailed        = "cannot delete EKS cluster"
 	errDescribeFailed      = "cannot describe EKS cluster"
 	errPatchCreationFailed = "cannot create a patch object"
 	errUpToDateFailed      = "cannot check whether object is up-to-date"
+	errGetConnectionSecret = "cannot get connection secret of EKS cluster"
 )
 
 // SetupCluster adds a controller that reconciles Clusters.
 func SetupCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 	name := managed.ControllerName(v1beta1.ClusterGroupKind)
+	record := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
@@ -67,17 +78,19 @@ func SetupCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter)
 		For(&v1beta1.Cluster{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.ClusterGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: eks.NewEKSClient, newSTSClientFn: eks.NewSTSClient}),
+			managed.WithExternalConnecter(awsclient.NewManagementPolicyConnecter(&connector{kube: mgr.GetClient(), record: record, newClientFn: eks.NewEKSClient, newSTSClientFn: eks.NewSTSClient, newEncryptionClientFn: eks.NewEncryptionConfigClient})),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithLogger(l.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+			managed.WithRecorder(record)))
 }
 
 type connector struct {
-	kube           client.Client
-	newClientFn    func(config aws.Config) eks.Client
-	newSTSClientFn func(config aws.Config) eks.STSClient
+	kube                  client.Client
+	record                event.Recorder
+	newClientFn           func(config aws.Config) eks.Client
+	newSTSClientFn        func(config aws.Config) eks.STSClient
+	newEncryptionClientFn func(sess *session.Session) eks.EncryptionConfigClient
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -89,13 +102,19 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newClientFn(*cfg), sts: c.newSTSClientFn(*cfg), kube: c.kube}, nil
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, aws.StringValue(cr.Spec.ForProvider.Region))
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(*cfg), sts: c.newSTSClientFn(*cfg), encryption: c.newEncryptionClientFn(sess), kube: c.kube, record: c.record}, nil
 }
 
 type external struct {
-	client eks.Client
-	sts    eks.STSClient
-	kube   client.Client
+	client     eks.Client
+	sts        eks.STSClient
+	encryption eks.EncryptionConfigClient
+	kube       client.Client
+	record     event.Recorder
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -117,7 +136,16 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}
 	}
 
+	update := cr.Status.AtProvider.Update
 	cr.Status.AtProvider = eks.GenerateObservation(rsp.Cluster)
+	cr.Status.AtProvider.Update = update
+	if eks.IsUpdateInProgress(update) {
+		u, err := e.client.DescribeUpdateRequest(&awseks.DescribeUpdateInput{Name: aws.String(meta.GetExternalName(cr)), UpdateId: aws.String(update.ID)}).Send(ctx)
+		if err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeUpdate)
+		}
+		cr.Status.AtProvider.Update = eks.GenerateClusterUpdate(u.Update)
+	}
 	switch cr.Status.AtProvider.Status { //nolint:exhaustive
 	case v1beta1.ClusterStatusActive:
 		cr.Status.SetConditions(xpv1.Available())
@@ -132,14 +160,44 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
 	}
+	var drifted []string
+	if !upToDate {
+		if drifted, err = eks.GetDriftedFields(&cr.Spec.ForProvider, rsp.Cluster); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
+		}
+	}
+	awsclient.RecordDrift(e.record, cr, drifted)
+
+	conn, err := e.connectionDetails(ctx, cr, rsp.Cluster)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:    true,
 		ResourceUpToDate:  upToDate,
-		ConnectionDetails: eks.GetConnectionDetails(rsp.Cluster, e.sts),
+		ConnectionDetails: conn,
 	}, nil
 }
 
+// connectionDetails returns the connection details of the supplied cluster,
+// generating a new bearer token only if the one in the existing connection
+// secret is about to expire. The connection secret is patched, so keys that
+// are omitted here keep their current values.
+func (e *external) connectionDetails(ctx context.Context, cr *v1beta1.Cluster, cluster *awseks.Cluster) (managed.ConnectionDetails, error) {
+	if ref := cr.GetWriteConnectionSecretToReference(); ref != nil {
+		s := &corev1.Secret{}
+		err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s)
+		if resource.IgnoreNotFound(err) != nil {
+			return nil, errors.Wrap(err, errGetConnectionSecret)
+		}
+		if err == nil && !eks.TokenNeedsRefresh(s.Data, time.Now()) {
+			return nil, nil
+		}
+	}
+	return eks.GetConnectionDetails(cluster, e.sts), nil
+}
+
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1beta1.Cluster)
 	if !ok {
@@ -162,6 +220,9 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	case v1beta1.ClusterStatusUpdating, v1beta1.ClusterStatusCreating:
 		return managed.ExternalUpdate{}, nil
 	}
+	if eks.IsUpdateInProgress(cr.Status.AtProvider.Update) {
+		return managed.ExternalUpdate{}, nil
+	}
 
 	// NOTE(hasheddan): we have to describe the cluster again because different
 	// fields require different update methods.
@@ -184,12 +245,51 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if err != nil {
 		return managed.ExternalUpdate{}, awsclient.Wrap(err, errPatchCreationFailed)
 	}
+	// EKS only allows one update to be in progress at a time, so we make at
+	// most one of the below requests per reconcile and track its progress in
+	// the status of the cluster.
 	if patch.Version != nil {
-		_, err := e.client.UpdateClusterVersionRequest(&awseks.UpdateClusterVersionInput{Name: awsclient.String(meta.GetExternalName(cr)), Version: patch.Version}).Send(ctx)
-		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateVersionFailed)
+		version := eks.NextClusterVersion(aws.StringValue(rsp.Cluster.Version), aws.StringValue(patch.Version))
+		u, err := e.client.UpdateClusterVersionRequest(&awseks.UpdateClusterVersionInput{Name: awsclient.String(meta.GetExternalName(cr)), Version: aws.String(version)}).Send(ctx)
+		if err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateVersionFailed)
+		}
+		setUpdate(cr, u.Update)
+		return managed.ExternalUpdate{}, nil
+	}
+	if len(patch.EncryptionConfig) != 0 {
+		// EKS can only associate an encryption configuration with a cluster
+		// that has none; it cannot be changed or removed afterwards.
+		if len(rsp.Cluster.EncryptionConfig) != 0 {
+			return managed.ExternalUpdate{}, errors.New(errModifyEncryption)
+		}
+		u, err := e.encryption.AssociateEncryptionConfigWithContext(ctx, eks.GenerateAssociateEncryptionConfigInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+		if err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errAssociateEncryption)
+		}
+		if u.Update != nil && u.Update.Id != nil {
+			cr.Status.AtProvider.Update = eks.GenerateClusterUpdateV1(u.Update)
+		}
+		return managed.ExternalUpdate{}, nil
+	}
+	input := eks.GenerateUpdateClusterConfigInput(meta.GetExternalName(cr), patch, rsp.Cluster)
+	if input == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	u, err := e.client.UpdateClusterConfigRequest(input).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateConfigFailed)
+	}
+	setUpdate(cr, u.Update)
+	return managed.ExternalUpdate{}, nil
+}
+
+// setUpdate records the supplied update in the status of the cluster so that
+// its progress can be tracked.
+func setUpdate(cr *v1beta1.Cluster, u *awseks.Update) {
+	if u != nil && u.Id != nil {
+		cr.Status.AtProvider.Update = eks.GenerateClusterUpdate(u)
 	}
-	_, err = e.client.UpdateClusterConfigRequest(eks.GenerateUpdateClusterConfigInput(meta.GetExternalName(cr), patch)).Send(ctx)
-	return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateConfigFailed)
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -214,11 +314,10 @@ func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
 	if !ok {
 		return errors.New(errNotEKSCluster)
 	}
-	if cr.Spec.ForProvider.Tags == nil {
-		cr.Spec.ForProvider.Tags = map[string]string{}
-	}
-	for k, v := range resource.GetExternalTags(mg) {
-		cr.Spec.ForProvider.Tags[k] = v
+	tags, err := awsclient.MergeTags(ctx, t.kube, mg, cr.Spec.ForProvider.Tags)
+	if err != nil {
+		return err
 	}
+	cr.Spec.ForProvider.Tags = tags
 	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
 }
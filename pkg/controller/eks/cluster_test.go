@@ -20,35 +20,46 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	awseksv1 "github.com/aws/aws-sdk-go/service/eks"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/eks"
 	"github.com/crossplane/provider-aws/pkg/clients/eks/fake"
 )
 
 var (
-	version = "1.16"
+	version  = "1.16"
+	updateID = "update-id"
 
 	errBoom = errors.New("boom")
 )
 
 type args struct {
-	eks  eks.Client
-	kube client.Client
-	cr   *v1beta1.Cluster
+	eks        eks.Client
+	encryption eks.EncryptionConfigClient
+	kube       client.Client
+	cr         *v1beta1.Cluster
 }
 
 type clusterModifier func(*v1beta1.Cluster)
@@ -67,6 +78,16 @@ func withTags(tagMaps ...map[string]string) clusterModifier {
 	return func(r *v1beta1.Cluster) { r.Spec.ForProvider.Tags = tags }
 }
 
+func withProviderConfig(name string) clusterModifier {
+	return func(r *v1beta1.Cluster) { r.SetProviderConfigReference(&xpv1.Reference{Name: name}) }
+}
+
+func withDefaultTags(a string) clusterModifier {
+	return func(r *v1beta1.Cluster) {
+		meta.AddAnnotations(r, map[string]string{awsclient.AnnotationKeyDefaultTags: a})
+	}
+}
+
 func withVersion(v *string) clusterModifier {
 	return func(r *v1beta1.Cluster) { r.Spec.ForProvider.Version = v }
 }
@@ -75,6 +96,29 @@ func withStatus(s v1beta1.ClusterStatusType) clusterModifier {
 	return func(r *v1beta1.Cluster) { r.Status.AtProvider.Status = s }
 }
 
+func withConnectionSecret(name string) clusterModifier {
+	return func(r *v1beta1.Cluster) {
+		r.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Namespace: "default", Name: name})
+	}
+}
+
+func withTokenExpiration(d time.Duration) test.ObjectFn {
+	return func(o client.Object) error {
+		o.(*corev1.Secret).Data = map[string][]byte{
+			eks.ConnectionSecretTokenExpirationKey: []byte(time.Now().Add(d).UTC().Format(time.RFC3339)),
+		}
+		return nil
+	}
+}
+
+func withEncryptionConfig(c ...v1beta1.EncryptionConfig) clusterModifier {
+	return func(r *v1beta1.Cluster) { r.Spec.ForProvider.EncryptionConfig = c }
+}
+
+func withUpdate(u *v1beta1.ClusterUpdate) clusterModifier {
+	return func(r *v1beta1.Cluster) { r.Status.AtProvider.Update = u }
+}
+
 func withConfig(c v1beta1.VpcConfigRequest) clusterModifier {
 	return func(r *v1beta1.Cluster) { r.Spec.ForProvider.ResourcesVpcConfig = c }
 }
@@ -195,6 +239,77 @@ func TestObserve(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errDescribeFailed),
 			},
 		},
+		"SuccessfulDescribeUpdate": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeClusterRequest: func(_ *awseks.DescribeClusterInput) awseks.DescribeClusterRequest {
+						return awseks.DescribeClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeClusterOutput{
+								Cluster: &awseks.Cluster{
+									Status: awseks.ClusterStatusActive,
+								},
+							}},
+						}
+					},
+					MockDescribeUpdateRequest: func(_ *awseks.DescribeUpdateInput) awseks.DescribeUpdateRequest {
+						return awseks.DescribeUpdateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeUpdateOutput{
+								Update: &awseks.Update{
+									Id:     &updateID,
+									Status: awseks.UpdateStatusFailed,
+									Type:   awseks.UpdateTypeVersionUpdate,
+									Errors: []awseks.ErrorDetail{{ErrorCode: awseks.ErrorCodeAccessDenied}},
+								},
+							}},
+						}
+					},
+				},
+				cr: cluster(withUpdate(&v1beta1.ClusterUpdate{ID: updateID, Status: string(awseks.UpdateStatusInProgress)})),
+			},
+			want: want{
+				cr: cluster(
+					withConditions(xpv1.Available()),
+					withStatus(v1beta1.ClusterStatusActive),
+					withUpdate(&v1beta1.ClusterUpdate{
+						ID:     updateID,
+						Status: string(awseks.UpdateStatusFailed),
+						Type:   string(awseks.UpdateTypeVersionUpdate),
+						Errors: []v1beta1.ClusterUpdateError{{ErrorCode: string(awseks.ErrorCodeAccessDenied)}},
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: eks.GetConnectionDetails(&awseks.Cluster{}, &sts.Client{}),
+				},
+			},
+		},
+		"FailedDescribeUpdate": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeClusterRequest: func(_ *awseks.DescribeClusterInput) awseks.DescribeClusterRequest {
+						return awseks.DescribeClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeClusterOutput{
+								Cluster: &awseks.Cluster{
+									Status: awseks.ClusterStatusUpdating,
+								},
+							}},
+						}
+					},
+					MockDescribeUpdateRequest: func(_ *awseks.DescribeUpdateInput) awseks.DescribeUpdateRequest {
+						return awseks.DescribeUpdateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: cluster(withUpdate(&v1beta1.ClusterUpdate{ID: updateID, Status: string(awseks.UpdateStatusInProgress)})),
+			},
+			want: want{
+				cr: cluster(
+					withStatus(v1beta1.ClusterStatusUpdating),
+					withUpdate(&v1beta1.ClusterUpdate{ID: updateID, Status: string(awseks.UpdateStatusInProgress)})),
+				err: awsclient.Wrap(errBoom, errDescribeUpdate),
+			},
+		},
 		"NotFound": {
 			args: args{
 				eks: &fake.MockClient{
@@ -242,6 +357,121 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"TokenStillValid": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, withTokenExpiration(time.Hour)),
+				},
+				eks: &fake.MockClient{
+					MockDescribeClusterRequest: func(_ *awseks.DescribeClusterInput) awseks.DescribeClusterRequest {
+						return awseks.DescribeClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeClusterOutput{
+								Cluster: &awseks.Cluster{
+									Status: awseks.ClusterStatusActive,
+								},
+							}},
+						}
+					},
+				},
+				cr: cluster(withConnectionSecret("secret")),
+			},
+			want: want{
+				cr: cluster(
+					withConnectionSecret("secret"),
+					withConditions(xpv1.Available()),
+					withStatus(v1beta1.ClusterStatusActive)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TokenExpiring": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, withTokenExpiration(eks.TokenRefreshMargin-time.Minute)),
+				},
+				eks: &fake.MockClient{
+					MockDescribeClusterRequest: func(_ *awseks.DescribeClusterInput) awseks.DescribeClusterRequest {
+						return awseks.DescribeClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeClusterOutput{
+								Cluster: &awseks.Cluster{
+									Status: awseks.ClusterStatusActive,
+								},
+							}},
+						}
+					},
+				},
+				cr: cluster(withConnectionSecret("secret")),
+			},
+			want: want{
+				cr: cluster(
+					withConnectionSecret("secret"),
+					withConditions(xpv1.Available()),
+					withStatus(v1beta1.ClusterStatusActive)),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: eks.GetConnectionDetails(&awseks.Cluster{}, &sts.Client{}),
+				},
+			},
+		},
+		"NoConnectionSecret": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "secret")),
+				},
+				eks: &fake.MockClient{
+					MockDescribeClusterRequest: func(_ *awseks.DescribeClusterInput) awseks.DescribeClusterRequest {
+						return awseks.DescribeClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeClusterOutput{
+								Cluster: &awseks.Cluster{
+									Status: awseks.ClusterStatusActive,
+								},
+							}},
+						}
+					},
+				},
+				cr: cluster(withConnectionSecret("secret")),
+			},
+			want: want{
+				cr: cluster(
+					withConnectionSecret("secret"),
+					withConditions(xpv1.Available()),
+					withStatus(v1beta1.ClusterStatusActive)),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: eks.GetConnectionDetails(&awseks.Cluster{}, &sts.Client{}),
+				},
+			},
+		},
+		"GetConnectionSecretFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				eks: &fake.MockClient{
+					MockDescribeClusterRequest: func(_ *awseks.DescribeClusterInput) awseks.DescribeClusterRequest {
+						return awseks.DescribeClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeClusterOutput{
+								Cluster: &awseks.Cluster{
+									Status: awseks.ClusterStatusActive,
+								},
+							}},
+						}
+					},
+				},
+				cr: cluster(withConnectionSecret("secret")),
+			},
+			want: want{
+				cr: cluster(
+					withConnectionSecret("secret"),
+					withConditions(xpv1.Available()),
+					withStatus(v1beta1.ClusterStatusActive)),
+				err: errors.Wrap(errBoom, errGetConnectionSecret),
+			},
+		},
 		"LateInitFailedKubeUpdate": {
 			args: args{
 				kube: &test.MockClient{
@@ -360,6 +590,8 @@ func TestCreate(t *testing.T) {
 }
 
 func TestUpdate(t *testing.T) {
+	createTime := time.Now()
+
 	type want struct {
 		cr     *v1beta1.Cluster
 		result managed.ExternalUpdate
@@ -454,6 +686,11 @@ func TestUpdate(t *testing.T) {
 			args: args{
 				eks: &fake.MockClient{
 					MockUpdateClusterConfigRequest: func(input *awseks.UpdateClusterConfigInput) awseks.UpdateClusterConfigRequest {
+						if input.ResourcesVpcConfig == nil || !aws.BoolValue(input.ResourcesVpcConfig.EndpointPublicAccess) {
+							return awseks.UpdateClusterConfigRequest{
+								Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+							}
+						}
 						return awseks.UpdateClusterConfigRequest{
 							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.UpdateClusterConfigOutput{}},
 						}
@@ -466,12 +703,148 @@ func TestUpdate(t *testing.T) {
 						}
 					},
 				},
+				cr: cluster(withConfig(v1beta1.VpcConfigRequest{EndpointPublicAccess: aws.Bool(true)})),
+			},
+			want: want{
+				cr: cluster(withConfig(v1beta1.VpcConfigRequest{EndpointPublicAccess: aws.Bool(true)})),
+			},
+		},
+		"NothingToUpdate": {
+			args: args{
+				eks: &fake.MockClient{
+					MockUpdateClusterConfigRequest: func(input *awseks.UpdateClusterConfigInput) awseks.UpdateClusterConfigRequest {
+						return awseks.UpdateClusterConfigRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+					MockDescribeClusterRequest: func(input *awseks.DescribeClusterInput) awseks.DescribeClusterRequest {
+						return awseks.DescribeClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeClusterOutput{
+								Cluster: &awseks.Cluster{},
+							}},
+						}
+					},
+				},
 				cr: cluster(withConfig(v1beta1.VpcConfigRequest{SubnetIDs: []string{"subnet"}})),
 			},
 			want: want{
 				cr: cluster(withConfig(v1beta1.VpcConfigRequest{SubnetIDs: []string{"subnet"}})),
 			},
 		},
+		"SuccessfulUpdateVersionOneMinorAtATime": {
+			args: args{
+				eks: &fake.MockClient{
+					MockUpdateClusterVersionRequest: func(input *awseks.UpdateClusterVersionInput) awseks.UpdateClusterVersionRequest {
+						if aws.StringValue(input.Version) != "1.17" {
+							return awseks.UpdateClusterVersionRequest{
+								Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+							}
+						}
+						return awseks.UpdateClusterVersionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.UpdateClusterVersionOutput{
+								Update: &awseks.Update{
+									Id:     &updateID,
+									Status: awseks.UpdateStatusInProgress,
+									Type:   awseks.UpdateTypeVersionUpdate,
+								},
+							}},
+						}
+					},
+					MockDescribeClusterRequest: func(input *awseks.DescribeClusterInput) awseks.DescribeClusterRequest {
+						return awseks.DescribeClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeClusterOutput{
+								Cluster: &awseks.Cluster{Version: &version},
+							}},
+						}
+					},
+				},
+				cr: cluster(withVersion(aws.String("1.18"))),
+			},
+			want: want{
+				cr: cluster(
+					withVersion(aws.String("1.18")),
+					withUpdate(&v1beta1.ClusterUpdate{
+						ID:     updateID,
+						Status: string(awseks.UpdateStatusInProgress),
+						Type:   string(awseks.UpdateTypeVersionUpdate),
+					})),
+			},
+		},
+		"SuccessfulAssociateEncryptionConfig": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeClusterRequest: func(input *awseks.DescribeClusterInput) awseks.DescribeClusterRequest {
+						return awseks.DescribeClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeClusterOutput{
+								Cluster: &awseks.Cluster{},
+							}},
+						}
+					},
+				},
+				encryption: &fake.MockEncryptionConfigClient{
+					MockAssociateEncryptionConfig: func(_ *awseksv1.AssociateEncryptionConfigInput) (*awseksv1.AssociateEncryptionConfigOutput, error) {
+						return &awseksv1.AssociateEncryptionConfigOutput{Update: &awseksv1.Update{
+							CreatedAt: &createTime,
+							Id:        &updateID,
+							Status:    awsv1.String(awseksv1.UpdateStatusInProgress),
+							Type:      awsv1.String(awseksv1.UpdateTypeAssociateEncryptionConfig),
+						}}, nil
+					},
+				},
+				cr: cluster(withEncryptionConfig(v1beta1.EncryptionConfig{Provider: v1beta1.Provider{KeyArn: "key"}, Resources: []string{"secrets"}})),
+			},
+			want: want{
+				cr: cluster(
+					withEncryptionConfig(v1beta1.EncryptionConfig{Provider: v1beta1.Provider{KeyArn: "key"}, Resources: []string{"secrets"}}),
+					withUpdate(&v1beta1.ClusterUpdate{
+						CreatedAt: &metav1.Time{Time: createTime},
+						ID:        updateID,
+						Status:    awseksv1.UpdateStatusInProgress,
+						Type:      awseksv1.UpdateTypeAssociateEncryptionConfig,
+					})),
+			},
+		},
+		"ChangedEncryptionConfig": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeClusterRequest: func(input *awseks.DescribeClusterInput) awseks.DescribeClusterRequest {
+						return awseks.DescribeClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeClusterOutput{
+								Cluster: &awseks.Cluster{EncryptionConfig: []awseks.EncryptionConfig{{Provider: &awseks.Provider{KeyArn: aws.String("old")}, Resources: []string{"secrets"}}}},
+							}},
+						}
+					},
+				},
+				cr: cluster(withEncryptionConfig(v1beta1.EncryptionConfig{Provider: v1beta1.Provider{KeyArn: "key"}, Resources: []string{"secrets"}})),
+			},
+			want: want{
+				cr:  cluster(withEncryptionConfig(v1beta1.EncryptionConfig{Provider: v1beta1.Provider{KeyArn: "key"}, Resources: []string{"secrets"}})),
+				err: errors.New(errModifyEncryption),
+			},
+		},
+		"FailedAssociateEncryptionConfig": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeClusterRequest: func(input *awseks.DescribeClusterInput) awseks.DescribeClusterRequest {
+						return awseks.DescribeClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeClusterOutput{
+								Cluster: &awseks.Cluster{},
+							}},
+						}
+					},
+				},
+				encryption: &fake.MockEncryptionConfigClient{
+					MockAssociateEncryptionConfig: func(_ *awseksv1.AssociateEncryptionConfigInput) (*awseksv1.AssociateEncryptionConfigOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: cluster(withEncryptionConfig(v1beta1.EncryptionConfig{Provider: v1beta1.Provider{KeyArn: "key"}, Resources: []string{"secrets"}})),
+			},
+			want: want{
+				cr:  cluster(withEncryptionConfig(v1beta1.EncryptionConfig{Provider: v1beta1.Provider{KeyArn: "key"}, Resources: []string{"secrets"}})),
+				err: awsclient.Wrap(errBoom, errAssociateEncryption),
+			},
+		},
 		"AlreadyModifying": {
 			args: args{
 				cr: cluster(withStatus(v1beta1.ClusterStatusUpdating)),
@@ -480,6 +853,14 @@ func TestUpdate(t *testing.T) {
 				cr: cluster(withStatus(v1beta1.ClusterStatusUpdating)),
 			},
 		},
+		"UpdateInProgress": {
+			args: args{
+				cr: cluster(withUpdate(&v1beta1.ClusterUpdate{ID: updateID, Status: string(awseks.UpdateStatusInProgress)})),
+			},
+			want: want{
+				cr: cluster(withUpdate(&v1beta1.ClusterUpdate{ID: updateID, Status: string(awseks.UpdateStatusInProgress)})),
+			},
+		},
 		"FailedDescribe": {
 			args: args{
 				eks: &fake.MockClient{
@@ -512,10 +893,10 @@ func TestUpdate(t *testing.T) {
 						}
 					},
 				},
-				cr: cluster(),
+				cr: cluster(withConfig(v1beta1.VpcConfigRequest{EndpointPublicAccess: aws.Bool(true)})),
 			},
 			want: want{
-				cr:  cluster(),
+				cr:  cluster(withConfig(v1beta1.VpcConfigRequest{EndpointPublicAccess: aws.Bool(true)})),
 				err: awsclient.Wrap(errBoom, errUpdateConfigFailed),
 			},
 		},
@@ -594,7 +975,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.eks}
+			e := &external{kube: tc.kube, client: tc.eks, encryption: tc.encryption}
 			u, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -711,6 +1092,53 @@ func TestInitialize(t *testing.T) {
 				cr: cluster(withTags(resource.GetExternalTags(cluster()), (map[string]string{"foo": "bar"}))),
 			},
 		},
+		"DefaultTags": {
+			args: args{
+				cr: cluster(withProviderConfig("default"), withTags(map[string]string{"team": "override"})),
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*awsv1beta1.ProviderConfig).Spec.DefaultTags = map[string]string{"team": "platform", "env": "prod"}
+						return nil
+					},
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+			},
+			want: want{
+				cr: cluster(withProviderConfig("default"), withDefaultTags(`{"env":"prod"}`), withTags(
+					map[string]string{"env": "prod", "team": "override"},
+					resource.GetExternalTags(cluster(withProviderConfig("default"))),
+				)),
+			},
+		},
+		"DefaultTagsChanged": {
+			args: args{
+				cr: cluster(withProviderConfig("default"), withDefaultTags(`{"env":"prod","old":"tag"}`), withTags(
+					map[string]string{"env": "prod", "old": "tag", "team": "override"},
+				)),
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*awsv1beta1.ProviderConfig).Spec.DefaultTags = map[string]string{"team": "platform", "env": "staging"}
+						return nil
+					},
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+			},
+			want: want{
+				cr: cluster(withProviderConfig("default"), withDefaultTags(`{"env":"staging"}`), withTags(
+					map[string]string{"env": "staging", "team": "override"},
+					resource.GetExternalTags(cluster(withProviderConfig("default"))),
+				)),
+			},
+		},
+		"GetProviderConfigFailed": {
+			args: args{
+				cr:   cluster(withProviderConfig("default")),
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, "cannot get referenced ProviderConfig"),
+			},
+		},
 		"UpdateFailed": {
 			args: args{
 				cr:   cluster(),
@@ -0,0 +1,281 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterauth
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/eks"
+)
+
+const (
+	errNotEKSClusterAuth = "managed resource is not an EKS cluster auth custom resource"
+
+	errDescribeCluster  = "cannot describe EKS cluster"
+	errClusterNotReady  = "EKS cluster is not ready"
+	errNewKubeClient    = "cannot create a client for the EKS cluster"
+	errGetConfigMap     = "cannot get aws-auth ConfigMap"
+	errCreateConfigMap  = "cannot create aws-auth ConfigMap"
+	errUpdateConfigMap  = "cannot update aws-auth ConfigMap"
+	errObserveConfigMap = "cannot observe the mappings in aws-auth ConfigMap"
+	errApplyMappings    = "cannot add the mappings to aws-auth ConfigMap"
+	errRemoveMappings   = "cannot remove the mappings from aws-auth ConfigMap"
+)
+
+// SetupClusterAuth adds a controller that reconciles ClusterAuths.
+func SetupClusterAuth(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.ClusterAuthKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.ClusterAuth{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ClusterAuthGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewManagementPolicyConnecter(&connector{kube: mgr.GetClient(), newClientFn: eks.NewEKSClient, newSTSClientFn: eks.NewSTSClient, newKubeClientFn: eks.NewKubeClient})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube            client.Client
+	newClientFn     func(config aws.Config) eks.Client
+	newSTSClientFn  func(config aws.Config) eks.STSClient
+	newKubeClientFn func(kubeconfig []byte) (client.Client, error)
+	clients         kubeClientCache
+}
+
+// A kubeClientCache caches the clients of EKS clusters so that Connect does not
+// build a client, which discovers the API of the cluster, on every reconcile.
+// A client is built again before the bearer token it uses expires.
+type kubeClientCache struct {
+	mu      sync.Mutex
+	clients map[string]cachedKubeClient
+}
+
+type cachedKubeClient struct {
+	client     client.Client
+	expiration time.Time
+}
+
+// get returns the cached client for the supplied key, or nil if there is none
+// or its token expires within eks.TokenRefreshMargin of the supplied time.
+func (c *kubeClientCache) get(key string, now time.Time) client.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cached, ok := c.clients[key]
+	if !ok || !now.Add(eks.TokenRefreshMargin).Before(cached.expiration) {
+		return nil
+	}
+	return cached.client
+}
+
+func (c *kubeClientCache) set(key string, kube client.Client, expiration time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.clients == nil {
+		c.clients = map[string]cachedKubeClient{}
+	}
+	c.clients[key] = cachedKubeClient{client: kube, expiration: expiration}
+}
+
+// kubeClientKey identifies the EKS cluster of the supplied ClusterAuth and the
+// credentials that are used to connect to it.
+func kubeClientKey(cr *v1alpha1.ClusterAuth) string {
+	creds := ""
+	switch {
+	case cr.GetProviderConfigReference() != nil:
+		creds = "providerconfig/" + cr.GetProviderConfigReference().Name
+	case cr.GetProviderReference() != nil:
+		creds = "provider/" + cr.GetProviderReference().Name
+	}
+	return strings.Join([]string{creds, cr.Spec.ForProvider.Region, cr.Spec.ForProvider.ClusterName}, "/")
+}
+
+// Connect builds a client for the EKS cluster using the same kubeconfig that
+// is published as the connection details of the Cluster, which authenticates
+// with the identity of the provider. Clients are cached until their token is
+// about to expire.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ClusterAuth)
+	if !ok {
+		return nil, errors.New(errNotEKSClusterAuth)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	key := kubeClientKey(cr)
+	if kube := c.clients.get(key, time.Now()); kube != nil {
+		return &external{kube: kube}, nil
+	}
+	rsp, err := c.newClientFn(*cfg).DescribeClusterRequest(&awseks.DescribeClusterInput{Name: aws.String(cr.Spec.ForProvider.ClusterName)}).Send(ctx)
+	if err != nil {
+		return nil, awsclient.Wrap(err, errDescribeCluster)
+	}
+	conn := eks.GetConnectionDetails(rsp.Cluster, c.newSTSClientFn(*cfg))
+	kubeconfig, ok := conn[xpv1.ResourceCredentialsSecretKubeconfigKey]
+	if !ok {
+		return nil, errors.New(errClusterNotReady)
+	}
+	kube, err := c.newKubeClientFn(kubeconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewKubeClient)
+	}
+	if exp, err := time.Parse(time.RFC3339, string(conn[eks.ConnectionSecretTokenExpirationKey])); err == nil {
+		c.clients.set(key, kube, exp)
+	}
+	return &external{kube: kube}, nil
+}
+
+// external manages the aws-auth ConfigMap through kube, which is a client of
+// the EKS cluster.
+type external struct {
+	kube client.Client
+}
+
+func (e *external) getConfigMap(ctx context.Context) (*corev1.ConfigMap, error) {
+	cm := &corev1.ConfigMap{}
+	err := e.kube.Get(ctx, types.NamespacedName{Name: eks.AuthConfigMapName, Namespace: eks.AuthConfigMapNamespace}, cm)
+	return cm, err
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterAuth)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEKSClusterAuth)
+	}
+	cm, err := e.getConfigMap(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(kerrors.IsNotFound, err), errGetConfigMap)
+	}
+	// A ClusterAuth that is being deleted exists only as long as the mappings
+	// it recorded need to be removed or restored, so none are desired.
+	p := &cr.Spec.ForProvider
+	if meta.WasDeleted(cr) {
+		p = &v1alpha1.ClusterAuthParameters{}
+	}
+	exists, upToDate, err := eks.ObserveClusterAuth(p, cr.Status.AtProvider, cm)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveConfigMap)
+	}
+	if !exists {
+		return managed.ExternalObservation{}, nil
+	}
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterAuth)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEKSClusterAuth)
+	}
+	cr.SetConditions(xpv1.Creating())
+	cm, err := e.getConfigMap(ctx)
+	if resource.Ignore(kerrors.IsNotFound, err) != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetConfigMap)
+	}
+	if kerrors.IsNotFound(err) {
+		cm = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: eks.AuthConfigMapName, Namespace: eks.AuthConfigMapNamespace}}
+		obs, err := eks.UpsertClusterAuth(&cr.Spec.ForProvider, cr.Status.AtProvider, cm)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errApplyMappings)
+		}
+		if err := e.kube.Create(ctx, cm); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errCreateConfigMap)
+		}
+		cr.Status.AtProvider = obs
+		return managed.ExternalCreation{}, nil
+	}
+	obs, err := eks.UpsertClusterAuth(&cr.Spec.ForProvider, cr.Status.AtProvider, cm)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errApplyMappings)
+	}
+	if err := e.kube.Update(ctx, cm); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errUpdateConfigMap)
+	}
+	cr.Status.AtProvider = obs
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ClusterAuth)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEKSClusterAuth)
+	}
+	cm, err := e.getConfigMap(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetConfigMap)
+	}
+	obs, err := eks.UpsertClusterAuth(&cr.Spec.ForProvider, cr.Status.AtProvider, cm)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errApplyMappings)
+	}
+	if err := e.kube.Update(ctx, cm); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateConfigMap)
+	}
+	cr.Status.AtProvider = obs
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ClusterAuth)
+	if !ok {
+		return errors.New(errNotEKSClusterAuth)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	cm, err := e.getConfigMap(ctx)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(kerrors.IsNotFound, err), errGetConfigMap)
+	}
+	if err := eks.RemoveClusterAuth(cr.Status.AtProvider, cm); err != nil {
+		return errors.Wrap(err, errRemoveMappings)
+	}
+	return errors.Wrap(e.kube.Update(ctx, cm), errUpdateConfigMap)
+}
@@ -0,0 +1,543 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterauth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/eks"
+)
+
+var (
+	roleArn      = "arn:aws:iam::123456789012:role/team"
+	otherRoleArn = "arn:aws:iam::123456789012:role/other"
+
+	roleEntry = `- groups:
+  - system:masters
+  rolearn: arn:aws:iam::123456789012:role/team
+  username: team
+`
+	otherEntry = `- rolearn: arn:aws:iam::123456789012:role/other
+  username: other
+`
+	oldRoleEntry = `- rolearn: arn:aws:iam::123456789012:role/team
+  username: old
+`
+	oldRole = v1alpha1.MapRole{RoleARN: roleArn, Username: "old"}
+
+	deletionTime = metav1.Now()
+
+	errBoom     = errors.New("boom")
+	errNotFound = kerrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "aws-auth")
+)
+
+type args struct {
+	kube client.Client
+	cr   *v1alpha1.ClusterAuth
+}
+
+type clusterAuthModifier func(*v1alpha1.ClusterAuth)
+
+func withConditions(c ...xpv1.Condition) clusterAuthModifier {
+	return func(r *v1alpha1.ClusterAuth) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withAddedRoles(arns ...string) clusterAuthModifier {
+	return func(r *v1alpha1.ClusterAuth) { r.Status.AtProvider.MapRoles = arns }
+}
+
+func withReplacedRoles(r ...v1alpha1.MapRole) clusterAuthModifier {
+	return func(cr *v1alpha1.ClusterAuth) { cr.Status.AtProvider.ReplacedMapRoles = r }
+}
+
+func withDeletionTimestamp() clusterAuthModifier {
+	return func(r *v1alpha1.ClusterAuth) { r.SetDeletionTimestamp(&deletionTime) }
+}
+
+func clusterAuth(m ...clusterAuthModifier) *v1alpha1.ClusterAuth {
+	cr := &v1alpha1.ClusterAuth{
+		Spec: v1alpha1.ClusterAuthSpec{
+			ForProvider: v1alpha1.ClusterAuthParameters{
+				MapRoles: []v1alpha1.MapRole{{
+					RoleARN:  roleArn,
+					Username: "team",
+					Groups:   []string{"system:masters"},
+				}},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withData(data map[string]string) test.ObjectFn {
+	return func(o client.Object) error {
+		o.(*corev1.ConfigMap).Data = data
+		return nil
+	}
+}
+
+func wantData(data map[string]string) test.ObjectFn {
+	return func(o client.Object) error {
+		if diff := cmp.Diff(data, o.(*corev1.ConfigMap).Data); diff != "" {
+			return errors.Errorf("unexpected ConfigMap data: -want, +got:\n%s", diff)
+		}
+		return nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ClusterAuth
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, withData(map[string]string{"mapRoles": otherEntry + roleEntry})),
+				},
+				cr: clusterAuth(),
+			},
+			want: want{
+				cr: clusterAuth(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, withData(map[string]string{"mapRoles": oldRoleEntry})),
+				},
+				cr: clusterAuth(),
+			},
+			want: want{
+				cr: clusterAuth(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"AddedMappingNotDesired": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, withData(map[string]string{"mapRoles": otherEntry + roleEntry})),
+				},
+				cr: clusterAuth(withAddedRoles(otherRoleArn, roleArn)),
+			},
+			want: want{
+				cr: clusterAuth(withAddedRoles(otherRoleArn, roleArn), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DeletedAddedMappingExists": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, withData(map[string]string{"mapRoles": otherEntry + roleEntry})),
+				},
+				cr: clusterAuth(withAddedRoles(roleArn), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: clusterAuth(withAddedRoles(roleArn), withDeletionTimestamp(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DeletedReplacedMappingNotRestored": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, withData(map[string]string{"mapRoles": roleEntry})),
+				},
+				cr: clusterAuth(withReplacedRoles(oldRole), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: clusterAuth(withReplacedRoles(oldRole), withDeletionTimestamp(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DeletedReplacedMappingRestored": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, withData(map[string]string{"mapRoles": otherEntry + oldRoleEntry})),
+				},
+				cr: clusterAuth(withReplacedRoles(oldRole), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: clusterAuth(withReplacedRoles(oldRole), withDeletionTimestamp()),
+			},
+		},
+		"MappingNotFound": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, withData(map[string]string{"mapRoles": otherEntry})),
+				},
+				cr: clusterAuth(),
+			},
+			want: want{
+				cr: clusterAuth(),
+			},
+		},
+		"ConfigMapNotFound": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errNotFound),
+				},
+				cr: clusterAuth(),
+			},
+			want: want{
+				cr: clusterAuth(),
+			},
+		},
+		"FailedGet": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: clusterAuth(),
+			},
+			want: want{
+				cr:  clusterAuth(),
+				err: errors.Wrap(errBoom, errGetConfigMap),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ClusterAuth
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulNewConfigMap": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:    test.NewMockGetFn(errNotFound),
+					MockCreate: test.NewMockCreateFn(nil, wantData(map[string]string{"mapRoles": roleEntry})),
+				},
+				cr: clusterAuth(),
+			},
+			want: want{
+				cr: clusterAuth(withAddedRoles(roleArn), withConditions(xpv1.Creating())),
+			},
+		},
+		"SuccessfulExistingConfigMap": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:    test.NewMockGetFn(nil, withData(map[string]string{"mapRoles": otherEntry})),
+					MockUpdate: test.NewMockUpdateFn(nil, wantData(map[string]string{"mapRoles": otherEntry + roleEntry})),
+				},
+				cr: clusterAuth(),
+			},
+			want: want{
+				cr: clusterAuth(withAddedRoles(roleArn), withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedGet": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: clusterAuth(),
+			},
+			want: want{
+				cr:  clusterAuth(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errGetConfigMap),
+			},
+		},
+		"FailedCreate": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:    test.NewMockGetFn(errNotFound),
+					MockCreate: test.NewMockCreateFn(errBoom),
+				},
+				cr: clusterAuth(),
+			},
+			want: want{
+				cr:  clusterAuth(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateConfigMap),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ClusterAuth
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:    test.NewMockGetFn(nil, withData(map[string]string{"mapRoles": oldRoleEntry})),
+					MockUpdate: test.NewMockUpdateFn(nil, wantData(map[string]string{"mapRoles": roleEntry})),
+				},
+				cr: clusterAuth(),
+			},
+			want: want{
+				cr: clusterAuth(withReplacedRoles(oldRole)),
+			},
+		},
+		"RemoveAddedNotDesired": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:    test.NewMockGetFn(nil, withData(map[string]string{"mapRoles": otherEntry + roleEntry})),
+					MockUpdate: test.NewMockUpdateFn(nil, wantData(map[string]string{"mapRoles": roleEntry})),
+				},
+				cr: clusterAuth(withAddedRoles(otherRoleArn, roleArn)),
+			},
+			want: want{
+				cr: clusterAuth(withAddedRoles(roleArn)),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:    test.NewMockGetFn(nil),
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: clusterAuth(),
+			},
+			want: want{
+				cr:  clusterAuth(),
+				err: errors.Wrap(errBoom, errUpdateConfigMap),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube}
+			u, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, u); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ClusterAuth
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:    test.NewMockGetFn(nil, withData(map[string]string{"mapRoles": otherEntry + roleEntry})),
+					MockUpdate: test.NewMockUpdateFn(nil, wantData(map[string]string{"mapRoles": otherEntry})),
+				},
+				cr: clusterAuth(withAddedRoles(roleArn)),
+			},
+			want: want{
+				cr: clusterAuth(withAddedRoles(roleArn), withConditions(xpv1.Deleting())),
+			},
+		},
+		"KeepMappingsNotAdded": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:    test.NewMockGetFn(nil, withData(map[string]string{"mapRoles": otherEntry + roleEntry})),
+					MockUpdate: test.NewMockUpdateFn(nil, wantData(map[string]string{"mapRoles": otherEntry + roleEntry})),
+				},
+				cr: clusterAuth(),
+			},
+			want: want{
+				cr: clusterAuth(withConditions(xpv1.Deleting())),
+			},
+		},
+		"RestoreReplacedMappings": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:    test.NewMockGetFn(nil, withData(map[string]string{"mapRoles": otherEntry + roleEntry})),
+					MockUpdate: test.NewMockUpdateFn(nil, wantData(map[string]string{"mapRoles": otherEntry + oldRoleEntry})),
+				},
+				cr: clusterAuth(withReplacedRoles(oldRole)),
+			},
+			want: want{
+				cr: clusterAuth(withReplacedRoles(oldRole), withConditions(xpv1.Deleting())),
+			},
+		},
+		"ConfigMapNotFound": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errNotFound),
+				},
+				cr: clusterAuth(),
+			},
+			want: want{
+				cr: clusterAuth(withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:    test.NewMockGetFn(nil, withData(map[string]string{"mapRoles": roleEntry})),
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: clusterAuth(),
+			},
+			want: want{
+				cr:  clusterAuth(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errUpdateConfigMap),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestKubeClientCache(t *testing.T) {
+	now := time.Now()
+	kube := &test.MockClient{}
+
+	cases := map[string]struct {
+		key        string
+		expiration time.Time
+		want       client.Client
+	}{
+		"Cached": {
+			key:        "pc/us-east-1/cluster",
+			expiration: now.Add(eks.TokenLifetime),
+			want:       kube,
+		},
+		"TokenExpiring": {
+			key:        "pc/us-east-1/cluster",
+			expiration: now.Add(eks.TokenRefreshMargin),
+		},
+		"OtherCluster": {
+			key:        "pc/us-east-1/other",
+			expiration: now.Add(eks.TokenLifetime),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &kubeClientCache{}
+			c.set(tc.key, kube, tc.expiration)
+			got := c.get("pc/us-east-1/cluster", now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
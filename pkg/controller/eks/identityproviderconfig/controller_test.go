@@ -0,0 +1,494 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identityproviderconfig
+
+import (
+	"context"
+	"testing"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/eks"
+	"github.com/crossplane/provider-aws/pkg/clients/eks/fake"
+)
+
+var (
+	arn           = "arn:aws:eks:us-east-1:123456789012:identityproviderconfig/cool-cluster/oidc/cool-idp/id"
+	usernameClaim = "email"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	eks  eks.IdentityProviderConfigClient
+	kube client.Client
+	cr   *v1alpha1.IdentityProviderConfig
+}
+
+type idpModifier func(*v1alpha1.IdentityProviderConfig)
+
+func withConditions(c ...xpv1.Condition) idpModifier {
+	return func(r *v1alpha1.IdentityProviderConfig) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withTags(tags map[string]string) idpModifier {
+	return func(r *v1alpha1.IdentityProviderConfig) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withUsernameClaim(c *string) idpModifier {
+	return func(r *v1alpha1.IdentityProviderConfig) { r.Spec.ForProvider.OIDC.UsernameClaim = c }
+}
+
+func withStatus(s v1alpha1.IdentityProviderConfigStatusType) idpModifier {
+	return func(r *v1alpha1.IdentityProviderConfig) { r.Status.AtProvider.Status = s }
+}
+
+func withARN(a string) idpModifier {
+	return func(r *v1alpha1.IdentityProviderConfig) { r.Status.AtProvider.IdentityProviderConfigArn = a }
+}
+
+func idpConfig(m ...idpModifier) *v1alpha1.IdentityProviderConfig {
+	cr := &v1alpha1.IdentityProviderConfig{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.IdentityProviderConfig
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDescribeIdentityProviderConfig: func(_ *awseks.DescribeIdentityProviderConfigInput) (*awseks.DescribeIdentityProviderConfigOutput, error) {
+						return &awseks.DescribeIdentityProviderConfigOutput{
+							IdentityProviderConfig: &awseks.IdentityProviderConfigResponse{
+								Oidc: &awseks.OidcIdentityProviderConfig{
+									IdentityProviderConfigArn: &arn,
+									Status:                    awsv1.String(awseks.ConfigStatusActive),
+								},
+							},
+						}, nil
+					},
+				},
+				cr: idpConfig(),
+			},
+			want: want{
+				cr: idpConfig(
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.IdentityProviderConfigStatusActive),
+					withARN(arn)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsOutdated": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDescribeIdentityProviderConfig: func(_ *awseks.DescribeIdentityProviderConfigInput) (*awseks.DescribeIdentityProviderConfigOutput, error) {
+						return &awseks.DescribeIdentityProviderConfigOutput{
+							IdentityProviderConfig: &awseks.IdentityProviderConfigResponse{
+								Oidc: &awseks.OidcIdentityProviderConfig{
+									Status: awsv1.String(awseks.ConfigStatusCreating),
+								},
+							},
+						}, nil
+					},
+				},
+				cr: idpConfig(withTags(map[string]string{"foo": "bar"})),
+			},
+			want: want{
+				cr: idpConfig(
+					withTags(map[string]string{"foo": "bar"}),
+					withConditions(xpv1.Creating()),
+					withStatus(v1alpha1.IdentityProviderConfigStatusCreating)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDescribeIdentityProviderConfig: func(_ *awseks.DescribeIdentityProviderConfigInput) (*awseks.DescribeIdentityProviderConfigOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: idpConfig(),
+			},
+			want: want{
+				cr:  idpConfig(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"NotFound": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDescribeIdentityProviderConfig: func(_ *awseks.DescribeIdentityProviderConfigInput) (*awseks.DescribeIdentityProviderConfigOutput, error) {
+						return nil, errors.New(awseks.ErrCodeResourceNotFoundException)
+					},
+				},
+				cr: idpConfig(),
+			},
+			want: want{
+				cr: idpConfig(),
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDescribeIdentityProviderConfig: func(_ *awseks.DescribeIdentityProviderConfigInput) (*awseks.DescribeIdentityProviderConfigOutput, error) {
+						return &awseks.DescribeIdentityProviderConfigOutput{
+							IdentityProviderConfig: &awseks.IdentityProviderConfigResponse{
+								Oidc: &awseks.OidcIdentityProviderConfig{
+									Status:        awsv1.String(awseks.ConfigStatusActive),
+									UsernameClaim: &usernameClaim,
+								},
+							},
+						}, nil
+					},
+				},
+				cr: idpConfig(),
+			},
+			want: want{
+				cr: idpConfig(
+					withUsernameClaim(&usernameClaim),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.IdentityProviderConfigStatusActive)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitFailedKubeUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDescribeIdentityProviderConfig: func(_ *awseks.DescribeIdentityProviderConfigInput) (*awseks.DescribeIdentityProviderConfigOutput, error) {
+						return &awseks.DescribeIdentityProviderConfigOutput{
+							IdentityProviderConfig: &awseks.IdentityProviderConfigResponse{
+								Oidc: &awseks.OidcIdentityProviderConfig{
+									UsernameClaim: &usernameClaim,
+								},
+							},
+						}, nil
+					},
+				},
+				cr: idpConfig(),
+			},
+			want: want{
+				cr:  idpConfig(withUsernameClaim(&usernameClaim)),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eks}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.IdentityProviderConfig
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockAssociateIdentityProviderConfig: func(_ *awseks.AssociateIdentityProviderConfigInput) (*awseks.AssociateIdentityProviderConfigOutput, error) {
+						return &awseks.AssociateIdentityProviderConfigOutput{}, nil
+					},
+				},
+				cr: idpConfig(),
+			},
+			want: want{
+				cr: idpConfig(withConditions(xpv1.Creating())),
+			},
+		},
+		"SuccessfulNoNeedForCreate": {
+			args: args{
+				cr: idpConfig(withStatus(v1alpha1.IdentityProviderConfigStatusCreating)),
+			},
+			want: want{
+				cr: idpConfig(
+					withStatus(v1alpha1.IdentityProviderConfigStatusCreating),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockAssociateIdentityProviderConfig: func(_ *awseks.AssociateIdentityProviderConfigInput) (*awseks.AssociateIdentityProviderConfigOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: idpConfig(),
+			},
+			want: want{
+				cr:  idpConfig(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errAssociateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eks}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.IdentityProviderConfig
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	describe := func(_ *awseks.DescribeIdentityProviderConfigInput) (*awseks.DescribeIdentityProviderConfigOutput, error) {
+		return &awseks.DescribeIdentityProviderConfigOutput{
+			IdentityProviderConfig: &awseks.IdentityProviderConfigResponse{
+				Oidc: &awseks.OidcIdentityProviderConfig{
+					IdentityProviderConfigArn: &arn,
+					Tags:                      awsv1.StringMap(map[string]string{"old": "tag"}),
+				},
+			},
+		}, nil
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulTags": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDescribeIdentityProviderConfig: describe,
+					MockTagResource: func(_ *awseks.TagResourceInput) (*awseks.TagResourceOutput, error) {
+						return &awseks.TagResourceOutput{}, nil
+					},
+					MockUntagResource: func(_ *awseks.UntagResourceInput) (*awseks.UntagResourceOutput, error) {
+						return &awseks.UntagResourceOutput{}, nil
+					},
+				},
+				cr: idpConfig(withTags(map[string]string{"foo": "bar"})),
+			},
+			want: want{
+				cr: idpConfig(withTags(map[string]string{"foo": "bar"})),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDescribeIdentityProviderConfig: func(_ *awseks.DescribeIdentityProviderConfigInput) (*awseks.DescribeIdentityProviderConfigOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: idpConfig(),
+			},
+			want: want{
+				cr:  idpConfig(),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"FailedRemoveTags": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDescribeIdentityProviderConfig: describe,
+					MockUntagResource: func(_ *awseks.UntagResourceInput) (*awseks.UntagResourceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: idpConfig(),
+			},
+			want: want{
+				cr:  idpConfig(),
+				err: awsclient.Wrap(errBoom, errRemoveTagsFailed),
+			},
+		},
+		"FailedAddTags": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDescribeIdentityProviderConfig: describe,
+					MockTagResource: func(_ *awseks.TagResourceInput) (*awseks.TagResourceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: idpConfig(withTags(map[string]string{"old": "tag", "foo": "bar"})),
+			},
+			want: want{
+				cr:  idpConfig(withTags(map[string]string{"old": "tag", "foo": "bar"})),
+				err: awsclient.Wrap(errBoom, errAddTagsFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eks}
+			u, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, u); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.IdentityProviderConfig
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDisassociateIdentityProviderConfig: func(_ *awseks.DisassociateIdentityProviderConfigInput) (*awseks.DisassociateIdentityProviderConfigOutput, error) {
+						return &awseks.DisassociateIdentityProviderConfigOutput{}, nil
+					},
+				},
+				cr: idpConfig(),
+			},
+			want: want{
+				cr: idpConfig(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: idpConfig(withStatus(v1alpha1.IdentityProviderConfigStatusDeleting)),
+			},
+			want: want{
+				cr: idpConfig(withStatus(v1alpha1.IdentityProviderConfigStatusDeleting),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDisassociateIdentityProviderConfig: func(_ *awseks.DisassociateIdentityProviderConfigInput) (*awseks.DisassociateIdentityProviderConfigOutput, error) {
+						return nil, errors.New(awseks.ErrCodeResourceNotFoundException)
+					},
+				},
+				cr: idpConfig(),
+			},
+			want: want{
+				cr: idpConfig(withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				eks: &fake.MockIdentityProviderConfigClient{
+					MockDisassociateIdentityProviderConfig: func(_ *awseks.DisassociateIdentityProviderConfigInput) (*awseks.DisassociateIdentityProviderConfigOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: idpConfig(),
+			},
+			want: want{
+				cr:  idpConfig(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDisassociateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eks}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,11 +18,10 @@ package nodegroup
 
 import (
 	"context"
-	"net/http"
 	"testing"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -41,12 +40,14 @@ import (
 var (
 	version           = "1.16"
 	desiredSize int64 = 3
+	ltID              = "lt-0123"
+	ltVersion         = "2"
 
 	errBoom = errors.New("boom")
 )
 
 type args struct {
-	eks  eks.Client
+	eks  eks.NodeGroupClient
 	kube client.Client
 	cr   *v1alpha1.NodeGroup
 }
@@ -75,6 +76,10 @@ func withStatus(s v1alpha1.NodeGroupStatusType) nodeGroupModifier {
 	return func(r *v1alpha1.NodeGroup) { r.Status.AtProvider.Status = s }
 }
 
+func withLaunchTemplate(lt *v1alpha1.LaunchTemplateSpecification) nodeGroupModifier {
+	return func(r *v1alpha1.NodeGroup) { r.Spec.ForProvider.LaunchTemplate = lt }
+}
+
 func withScalingConfig(c *v1alpha1.NodeGroupScalingConfig) nodeGroupModifier {
 	return func(r *v1alpha1.NodeGroup) { r.Spec.ForProvider.ScalingConfig = c }
 }
@@ -103,15 +108,13 @@ func TestObserve(t *testing.T) {
 	}{
 		"SuccessfulAvailable": {
 			args: args{
-				eks: &fake.MockClient{
-					MockDescribeNodegroupRequest: func(_ *awseks.DescribeNodegroupInput) awseks.DescribeNodegroupRequest {
-						return awseks.DescribeNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeNodegroupOutput{
-								Nodegroup: &awseks.Nodegroup{
-									Status: awseks.NodegroupStatusActive,
-								},
-							}},
-						}
+				eks: &fake.MockNodeGroupClient{
+					MockDescribeNodegroup: func(_ *awseks.DescribeNodegroupInput) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awseks.Nodegroup{
+								Status: awsv1.String(awseks.NodegroupStatusActive),
+							},
+						}, nil
 					},
 				},
 				cr: nodeGroup(),
@@ -128,15 +131,13 @@ func TestObserve(t *testing.T) {
 		},
 		"DeletingState": {
 			args: args{
-				eks: &fake.MockClient{
-					MockDescribeNodegroupRequest: func(_ *awseks.DescribeNodegroupInput) awseks.DescribeNodegroupRequest {
-						return awseks.DescribeNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeNodegroupOutput{
-								Nodegroup: &awseks.Nodegroup{
-									Status: awseks.NodegroupStatusDeleting,
-								},
-							}},
-						}
+				eks: &fake.MockNodeGroupClient{
+					MockDescribeNodegroup: func(_ *awseks.DescribeNodegroupInput) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awseks.Nodegroup{
+								Status: awsv1.String(awseks.NodegroupStatusDeleting),
+							},
+						}, nil
 					},
 				},
 				cr: nodeGroup(),
@@ -153,15 +154,13 @@ func TestObserve(t *testing.T) {
 		},
 		"FailedState": {
 			args: args{
-				eks: &fake.MockClient{
-					MockDescribeNodegroupRequest: func(_ *awseks.DescribeNodegroupInput) awseks.DescribeNodegroupRequest {
-						return awseks.DescribeNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeNodegroupOutput{
-								Nodegroup: &awseks.Nodegroup{
-									Status: awseks.NodegroupStatusDegraded,
-								},
-							}},
-						}
+				eks: &fake.MockNodeGroupClient{
+					MockDescribeNodegroup: func(_ *awseks.DescribeNodegroupInput) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awseks.Nodegroup{
+								Status: awsv1.String(awseks.NodegroupStatusDegraded),
+							},
+						}, nil
 					},
 				},
 				cr: nodeGroup(),
@@ -178,11 +177,9 @@ func TestObserve(t *testing.T) {
 		},
 		"FailedDescribeRequest": {
 			args: args{
-				eks: &fake.MockClient{
-					MockDescribeNodegroupRequest: func(_ *awseks.DescribeNodegroupInput) awseks.DescribeNodegroupRequest {
-						return awseks.DescribeNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
-						}
+				eks: &fake.MockNodeGroupClient{
+					MockDescribeNodegroup: func(_ *awseks.DescribeNodegroupInput) (*awseks.DescribeNodegroupOutput, error) {
+						return nil, errBoom
 					},
 				},
 				cr: nodeGroup(),
@@ -194,11 +191,9 @@ func TestObserve(t *testing.T) {
 		},
 		"NotFound": {
 			args: args{
-				eks: &fake.MockClient{
-					MockDescribeNodegroupRequest: func(_ *awseks.DescribeNodegroupInput) awseks.DescribeNodegroupRequest {
-						return awseks.DescribeNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errors.New(awseks.ErrCodeResourceNotFoundException)},
-						}
+				eks: &fake.MockNodeGroupClient{
+					MockDescribeNodegroup: func(_ *awseks.DescribeNodegroupInput) (*awseks.DescribeNodegroupOutput, error) {
+						return nil, errors.New(awseks.ErrCodeResourceNotFoundException)
 					},
 				},
 				cr: nodeGroup(),
@@ -212,16 +207,14 @@ func TestObserve(t *testing.T) {
 				kube: &test.MockClient{
 					MockUpdate: test.NewMockUpdateFn(nil),
 				},
-				eks: &fake.MockClient{
-					MockDescribeNodegroupRequest: func(_ *awseks.DescribeNodegroupInput) awseks.DescribeNodegroupRequest {
-						return awseks.DescribeNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeNodegroupOutput{
-								Nodegroup: &awseks.Nodegroup{
-									Status:  awseks.NodegroupStatusCreating,
-									Version: &version,
-								},
-							}},
-						}
+				eks: &fake.MockNodeGroupClient{
+					MockDescribeNodegroup: func(_ *awseks.DescribeNodegroupInput) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awseks.Nodegroup{
+								Status:  awsv1.String(awseks.NodegroupStatusCreating),
+								Version: &version,
+							},
+						}, nil
 					},
 				},
 				cr: nodeGroup(),
@@ -243,16 +236,14 @@ func TestObserve(t *testing.T) {
 				kube: &test.MockClient{
 					MockUpdate: test.NewMockUpdateFn(errBoom),
 				},
-				eks: &fake.MockClient{
-					MockDescribeNodegroupRequest: func(_ *awseks.DescribeNodegroupInput) awseks.DescribeNodegroupRequest {
-						return awseks.DescribeNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeNodegroupOutput{
-								Nodegroup: &awseks.Nodegroup{
-									Status:  awseks.NodegroupStatusCreating,
-									Version: &version,
-								},
-							}},
-						}
+				eks: &fake.MockNodeGroupClient{
+					MockDescribeNodegroup: func(_ *awseks.DescribeNodegroupInput) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awseks.Nodegroup{
+								Status:  awsv1.String(awseks.NodegroupStatusCreating),
+								Version: &version,
+							},
+						}, nil
 					},
 				},
 				cr: nodeGroup(),
@@ -295,11 +286,9 @@ func TestCreate(t *testing.T) {
 	}{
 		"Successful": {
 			args: args{
-				eks: &fake.MockClient{
-					MockCreateNodegroupRequest: func(input *awseks.CreateNodegroupInput) awseks.CreateNodegroupRequest {
-						return awseks.CreateNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.CreateNodegroupOutput{}},
-						}
+				eks: &fake.MockNodeGroupClient{
+					MockCreateNodegroup: func(input *awseks.CreateNodegroupInput) (*awseks.CreateNodegroupOutput, error) {
+						return &awseks.CreateNodegroupOutput{}, nil
 					},
 				},
 				cr: nodeGroup(),
@@ -321,11 +310,9 @@ func TestCreate(t *testing.T) {
 		},
 		"FailedRequest": {
 			args: args{
-				eks: &fake.MockClient{
-					MockCreateNodegroupRequest: func(input *awseks.CreateNodegroupInput) awseks.CreateNodegroupRequest {
-						return awseks.CreateNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
-						}
+				eks: &fake.MockNodeGroupClient{
+					MockCreateNodegroup: func(input *awseks.CreateNodegroupInput) (*awseks.CreateNodegroupOutput, error) {
+						return nil, errBoom
 					},
 				},
 				cr: nodeGroup(),
@@ -368,23 +355,17 @@ func TestUpdate(t *testing.T) {
 	}{
 		"SuccessfulAddTags": {
 			args: args{
-				eks: &fake.MockClient{
-					MockDescribeNodegroupRequest: func(input *awseks.DescribeNodegroupInput) awseks.DescribeNodegroupRequest {
-						return awseks.DescribeNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeNodegroupOutput{
-								Nodegroup: &awseks.Nodegroup{},
-							}},
-						}
+				eks: &fake.MockNodeGroupClient{
+					MockDescribeNodegroup: func(input *awseks.DescribeNodegroupInput) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awseks.Nodegroup{},
+						}, nil
 					},
-					MockUpdateNodegroupConfigRequest: func(input *awseks.UpdateNodegroupConfigInput) awseks.UpdateNodegroupConfigRequest {
-						return awseks.UpdateNodegroupConfigRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.UpdateNodegroupConfigOutput{}},
-						}
+					MockUpdateNodegroupConfig: func(input *awseks.UpdateNodegroupConfigInput) (*awseks.UpdateNodegroupConfigOutput, error) {
+						return &awseks.UpdateNodegroupConfigOutput{}, nil
 					},
-					MockTagResourceRequest: func(input *awseks.TagResourceInput) awseks.TagResourceRequest {
-						return awseks.TagResourceRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.TagResourceOutput{}},
-						}
+					MockTagResource: func(input *awseks.TagResourceInput) (*awseks.TagResourceOutput, error) {
+						return &awseks.TagResourceOutput{}, nil
 					},
 				},
 				cr: nodeGroup(
@@ -397,23 +378,17 @@ func TestUpdate(t *testing.T) {
 		},
 		"SuccessfulRemoveTags": {
 			args: args{
-				eks: &fake.MockClient{
-					MockDescribeNodegroupRequest: func(input *awseks.DescribeNodegroupInput) awseks.DescribeNodegroupRequest {
-						return awseks.DescribeNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeNodegroupOutput{
-								Nodegroup: &awseks.Nodegroup{},
-							}},
-						}
+				eks: &fake.MockNodeGroupClient{
+					MockDescribeNodegroup: func(input *awseks.DescribeNodegroupInput) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awseks.Nodegroup{},
+						}, nil
 					},
-					MockUpdateNodegroupConfigRequest: func(input *awseks.UpdateNodegroupConfigInput) awseks.UpdateNodegroupConfigRequest {
-						return awseks.UpdateNodegroupConfigRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.UpdateNodegroupConfigOutput{}},
-						}
+					MockUpdateNodegroupConfig: func(input *awseks.UpdateNodegroupConfigInput) (*awseks.UpdateNodegroupConfigOutput, error) {
+						return &awseks.UpdateNodegroupConfigOutput{}, nil
 					},
-					MockUntagResourceRequest: func(input *awseks.UntagResourceInput) awseks.UntagResourceRequest {
-						return awseks.UntagResourceRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.UntagResourceOutput{}},
-						}
+					MockUntagResource: func(input *awseks.UntagResourceInput) (*awseks.UntagResourceOutput, error) {
+						return &awseks.UntagResourceOutput{}, nil
 					},
 				},
 				cr: nodeGroup(),
@@ -424,18 +399,14 @@ func TestUpdate(t *testing.T) {
 		},
 		"SuccessfulUpdateVersion": {
 			args: args{
-				eks: &fake.MockClient{
-					MockUpdateNodegroupVersionRequest: func(input *awseks.UpdateNodegroupVersionInput) awseks.UpdateNodegroupVersionRequest {
-						return awseks.UpdateNodegroupVersionRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.UpdateNodegroupVersionOutput{}},
-						}
+				eks: &fake.MockNodeGroupClient{
+					MockUpdateNodegroupVersion: func(input *awseks.UpdateNodegroupVersionInput) (*awseks.UpdateNodegroupVersionOutput, error) {
+						return &awseks.UpdateNodegroupVersionOutput{}, nil
 					},
-					MockDescribeNodegroupRequest: func(input *awseks.DescribeNodegroupInput) awseks.DescribeNodegroupRequest {
-						return awseks.DescribeNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeNodegroupOutput{
-								Nodegroup: &awseks.Nodegroup{},
-							}},
-						}
+					MockDescribeNodegroup: func(input *awseks.DescribeNodegroupInput) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awseks.Nodegroup{},
+						}, nil
 					},
 				},
 				cr: nodeGroup(withVersion(&version)),
@@ -444,20 +415,42 @@ func TestUpdate(t *testing.T) {
 				cr: nodeGroup(withVersion(&version)),
 			},
 		},
-		"SuccessfulUpdateNodeGroup": {
+		"SuccessfulUpdateLaunchTemplateVersion": {
 			args: args{
-				eks: &fake.MockClient{
-					MockUpdateNodegroupConfigRequest: func(input *awseks.UpdateNodegroupConfigInput) awseks.UpdateNodegroupConfigRequest {
-						return awseks.UpdateNodegroupConfigRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.UpdateNodegroupConfigOutput{}},
+				eks: &fake.MockNodeGroupClient{
+					MockUpdateNodegroupVersion: func(input *awseks.UpdateNodegroupVersionInput) (*awseks.UpdateNodegroupVersionOutput, error) {
+						if awsv1.StringValue(input.LaunchTemplate.Version) != ltVersion {
+							return nil, errBoom
 						}
+						return &awseks.UpdateNodegroupVersionOutput{}, nil
 					},
-					MockDescribeNodegroupRequest: func(input *awseks.DescribeNodegroupInput) awseks.DescribeNodegroupRequest {
-						return awseks.DescribeNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeNodegroupOutput{
-								Nodegroup: &awseks.Nodegroup{},
-							}},
-						}
+					MockDescribeNodegroup: func(input *awseks.DescribeNodegroupInput) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awseks.Nodegroup{
+								LaunchTemplate: &awseks.LaunchTemplateSpecification{
+									Id:      &ltID,
+									Version: awsv1.String("1"),
+								},
+							},
+						}, nil
+					},
+				},
+				cr: nodeGroup(withLaunchTemplate(&v1alpha1.LaunchTemplateSpecification{ID: &ltID, Version: &ltVersion})),
+			},
+			want: want{
+				cr: nodeGroup(withLaunchTemplate(&v1alpha1.LaunchTemplateSpecification{ID: &ltID, Version: &ltVersion})),
+			},
+		},
+		"SuccessfulUpdateNodeGroup": {
+			args: args{
+				eks: &fake.MockNodeGroupClient{
+					MockUpdateNodegroupConfig: func(input *awseks.UpdateNodegroupConfigInput) (*awseks.UpdateNodegroupConfigOutput, error) {
+						return &awseks.UpdateNodegroupConfigOutput{}, nil
+					},
+					MockDescribeNodegroup: func(input *awseks.DescribeNodegroupInput) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awseks.Nodegroup{},
+						}, nil
 					},
 				},
 				cr: nodeGroup(withScalingConfig(&v1alpha1.NodeGroupScalingConfig{DesiredSize: &desiredSize})),
@@ -476,11 +469,9 @@ func TestUpdate(t *testing.T) {
 		},
 		"FailedDescribe": {
 			args: args{
-				eks: &fake.MockClient{
-					MockDescribeNodegroupRequest: func(input *awseks.DescribeNodegroupInput) awseks.DescribeNodegroupRequest {
-						return awseks.DescribeNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
-						}
+				eks: &fake.MockNodeGroupClient{
+					MockDescribeNodegroup: func(input *awseks.DescribeNodegroupInput) (*awseks.DescribeNodegroupOutput, error) {
+						return nil, errBoom
 					},
 				},
 				cr: nodeGroup(),
@@ -492,18 +483,14 @@ func TestUpdate(t *testing.T) {
 		},
 		"FailedUpdateConfig": {
 			args: args{
-				eks: &fake.MockClient{
-					MockUpdateNodegroupConfigRequest: func(input *awseks.UpdateNodegroupConfigInput) awseks.UpdateNodegroupConfigRequest {
-						return awseks.UpdateNodegroupConfigRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
-						}
+				eks: &fake.MockNodeGroupClient{
+					MockUpdateNodegroupConfig: func(input *awseks.UpdateNodegroupConfigInput) (*awseks.UpdateNodegroupConfigOutput, error) {
+						return nil, errBoom
 					},
-					MockDescribeNodegroupRequest: func(input *awseks.DescribeNodegroupInput) awseks.DescribeNodegroupRequest {
-						return awseks.DescribeNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeNodegroupOutput{
-								Nodegroup: &awseks.Nodegroup{},
-							}},
-						}
+					MockDescribeNodegroup: func(input *awseks.DescribeNodegroupInput) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awseks.Nodegroup{},
+						}, nil
 					},
 				},
 				cr: nodeGroup(),
@@ -515,18 +502,14 @@ func TestUpdate(t *testing.T) {
 		},
 		"FailedUpdateVersion": {
 			args: args{
-				eks: &fake.MockClient{
-					MockUpdateNodegroupVersionRequest: func(input *awseks.UpdateNodegroupVersionInput) awseks.UpdateNodegroupVersionRequest {
-						return awseks.UpdateNodegroupVersionRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
-						}
+				eks: &fake.MockNodeGroupClient{
+					MockUpdateNodegroupVersion: func(input *awseks.UpdateNodegroupVersionInput) (*awseks.UpdateNodegroupVersionOutput, error) {
+						return nil, errBoom
 					},
-					MockDescribeNodegroupRequest: func(input *awseks.DescribeNodegroupInput) awseks.DescribeNodegroupRequest {
-						return awseks.DescribeNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeNodegroupOutput{
-								Nodegroup: &awseks.Nodegroup{},
-							}},
-						}
+					MockDescribeNodegroup: func(input *awseks.DescribeNodegroupInput) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awseks.Nodegroup{},
+						}, nil
 					},
 				},
 				cr: nodeGroup(withVersion(&version)),
@@ -538,20 +521,16 @@ func TestUpdate(t *testing.T) {
 		},
 		"FailedRemoveTags": {
 			args: args{
-				eks: &fake.MockClient{
-					MockDescribeNodegroupRequest: func(input *awseks.DescribeNodegroupInput) awseks.DescribeNodegroupRequest {
-						return awseks.DescribeNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeNodegroupOutput{
-								Nodegroup: &awseks.Nodegroup{
-									Tags: map[string]string{"foo": "bar"},
-								},
-							}},
-						}
+				eks: &fake.MockNodeGroupClient{
+					MockDescribeNodegroup: func(input *awseks.DescribeNodegroupInput) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awseks.Nodegroup{
+								Tags: awsv1.StringMap(map[string]string{"foo": "bar"}),
+							},
+						}, nil
 					},
-					MockUntagResourceRequest: func(input *awseks.UntagResourceInput) awseks.UntagResourceRequest {
-						return awseks.UntagResourceRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
-						}
+					MockUntagResource: func(input *awseks.UntagResourceInput) (*awseks.UntagResourceOutput, error) {
+						return nil, errBoom
 					},
 				},
 				cr: nodeGroup(),
@@ -563,18 +542,14 @@ func TestUpdate(t *testing.T) {
 		},
 		"FailedAddTags": {
 			args: args{
-				eks: &fake.MockClient{
-					MockDescribeNodegroupRequest: func(input *awseks.DescribeNodegroupInput) awseks.DescribeNodegroupRequest {
-						return awseks.DescribeNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeNodegroupOutput{
-								Nodegroup: &awseks.Nodegroup{},
-							}},
-						}
+				eks: &fake.MockNodeGroupClient{
+					MockDescribeNodegroup: func(input *awseks.DescribeNodegroupInput) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awseks.Nodegroup{},
+						}, nil
 					},
-					MockTagResourceRequest: func(input *awseks.TagResourceInput) awseks.TagResourceRequest {
-						return awseks.TagResourceRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
-						}
+					MockTagResource: func(input *awseks.TagResourceInput) (*awseks.TagResourceOutput, error) {
+						return nil, errBoom
 					},
 				},
 				cr: nodeGroup(withTags(map[string]string{"foo": "bar"})),
@@ -616,11 +591,9 @@ func TestDelete(t *testing.T) {
 	}{
 		"Successful": {
 			args: args{
-				eks: &fake.MockClient{
-					MockDeleteNodegroupRequest: func(input *awseks.DeleteNodegroupInput) awseks.DeleteNodegroupRequest {
-						return awseks.DeleteNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DeleteNodegroupOutput{}},
-						}
+				eks: &fake.MockNodeGroupClient{
+					MockDeleteNodegroup: func(input *awseks.DeleteNodegroupInput) (*awseks.DeleteNodegroupOutput, error) {
+						return &awseks.DeleteNodegroupOutput{}, nil
 					},
 				},
 				cr: nodeGroup(),
@@ -640,11 +613,9 @@ func TestDelete(t *testing.T) {
 		},
 		"AlreadyDeleted": {
 			args: args{
-				eks: &fake.MockClient{
-					MockDeleteNodegroupRequest: func(input *awseks.DeleteNodegroupInput) awseks.DeleteNodegroupRequest {
-						return awseks.DeleteNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errors.New(awseks.ErrCodeResourceNotFoundException)},
-						}
+				eks: &fake.MockNodeGroupClient{
+					MockDeleteNodegroup: func(input *awseks.DeleteNodegroupInput) (*awseks.DeleteNodegroupOutput, error) {
+						return nil, errors.New(awseks.ErrCodeResourceNotFoundException)
 					},
 				},
 				cr: nodeGroup(),
@@ -655,11 +626,9 @@ func TestDelete(t *testing.T) {
 		},
 		"Failed": {
 			args: args{
-				eks: &fake.MockClient{
-					MockDeleteNodegroupRequest: func(input *awseks.DeleteNodegroupInput) awseks.DeleteNodegroupRequest {
-						return awseks.DeleteNodegroupRequest{
-							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
-						}
+				eks: &fake.MockNodeGroupClient{
+					MockDeleteNodegroup: func(input *awseks.DeleteNodegroupInput) (*awseks.DeleteNodegroupOutput, error) {
+						return nil, errBoom
 					},
 				},
 				cr: nodeGroup(),
@@ -18,6 +18,7 @@ package elb
 
 import (
 	"context"
+	"sort"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awselb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
@@ -65,8 +66,9 @@ func SetupELB(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) erro
 		For(&v1alpha1.ELB{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.ELBGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elb.NewClient}),
+			managed.WithExternalConnecter(awsclient.NewManagementPolicyConnecter(&connector{kube: mgr.GetClient(), newClientFn: elb.NewClient})),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithConnectionPublishers(),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -377,3 +379,35 @@ func stringSliceDiff(a, b []string) []string {
 	}
 	return diff
 }
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ELB)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	tagMap := map[string]string{}
+	for _, t := range cr.Spec.ForProvider.Tags {
+		tagMap[t.Key] = aws.StringValue(t.Value)
+	}
+	tagMap, err := awsclient.MergeTagsIfSet(ctx, t.kube, mg, tagMap)
+	if err != nil {
+		return err
+	}
+	if tagMap == nil {
+		return nil
+	}
+	cr.Spec.ForProvider.Tags = make([]v1alpha1.Tag, len(tagMap))
+	i := 0
+	for k, v := range tagMap {
+		cr.Spec.ForProvider.Tags[i] = v1alpha1.Tag{Key: k, Value: aws.String(v)}
+		i++
+	}
+	sort.Slice(cr.Spec.ForProvider.Tags, func(i, j int) bool {
+		return cr.Spec.ForProvider.Tags[i].Key < cr.Spec.ForProvider.Tags[j].Key
+	})
+	return errors.Wrap(t.kube.Update(ctx, cr), errSpecUpdate)
+}
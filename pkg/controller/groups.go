@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+const errFmtUnknownGroup = "unknown API group %q"
+
+// Groups returns the API groups that have AWS controllers in alphabetical
+// order.
+func Groups() []string {
+	groups := make([]string, 0, len(setups))
+	for g := range setups {
+		groups = append(groups, g)
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// ResolveGroups returns the API groups whose controllers should be created
+// given the groups that are explicitly enabled and disabled. All groups are
+// enabled if none are explicitly enabled. Groups may be given either by their
+// full name, such as ec2.aws.crossplane.io, or by their short name, such as
+// ec2, and a single value may contain a comma separated list of groups.
+func ResolveGroups(enable, disable []string) ([]string, error) {
+	enabled, err := parseGroups(enable)
+	if err != nil {
+		return nil, err
+	}
+	disabled, err := parseGroups(disable)
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]string, 0, len(setups))
+	for _, g := range Groups() {
+		if (len(enabled) == 0 || enabled[g]) && !disabled[g] {
+			groups = append(groups, g)
+		}
+	}
+	return groups, nil
+}
+
+func parseGroups(values []string) (map[string]bool, error) {
+	groups := map[string]bool{}
+	for _, v := range values {
+		for _, g := range strings.Split(v, ",") {
+			g = strings.TrimSpace(g)
+			if g == "" {
+				continue
+			}
+			if !strings.Contains(g, ".") {
+				g += "." + awsv1beta1.Group
+			}
+			if _, ok := setups[g]; !ok {
+				return nil, errors.Errorf(errFmtUnknownGroup, g)
+			}
+			groups[g] = true
+		}
+	}
+	return groups, nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis"
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	eksv1beta1 "github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	identityv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	rdsv1alpha1 "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+func TestResolveGroups(t *testing.T) {
+	type args struct {
+		enable  []string
+		disable []string
+	}
+	type want struct {
+		groups []string
+		err    error
+	}
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AllByDefault": {
+			want: want{groups: Groups()},
+		},
+		"Enable": {
+			args: args{enable: []string{"ec2,rds.aws.crossplane.io", " s3 "}},
+			want: want{groups: []string{"ec2.aws.crossplane.io", "rds.aws.crossplane.io", "s3.aws.crossplane.io"}},
+		},
+		"EnableAndDisable": {
+			args: args{enable: []string{"ec2,rds"}, disable: []string{"rds"}},
+			want: want{groups: []string{"ec2.aws.crossplane.io"}},
+		},
+		"UnknownGroup": {
+			args: args{disable: []string{"ec3"}},
+			want: want{err: errors.Errorf(errFmtUnknownGroup, "ec3.aws.crossplane.io")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			groups, err := ResolveGroups(tc.args.enable, tc.args.disable)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ResolveGroups(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.groups, groups); diff != "" {
+				t.Errorf("ResolveGroups(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestCrossGroupReferences ensures that only the controllers of the enabled
+// API groups are set up, and that the references of their managed resources
+// to the managed resources of other API groups can still be resolved because
+// the referenced API types are added to the scheme.
+func TestCrossGroupReferences(t *testing.T) {
+	groups, err := ResolveGroups([]string{"eks"}, nil)
+	if err != nil {
+		t.Fatalf("ResolveGroups(...): %s", err)
+	}
+
+	enabled := setupsForGroups(groups)
+	got := map[string]int{}
+	for g, fns := range enabled {
+		got[g] = len(fns)
+	}
+	want := map[string]int{
+		awsv1beta1.Group: len(setups[awsv1beta1.Group]),
+		eksv1beta1.Group: len(setups[eksv1beta1.Group]),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("setupsForGroups(...): -want, +got:\n%s", diff)
+	}
+
+	s := runtime.NewScheme()
+	if err := apis.AddToSchemeForGroups(s, groups); err != nil {
+		t.Fatalf("apis.AddToSchemeForGroups(...): %s", err)
+	}
+	registered := map[schema.GroupVersionKind]bool{
+		awsv1beta1.ProviderConfigGroupVersionKind: true,
+		eksv1beta1.ClusterGroupVersionKind:        true,
+		ec2v1beta1.SubnetGroupVersionKind:         true,
+		identityv1beta1.IAMRoleGroupVersionKind:   true,
+		rdsv1alpha1.DBClusterGroupVersionKind:     false,
+		sqsv1beta1.QueueGroupVersionKind:          false,
+	}
+	for gvk, want := range registered {
+		if got := s.Recognizes(gvk); got != want {
+			t.Errorf("s.Recognizes(%s): want %t, got %t", gvk, want, got)
+		}
+	}
+
+	role := &identityv1beta1.IAMRole{}
+	role.SetName("role")
+	role.Status.AtProvider.ARN = "arn:aws:iam::123456789012:role/eks"
+
+	subnet := &ec2v1beta1.Subnet{}
+	subnet.SetName("subnet")
+	meta.SetExternalName(subnet, "subnet-0123456789")
+
+	kube := fake.NewClientBuilder().WithScheme(s).WithObjects(role, subnet).Build()
+
+	cr := &eksv1beta1.Cluster{}
+	cr.Spec.ForProvider.RoleArnRef = &xpv1.Reference{Name: "role"}
+	cr.Spec.ForProvider.ResourcesVpcConfig.SubnetIDRefs = []xpv1.Reference{{Name: "subnet"}}
+
+	if err := cr.ResolveReferences(context.Background(), kube); err != nil {
+		t.Fatalf("ResolveReferences(...): %s", err)
+	}
+	if diff := cmp.Diff(role.Status.AtProvider.ARN, cr.Spec.ForProvider.RoleArn); diff != "" {
+		t.Errorf("ResolveReferences(...): -want roleArn, +got roleArn:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"subnet-0123456789"}, cr.Spec.ForProvider.ResourcesVpcConfig.SubnetIDs); diff != "" {
+		t.Errorf("ResolveReferences(...): -want subnetIds, +got subnetIds:\n%s", diff)
+	}
+}
@@ -2,12 +2,14 @@ package key
 
 import (
 	"context"
+	"sort"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/kms"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -22,6 +24,10 @@ import (
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+const (
+	errKubeUpdateFailed = "cannot update Key custom resource"
+)
+
 // SetupKey adds a controller that reconciles Key.
 func SetupKey(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 	name := managed.ControllerName(svcapitypes.KeyGroupKind)
@@ -47,7 +53,8 @@ func SetupKey(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) erro
 		For(&svcapitypes.Key{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.KeyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(awsclients.NewManagementPolicyConnecter(&connector{kube: mgr.GetClient(), opts: opts})),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -312,3 +319,35 @@ func diffTags(spec []*svcapitypes.Tag, current []*svcsdk.Tag) (addTags []*svcsdk
 	}
 	return
 }
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.Key)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	tagMap := map[string]string{}
+	for _, t := range cr.Spec.ForProvider.Tags {
+		tagMap[awsclients.StringValue(t.TagKey)] = awsclients.StringValue(t.TagValue)
+	}
+	tagMap, err := awsclients.MergeTagsIfSet(ctx, t.kube, mg, tagMap)
+	if err != nil {
+		return err
+	}
+	if tagMap == nil {
+		return nil
+	}
+	cr.Spec.ForProvider.Tags = make([]*svcapitypes.Tag, len(tagMap))
+	i := 0
+	for k, v := range tagMap {
+		cr.Spec.ForProvider.Tags[i] = &svcapitypes.Tag{TagKey: awsclients.String(k), TagValue: awsclients.String(v)}
+		i++
+	}
+	sort.Slice(cr.Spec.ForProvider.Tags, func(i, j int) bool {
+		return awsclients.StringValue(cr.Spec.ForProvider.Tags[i].TagKey) < awsclients.StringValue(cr.Spec.ForProvider.Tags[j].TagKey)
+	})
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
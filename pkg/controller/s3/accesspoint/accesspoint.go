@@ -0,0 +1,238 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesspoint
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an AccessPoint resource"
+	errCreateSession    = "cannot create a new session"
+	errGetAccountID     = "failed to determine the account ID of the access point"
+	errGet              = "failed to get the access point"
+	errGetPolicy        = "failed to get the access point policy"
+	errCreate           = "failed to create the access point"
+	errPutPolicy        = "failed to put the access point policy"
+	errDeletePolicy     = "failed to delete the access point policy"
+	errDelete           = "failed to delete the access point"
+	errFormatPolicy     = "failed to format the access point policy"
+)
+
+// SetupAccessPoint adds a controller that reconciles AccessPoints.
+func SetupAccessPoint(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha3.AccessPointGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha3.AccessPoint{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.AccessPointGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewManagementPolicyConnecter(&connector{kube: mgr.GetClient(),
+				newClientFn: s3.NewAccessPointClient, newSTSClientFn: s3.NewCallerIdentityClient})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube           client.Client
+	newClientFn    func(sess *session.Session) s3.AccessPointClient
+	newSTSClientFn func(sess *session.Session) s3.CallerIdentityClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha3.AccessPoint)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), sts: c.newSTSClientFn(sess)}, nil
+}
+
+type external struct {
+	client s3.AccessPointClient
+	sts    s3.CallerIdentityClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.AccessPoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The account that owns the bucket is required by every S3 Control call,
+	// so we default it to the account we are authenticated to.
+	lateInitialized := false
+	if cr.Spec.ForProvider.AccountID == nil {
+		id, err := e.sts.GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{})
+		if err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errGetAccountID)
+		}
+		cr.Spec.ForProvider.AccountID = id.Account
+		lateInitialized = true
+	}
+
+	name := meta.GetExternalName(cr)
+	resp, err := e.client.GetAccessPointWithContext(ctx, &s3control.GetAccessPointInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      aws.String(name),
+	})
+	if err != nil {
+		return managed.ExternalObservation{ResourceLateInitialized: lateInitialized},
+			awsclient.Wrap(resource.Ignore(s3.IsAccessPointNotFound, err), errGet)
+	}
+
+	var current *string
+	policy, err := e.client.GetAccessPointPolicyWithContext(ctx, &s3control.GetAccessPointPolicyInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      aws.String(name),
+	})
+	switch {
+	case s3.IsAccessPointPolicyNotFound(err):
+	case err != nil:
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGetPolicy)
+	default:
+		current = policy.Policy
+	}
+
+	desired, err := s3.FormatAccessPointPolicy(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFormatPolicy)
+	}
+
+	cr.Status.AtProvider = s3.GenerateAccessPointObservation(name, cr.Spec.ForProvider, *resp)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        s3.IsAccessPointPolicyUpToDate(desired, current),
+		ResourceLateInitialized: lateInitialized,
+		ConnectionDetails:       getConnectionDetails(cr),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.AccessPoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	name := meta.GetExternalName(cr)
+	if _, err := e.client.CreateAccessPointWithContext(ctx, s3.GenerateCreateAccessPointInput(name, cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	cr.Status.AtProvider.ARN = s3.AccessPointARN(cr.Spec.ForProvider.Region, aws.StringValue(cr.Spec.ForProvider.AccountID), name)
+
+	desired, err := s3.FormatAccessPointPolicy(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errFormatPolicy)
+	}
+	if desired != nil {
+		if err := e.putPolicy(ctx, cr, desired); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+	return managed.ExternalCreation{ConnectionDetails: getConnectionDetails(cr)}, nil
+}
+
+// Update replaces the policy of the access point. The other parameters of an
+// access point cannot be changed after it is created.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.AccessPoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	desired, err := s3.FormatAccessPointPolicy(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errFormatPolicy)
+	}
+	if desired != nil {
+		return managed.ExternalUpdate{}, e.putPolicy(ctx, cr, desired)
+	}
+
+	_, err = e.client.DeleteAccessPointPolicyWithContext(ctx, &s3control.DeleteAccessPointPolicyInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      aws.String(meta.GetExternalName(cr)),
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(s3.IsAccessPointPolicyNotFound, err), errDeletePolicy)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.AccessPoint)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteAccessPointWithContext(ctx, &s3control.DeleteAccessPointInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(s3.IsAccessPointNotFound, err), errDelete)
+}
+
+func (e *external) putPolicy(ctx context.Context, cr *v1alpha3.AccessPoint, policy *string) error {
+	_, err := e.client.PutAccessPointPolicyWithContext(ctx, &s3control.PutAccessPointPolicyInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      aws.String(meta.GetExternalName(cr)),
+		Policy:    policy,
+	})
+	return awsclient.Wrap(err, errPutPolicy)
+}
+
+func getConnectionDetails(cr *v1alpha3.AccessPoint) managed.ConnectionDetails {
+	conn := managed.ConnectionDetails{
+		s3.ConnectionKeyAccessPointARN:  []byte(cr.Status.AtProvider.ARN),
+		s3.ConnectionKeyAccessPointName: []byte(meta.GetExternalName(cr)),
+	}
+	// The alias is only known once the access point has been observed.
+	if cr.Status.AtProvider.Alias != "" {
+		conn[s3.ConnectionKeyAccessPointAlias] = []byte(cr.Status.AtProvider.Alias)
+	}
+	return conn
+}
@@ -0,0 +1,494 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesspoint
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	accountID      = "123456789012"
+	apName         = "team-a"
+	apARN          = "arn:aws:s3:us-east-1:123456789012:accesspoint/team-a"
+	apAlias        = "team-a-abcdefghijklmnopqrstuvwxyz0123-s3alias"
+	rawPolicy      = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+	created        = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	s3  s3.AccessPointClient
+	sts s3.CallerIdentityClient
+	cr  resource.Managed
+}
+
+type accessPointModifier func(*v1alpha3.AccessPoint)
+
+func withConditions(c ...xpv1.Condition) accessPointModifier {
+	return func(r *v1alpha3.AccessPoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withAccountID(id *string) accessPointModifier {
+	return func(r *v1alpha3.AccessPoint) { r.Spec.ForProvider.AccountID = id }
+}
+
+func withRawPolicy(p *string) accessPointModifier {
+	return func(r *v1alpha3.AccessPoint) { r.Spec.ForProvider.RawPolicy = p }
+}
+
+func withObservation(o v1alpha3.AccessPointObservation) accessPointModifier {
+	return func(r *v1alpha3.AccessPoint) { r.Status.AtProvider = o }
+}
+
+func accessPoint(m ...accessPointModifier) *v1alpha3.AccessPoint {
+	cr := &v1alpha3.AccessPoint{
+		Spec: v1alpha3.AccessPointSpec{
+			ForProvider: v1alpha3.AccessPointParameters{
+				Region:     "us-east-1",
+				AccountID:  &accountID,
+				BucketName: aws.String("data-lake"),
+			},
+		},
+	}
+	meta.SetExternalName(cr, apName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func connectionDetails() managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		s3.ConnectionKeyAccessPointARN:  []byte(apARN),
+		s3.ConnectionKeyAccessPointName: []byte(apName),
+	}
+}
+
+func connectionDetailsWithAlias() managed.ConnectionDetails {
+	conn := connectionDetails()
+	conn[s3.ConnectionKeyAccessPointAlias] = []byte(apAlias)
+	return conn
+}
+
+func getAccessPoint(err error) func(*s3control.GetAccessPointInput) (*s3control.GetAccessPointOutput, error) {
+	return func(*s3control.GetAccessPointInput) (*s3control.GetAccessPointOutput, error) {
+		if err != nil {
+			return nil, err
+		}
+		return &s3control.GetAccessPointOutput{
+			Alias:         aws.String(apAlias),
+			Bucket:        aws.String("data-lake"),
+			Name:          aws.String(apName),
+			NetworkOrigin: aws.String(s3control.NetworkOriginInternet),
+			CreationDate:  &created,
+		}, nil
+	}
+}
+
+func getPolicy(policy *string, err error) func(*s3control.GetAccessPointPolicyInput) (*s3control.GetAccessPointPolicyOutput, error) {
+	return func(*s3control.GetAccessPointPolicyInput) (*s3control.GetAccessPointPolicyOutput, error) {
+		return &s3control.GetAccessPointPolicyOutput{Policy: policy}, err
+	}
+}
+
+func putPolicy(err error) func(*s3control.PutAccessPointPolicyInput) (*s3control.PutAccessPointPolicyOutput, error) {
+	return func(*s3control.PutAccessPointPolicyInput) (*s3control.PutAccessPointPolicyOutput, error) {
+		return &s3control.PutAccessPointPolicyOutput{}, err
+	}
+}
+
+func observation() v1alpha3.AccessPointObservation {
+	t := metav1.NewTime(created)
+	return v1alpha3.AccessPointObservation{
+		ARN:           apARN,
+		Alias:         apAlias,
+		NetworkOrigin: s3control.NetworkOriginInternet,
+		CreationDate:  &t,
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"LateInitAccountID": {
+			args: args{
+				sts: &fake.MockCallerIdentityClient{
+					MockGetCallerIdentity: func(*sts.GetCallerIdentityInput) (*sts.GetCallerIdentityOutput, error) {
+						return &sts.GetCallerIdentityOutput{Account: &accountID}, nil
+					},
+				},
+				s3: &fake.MockAccessPointClient{
+					MockGetAccessPoint: getAccessPoint(awserr.New(s3.AccessPointNotFoundErrCode, "", nil)),
+				},
+				cr: accessPoint(withAccountID(nil)),
+			},
+			want: want{
+				cr:     accessPoint(),
+				result: managed.ExternalObservation{ResourceLateInitialized: true},
+			},
+		},
+		"AccountIDError": {
+			args: args{
+				sts: &fake.MockCallerIdentityClient{
+					MockGetCallerIdentity: func(*sts.GetCallerIdentityInput) (*sts.GetCallerIdentityOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPoint(withAccountID(nil)),
+			},
+			want: want{
+				cr:  accessPoint(withAccountID(nil)),
+				err: awsclient.Wrap(errBoom, errGetAccountID),
+			},
+		},
+		"GetError": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockGetAccessPoint: getAccessPoint(errBoom),
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"UpToDateWithoutPolicy": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockGetAccessPoint:       getAccessPoint(nil),
+					MockGetAccessPointPolicy: getPolicy(nil, awserr.New(s3.AccessPointPolicyNotFoundErrCode, "", nil)),
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(withConditions(xpv1.Available()), withObservation(observation())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetailsWithAlias(),
+				},
+			},
+		},
+		"PolicyChanged": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockGetAccessPoint:       getAccessPoint(nil),
+					MockGetAccessPointPolicy: getPolicy(aws.String(`{"Version":"2012-10-17"}`), nil),
+				},
+				cr: accessPoint(withRawPolicy(&rawPolicy)),
+			},
+			want: want{
+				cr: accessPoint(withRawPolicy(&rawPolicy), withConditions(xpv1.Available()), withObservation(observation())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetailsWithAlias(),
+				},
+			},
+		},
+		"GetPolicyError": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockGetAccessPoint:       getAccessPoint(nil),
+					MockGetAccessPointPolicy: getPolicy(nil, errBoom),
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(),
+				err: awsclient.Wrap(errBoom, errGetPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, sts: tc.sts}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"SuccessfulWithPolicy": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockCreateAccessPoint: func(*s3control.CreateAccessPointInput) (*s3control.CreateAccessPointOutput, error) {
+						return &s3control.CreateAccessPointOutput{}, nil
+					},
+					MockPutAccessPointPolicy: putPolicy(nil),
+				},
+				cr: accessPoint(withRawPolicy(&rawPolicy)),
+			},
+			want: want{
+				cr: accessPoint(withRawPolicy(&rawPolicy), withConditions(xpv1.Creating()),
+					withObservation(v1alpha3.AccessPointObservation{ARN: apARN})),
+				result: managed.ExternalCreation{ConnectionDetails: connectionDetails()},
+			},
+		},
+		"CreateError": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockCreateAccessPoint: func(*s3control.CreateAccessPointInput) (*s3control.CreateAccessPointOutput, error) {
+						return &s3control.CreateAccessPointOutput{}, errBoom
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+		"PutPolicyError": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockCreateAccessPoint: func(*s3control.CreateAccessPointInput) (*s3control.CreateAccessPointOutput, error) {
+						return &s3control.CreateAccessPointOutput{}, nil
+					},
+					MockPutAccessPointPolicy: putPolicy(errBoom),
+				},
+				cr: accessPoint(withRawPolicy(&rawPolicy)),
+			},
+			want: want{
+				cr: accessPoint(withRawPolicy(&rawPolicy), withConditions(xpv1.Creating()),
+					withObservation(v1alpha3.AccessPointObservation{ARN: apARN})),
+				err: awsclient.Wrap(errBoom, errPutPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, sts: tc.sts}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PutPolicy": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockPutAccessPointPolicy: putPolicy(nil),
+				},
+				cr: accessPoint(withRawPolicy(&rawPolicy)),
+			},
+		},
+		"PutPolicyError": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockPutAccessPointPolicy: putPolicy(errBoom),
+				},
+				cr: accessPoint(withRawPolicy(&rawPolicy)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errPutPolicy),
+			},
+		},
+		"DeletePolicy": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockDeleteAccessPointPolicy: func(*s3control.DeleteAccessPointPolicyInput) (*s3control.DeleteAccessPointPolicyOutput, error) {
+						return &s3control.DeleteAccessPointPolicyOutput{}, nil
+					},
+				},
+				cr: accessPoint(),
+			},
+		},
+		"DeletePolicyError": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockDeleteAccessPointPolicy: func(*s3control.DeleteAccessPointPolicyInput) (*s3control.DeleteAccessPointPolicyOutput, error) {
+						return &s3control.DeleteAccessPointPolicyOutput{}, errBoom
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDeletePolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, sts: tc.sts}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockDeleteAccessPoint: func(*s3control.DeleteAccessPointInput) (*s3control.DeleteAccessPointOutput, error) {
+						return &s3control.DeleteAccessPointOutput{}, nil
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockDeleteAccessPoint: func(*s3control.DeleteAccessPointInput) (*s3control.DeleteAccessPointOutput, error) {
+						return &s3control.DeleteAccessPointOutput{}, awserr.New(s3.AccessPointNotFoundErrCode, "", nil)
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockAccessPointClient{
+					MockDeleteAccessPoint: func(*s3control.DeleteAccessPointInput) (*s3control.DeleteAccessPointOutput, error) {
+						return &s3control.DeleteAccessPointOutput{}, errBoom
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, sts: tc.sts}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
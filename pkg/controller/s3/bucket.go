@@ -18,9 +18,12 @@ package s3
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	k8serrors "k8s.io/apimachinery/pkg/util/errors"
@@ -49,13 +52,17 @@ const (
 	errCreate           = "failed to create the Bucket"
 	errCreateOrUpdate   = "cannot create or update"
 	errDelete           = "cannot delete"
+	errEmpty            = "cannot empty the Bucket"
 	errKubeUpdateFailed = "cannot update S3 custom resource"
+
+	msgFmtEmptying = "Emptying bucket before deletion, deleted %d objects in the last pass"
 )
 
 // SetupBucket adds a controller that reconciles Buckets.
 func SetupBucket(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 	name := managed.ControllerName(v1beta1.BucketGroupKind)
 	logger := l.WithValues("controller", name)
+	record := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
@@ -64,16 +71,19 @@ func SetupBucket(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) e
 		For(&v1beta1.Bucket{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.BucketGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: s3.NewClient, logger: logger}),
+			managed.WithExternalConnecter(awsclient.NewManagementPolicyConnecter(&connector{kube: mgr.GetClient(), newClientFn: s3.NewClient, newClientV1Fn: s3.NewClientV1, logger: logger, record: record})),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithLogger(logger),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+			managed.WithRecorder(record)))
 }
 
 type connector struct {
-	kube        client.Client
-	newClientFn func(config aws.Config) s3.BucketClient
-	logger      logging.Logger
+	kube          client.Client
+	newClientFn   func(config aws.Config) s3.BucketClient
+	newClientV1Fn func(sess *session.Session) s3.BucketClientV1
+	logger        logging.Logger
+	record        event.Recorder
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -85,8 +95,12 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.LocationConstraint)
+	if err != nil {
+		return nil, err
+	}
 	s3client := c.newClientFn(*cfg)
-	return &external{s3client: s3client, subresourceClients: bucket.NewSubresourceClients(s3client), kube: c.kube, logger: c.logger}, nil
+	return &external{s3client: s3client, subresourceClients: bucket.NewSubresourceClients(s3client, c.newClientV1Fn(sess)), kube: c.kube, logger: c.logger, record: c.record}, nil
 }
 
 type external struct {
@@ -94,6 +108,7 @@ type external struct {
 	s3client           s3.BucketClient
 	logger             logging.Logger
 	subresourceClients []bucket.SubresourceClient
+	record             event.Recorder
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint: gocyclo
@@ -126,15 +141,20 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		lateInit = true
 	}
 
+	var drifted []string
 	for _, awsClient := range e.subresourceClients {
 		obs, err := awsClient.Observe(ctx, cr)
 		if err != nil {
 			return managed.ExternalObservation{}, err
 		}
 		if obs != bucket.Updated {
-			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ResourceLateInitialized: lateInit}, nil
+			drifted = append(drifted, bucket.SubresourceField(awsClient))
 		}
 	}
+	awsclient.RecordDrift(e.record, cr, drifted)
+	if len(drifted) > 0 {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ResourceLateInitialized: lateInit}, nil
+	}
 
 	// TODO: smarter updating for the bucket, we dont need to update the ACL every time
 	err := s3.UpdateBucketACL(ctx, e.s3client, cr)
@@ -222,6 +242,51 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
+	if aws.BoolValue(cr.Spec.ForProvider.ForceDestroy) {
+		deleted, empty, err := s3.EmptyBucket(ctx, e.s3client, meta.GetExternalName(cr))
+		if err != nil {
+			return awsclient.Wrap(err, errEmpty)
+		}
+		// NOTE: The bucket is deleted only once a pass finds it empty, so
+		// that large buckets are drained across several reconciles.
+		if !empty {
+			cr.Status.SetConditions(xpv1.Deleting().WithMessage(fmt.Sprintf(msgFmtEmptying, deleted)))
+			return nil
+		}
+	}
 	_, err := e.s3client.DeleteBucketRequest(&awss3.DeleteBucketInput{Bucket: aws.String(meta.GetExternalName(cr))}).Send(ctx)
 	return resource.Ignore(s3.IsNotFound, err)
 }
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.Bucket)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	tagMap := map[string]string{}
+	if cr.Spec.ForProvider.BucketTagging != nil {
+		for _, t := range cr.Spec.ForProvider.BucketTagging.TagSet {
+			tagMap[t.Key] = t.Value
+		}
+	}
+	tagMap, err := awsclient.MergeTagsIfSet(ctx, t.kube, mg, tagMap)
+	if err != nil {
+		return err
+	}
+	if tagMap == nil {
+		return nil
+	}
+	tagging := &v1beta1.Tagging{TagSet: make([]v1beta1.Tag, 0, len(tagMap))}
+	for k, v := range tagMap {
+		tagging.TagSet = append(tagging.TagSet, v1beta1.Tag{Key: k, Value: v})
+	}
+	sort.Slice(tagging.TagSet, func(i, j int) bool {
+		return tagging.TagSet[i].Key < tagging.TagSet[j].Key
+	})
+	cr.Spec.ForProvider.BucketTagging = tagging
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
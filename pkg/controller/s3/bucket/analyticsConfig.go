@@ -0,0 +1,231 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"sort"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	analyticsListFailed   = "cannot list Bucket analytics configurations"
+	analyticsPutFailed    = "cannot put Bucket analytics configuration"
+	analyticsDeleteFailed = "cannot delete Bucket analytics configuration"
+)
+
+// AnalyticsConfigurationClient is the client for API methods and reconciling the AnalyticsConfigurations
+type AnalyticsConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewAnalyticsConfigurationClient creates the client for Analytics Configurations
+func NewAnalyticsConfigurationClient(client s3.BucketClient) *AnalyticsConfigurationClient {
+	return &AnalyticsConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *AnalyticsConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.list(ctx, meta.GetExternalName(bucket))
+	if err != nil {
+		return NeedsUpdate, awsclient.Wrap(err, analyticsListFailed)
+	}
+	local := bucket.Spec.ForProvider.AnalyticsConfigurations
+	switch {
+	case len(local) == 0 && len(external) == 0:
+		return Updated, nil
+	case len(local) == 0 && len(external) != 0:
+		return NeedsDeletion, nil
+	}
+	sortByID := cmpopts.SortSlices(func(a, b v1beta1.AnalyticsConfiguration) bool { return a.ID < b.ID })
+	sortTags := cmpopts.SortSlices(func(a, b v1beta1.Tag) bool { return a.Key < b.Key })
+	if cmp.Equal(local, GenerateLocalAnalyticsConfigurations(external), sortByID, sortTags, cmpopts.EquateEmpty()) {
+		return Updated, nil
+	}
+	return NeedsUpdate, nil
+}
+
+// CreateOrUpdate puts every desired configuration and removes the external
+// configurations that are no longer desired.
+func (in *AnalyticsConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	name := meta.GetExternalName(bucket)
+	external, err := in.list(ctx, name)
+	if err != nil {
+		return awsclient.Wrap(err, analyticsListFailed)
+	}
+	desired := map[string]bool{}
+	for _, config := range bucket.Spec.ForProvider.AnalyticsConfigurations {
+		desired[config.ID] = true
+		if _, err := in.client.PutBucketAnalyticsConfigurationRequest(GeneratePutBucketAnalyticsConfigurationInput(name, config)).Send(ctx); err != nil {
+			return awsclient.Wrap(err, analyticsPutFailed)
+		}
+	}
+	for _, c := range external {
+		if desired[awsclient.StringValue(c.Id)] {
+			continue
+		}
+		if err := in.delete(ctx, name, c.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete creates the request to delete the resource on AWS or set it to the default value.
+func (in *AnalyticsConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	name := meta.GetExternalName(bucket)
+	external, err := in.list(ctx, name)
+	if err != nil {
+		return awsclient.Wrap(err, analyticsListFailed)
+	}
+	for _, c := range external {
+		if err := in.delete(ctx, name, c.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LateInitialize is responsible for initializing the resource based on the external value
+func (in *AnalyticsConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, meta.GetExternalName(bucket))
+	if err != nil {
+		return awsclient.Wrap(err, analyticsListFailed)
+	}
+	if len(external) == 0 {
+		return nil
+	}
+	fp := &bucket.Spec.ForProvider
+	if fp.AnalyticsConfigurations == nil {
+		// only run late init if the user has not specified any configuration
+		fp.AnalyticsConfigurations = GenerateLocalAnalyticsConfigurations(external)
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *AnalyticsConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.AnalyticsConfigurations) != 0
+}
+
+func (in *AnalyticsConfigurationClient) list(ctx context.Context, name string) ([]awss3.AnalyticsConfiguration, error) {
+	var configs []awss3.AnalyticsConfiguration
+	input := &awss3.ListBucketAnalyticsConfigurationsInput{Bucket: awsclient.String(name)}
+	for {
+		out, err := in.client.ListBucketAnalyticsConfigurationsRequest(input).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, out.AnalyticsConfigurationList...)
+		if !awsclient.BoolValue(out.IsTruncated) || out.NextContinuationToken == nil {
+			return configs, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+func (in *AnalyticsConfigurationClient) delete(ctx context.Context, name string, id *string) error {
+	_, err := in.client.DeleteBucketAnalyticsConfigurationRequest(
+		&awss3.DeleteBucketAnalyticsConfigurationInput{
+			Bucket: awsclient.String(name),
+			Id:     id,
+		},
+	).Send(ctx)
+	return awsclient.Wrap(err, analyticsDeleteFailed)
+}
+
+// GeneratePutBucketAnalyticsConfigurationInput creates the input for the PutBucketAnalyticsConfiguration request for the S3 Client
+func GeneratePutBucketAnalyticsConfigurationInput(name string, config v1beta1.AnalyticsConfiguration) *awss3.PutBucketAnalyticsConfigurationInput {
+	external := &awss3.AnalyticsConfiguration{
+		Id:                   awsclient.String(config.ID),
+		StorageClassAnalysis: &awss3.StorageClassAnalysis{},
+	}
+	if f := config.Filter; f != nil {
+		external.Filter = &awss3.AnalyticsFilter{Prefix: f.Prefix}
+		if f.Tag != nil {
+			external.Filter.Tag = &awss3.Tag{Key: awsclient.String(f.Tag.Key), Value: awsclient.String(f.Tag.Value)}
+		}
+		if f.And != nil {
+			external.Filter.And = &awss3.AnalyticsAndOperator{Prefix: f.And.Prefix, Tags: s3.CopyTags(f.And.Tags)}
+		}
+	}
+	if e := config.StorageClassAnalysis.DataExport; e != nil {
+		d := e.Destination.S3BucketDestination
+		external.StorageClassAnalysis.DataExport = &awss3.StorageClassAnalysisDataExport{
+			Destination: &awss3.AnalyticsExportDestination{
+				S3BucketDestination: &awss3.AnalyticsS3BucketDestination{
+					Bucket:          awsclient.String(d.Bucket),
+					BucketAccountId: d.BucketAccountID,
+					Format:          awss3.AnalyticsS3ExportFileFormat(d.Format),
+					Prefix:          d.Prefix,
+				},
+			},
+			OutputSchemaVersion: awss3.StorageClassAnalysisSchemaVersion(e.OutputSchemaVersion),
+		}
+	}
+	return &awss3.PutBucketAnalyticsConfigurationInput{
+		Bucket:                 awsclient.String(name),
+		Id:                     awsclient.String(config.ID),
+		AnalyticsConfiguration: external,
+	}
+}
+
+// GenerateLocalAnalyticsConfigurations creates the local representation of
+// the supplied external configurations, sorted by ID.
+func GenerateLocalAnalyticsConfigurations(configs []awss3.AnalyticsConfiguration) []v1beta1.AnalyticsConfiguration {
+	if len(configs) == 0 {
+		return nil
+	}
+	out := make([]v1beta1.AnalyticsConfiguration, len(configs))
+	for i, c := range configs {
+		out[i] = v1beta1.AnalyticsConfiguration{ID: awsclient.StringValue(c.Id)}
+		if f := c.Filter; f != nil {
+			out[i].Filter = &v1beta1.AnalyticsFilter{Prefix: f.Prefix}
+			if f.Tag != nil {
+				out[i].Filter.Tag = &v1beta1.Tag{Key: awsclient.StringValue(f.Tag.Key), Value: awsclient.StringValue(f.Tag.Value)}
+			}
+			if f.And != nil {
+				out[i].Filter.And = &v1beta1.AnalyticsAndOperator{Prefix: f.And.Prefix, Tags: s3.CopyAWSTags(f.And.Tags)}
+			}
+		}
+		if c.StorageClassAnalysis != nil && c.StorageClassAnalysis.DataExport != nil {
+			e := c.StorageClassAnalysis.DataExport
+			out[i].StorageClassAnalysis.DataExport = &v1beta1.StorageClassAnalysisDataExport{
+				OutputSchemaVersion: string(e.OutputSchemaVersion),
+			}
+			if e.Destination != nil && e.Destination.S3BucketDestination != nil {
+				d := e.Destination.S3BucketDestination
+				out[i].StorageClassAnalysis.DataExport.Destination.S3BucketDestination = v1beta1.AnalyticsS3BucketDestination{
+					Bucket:          awsclient.StringValue(d.Bucket),
+					BucketAccountID: d.BucketAccountId,
+					Format:          string(d.Format),
+					Prefix:          d.Prefix,
+				}
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
@@ -0,0 +1,376 @@
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+	s3Testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
+)
+
+var (
+	analyticsID     = "archive"
+	analyticsPrefix = "logs/"
+	analyticsBucket = "arn:aws:s3:::analytics"
+)
+
+func localAnalytics() v1beta1.AnalyticsConfiguration {
+	return v1beta1.AnalyticsConfiguration{
+		ID: analyticsID,
+		Filter: &v1beta1.AnalyticsFilter{And: &v1beta1.AnalyticsAndOperator{
+			Prefix: &analyticsPrefix,
+			Tags:   []v1beta1.Tag{{Key: "k", Value: "v"}},
+		}},
+		StorageClassAnalysis: v1beta1.StorageClassAnalysis{DataExport: &v1beta1.StorageClassAnalysisDataExport{
+			Destination: v1beta1.AnalyticsExportDestination{S3BucketDestination: v1beta1.AnalyticsS3BucketDestination{
+				Bucket: analyticsBucket,
+				Format: string(s3.AnalyticsS3ExportFileFormatCsv),
+			}},
+			OutputSchemaVersion: string(s3.StorageClassAnalysisSchemaVersionV1),
+		}},
+	}
+}
+
+func externalAnalytics(id string) s3.AnalyticsConfiguration {
+	return s3.AnalyticsConfiguration{
+		Filter: &s3.AnalyticsFilter{And: &s3.AnalyticsAndOperator{
+			Prefix: &analyticsPrefix,
+			Tags:   []s3.Tag{{Key: awsclient.String("k"), Value: awsclient.String("v")}},
+		}},
+		Id: awsclient.String(id),
+		StorageClassAnalysis: &s3.StorageClassAnalysis{DataExport: &s3.StorageClassAnalysisDataExport{
+			Destination: &s3.AnalyticsExportDestination{S3BucketDestination: &s3.AnalyticsS3BucketDestination{
+				Bucket: awsclient.String(analyticsBucket),
+				Format: s3.AnalyticsS3ExportFileFormatCsv,
+			}},
+			OutputSchemaVersion: s3.StorageClassAnalysisSchemaVersionV1,
+		}},
+	}
+}
+
+func listAnalyticsConfigs(configs ...s3.AnalyticsConfiguration) func(*s3.ListBucketAnalyticsConfigurationsInput) s3.ListBucketAnalyticsConfigurationsRequest {
+	return func(*s3.ListBucketAnalyticsConfigurationsInput) s3.ListBucketAnalyticsConfigurationsRequest {
+		return s3.ListBucketAnalyticsConfigurationsRequest{
+			Request: s3Testing.CreateRequest(nil, &s3.ListBucketAnalyticsConfigurationsOutput{AnalyticsConfigurationList: configs}),
+		}
+	}
+}
+
+func TestAnalyticsConfigurationClient_Observe(t *testing.T) {
+	type args struct {
+		cl *AnalyticsConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurationsRequest: func(*s3.ListBucketAnalyticsConfigurationsInput) s3.ListBucketAnalyticsConfigurationsRequest {
+						return s3.ListBucketAnalyticsConfigurationsRequest{
+							Request: s3Testing.CreateRequest(errBoom, &s3.ListBucketAnalyticsConfigurationsOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, analyticsListFailed),
+			},
+		},
+		"NotFoundNotNeeded": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurationsRequest: listAnalyticsConfigs(),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NeedsDeletion": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurationsRequest: listAnalyticsConfigs(externalAnalytics(analyticsID)),
+				}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							AnalyticsConfigurations: []v1beta1.AnalyticsConfiguration{localAnalytics()},
+						},
+					},
+				},
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurationsRequest: listAnalyticsConfigs(externalAnalytics("other")),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Updated": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							AnalyticsConfigurations: []v1beta1.AnalyticsConfiguration{localAnalytics()},
+						},
+					},
+				},
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurationsRequest: listAnalyticsConfigs(externalAnalytics(analyticsID)),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAnalyticsConfigurationClient_CreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl *AnalyticsConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err     error
+		deleted []string
+	}
+
+	var deleted []string
+	deleteFn := func(input *s3.DeleteBucketAnalyticsConfigurationInput) s3.DeleteBucketAnalyticsConfigurationRequest {
+		deleted = append(deleted, awsclient.StringValue(input.Id))
+		return s3.DeleteBucketAnalyticsConfigurationRequest{
+			Request: s3Testing.CreateRequest(nil, &s3.DeleteBucketAnalyticsConfigurationOutput{}),
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PutError": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							AnalyticsConfigurations: []v1beta1.AnalyticsConfiguration{localAnalytics()},
+						},
+					},
+				},
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurationsRequest: listAnalyticsConfigs(),
+					MockPutBucketAnalyticsConfigurationRequest: func(*s3.PutBucketAnalyticsConfigurationInput) s3.PutBucketAnalyticsConfigurationRequest {
+						return s3.PutBucketAnalyticsConfigurationRequest{
+							Request: s3Testing.CreateRequest(errBoom, &s3.PutBucketAnalyticsConfigurationOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, analyticsPutFailed),
+			},
+		},
+		"DeletesUndesired": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							AnalyticsConfigurations: []v1beta1.AnalyticsConfiguration{localAnalytics()},
+						},
+					},
+				},
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurationsRequest: listAnalyticsConfigs(externalAnalytics(analyticsID), externalAnalytics("other")),
+					MockPutBucketAnalyticsConfigurationRequest: func(*s3.PutBucketAnalyticsConfigurationInput) s3.PutBucketAnalyticsConfigurationRequest {
+						return s3.PutBucketAnalyticsConfigurationRequest{
+							Request: s3Testing.CreateRequest(nil, &s3.PutBucketAnalyticsConfigurationOutput{}),
+						}
+					},
+					MockDeleteBucketAnalyticsConfigurationRequest: deleteFn,
+				}),
+			},
+			want: want{
+				deleted: []string{"other"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted = nil
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAnalyticsConfigurationClient_Delete(t *testing.T) {
+	type args struct {
+		cl *AnalyticsConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurationsRequest: listAnalyticsConfigs(externalAnalytics(analyticsID)),
+					MockDeleteBucketAnalyticsConfigurationRequest: func(*s3.DeleteBucketAnalyticsConfigurationInput) s3.DeleteBucketAnalyticsConfigurationRequest {
+						return s3.DeleteBucketAnalyticsConfigurationRequest{
+							Request: s3Testing.CreateRequest(errBoom, &s3.DeleteBucketAnalyticsConfigurationOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, analyticsDeleteFailed),
+			},
+		},
+		"NothingToDelete": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurationsRequest: listAnalyticsConfigs(),
+				}),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAnalyticsConfigurationClient_LateInitialize(t *testing.T) {
+	type args struct {
+		cl *AnalyticsConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurationsRequest: func(*s3.ListBucketAnalyticsConfigurationsInput) s3.ListBucketAnalyticsConfigurationsRequest {
+						return s3.ListBucketAnalyticsConfigurationsRequest{
+							Request: s3Testing.CreateRequest(errBoom, &s3.ListBucketAnalyticsConfigurationsOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, analyticsListFailed),
+				cr:  &v1beta1.Bucket{},
+			},
+		},
+		"Success": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurationsRequest: listAnalyticsConfigs(externalAnalytics(analyticsID)),
+				}),
+			},
+			want: want{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							AnalyticsConfigurations: []v1beta1.AnalyticsConfiguration{localAnalytics()},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePutBucketAnalyticsConfigurationInput(t *testing.T) {
+	external := externalAnalytics(analyticsID)
+	got := GeneratePutBucketAnalyticsConfigurationInput("bucket", localAnalytics())
+	want := &s3.PutBucketAnalyticsConfigurationInput{
+		Bucket:                 awsclient.String("bucket"),
+		Id:                     awsclient.String(analyticsID),
+		AnalyticsConfiguration: &external,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"sort"
+
+	awss3v1 "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	intelligentTieringListFailed   = "cannot list Bucket intelligent tiering configurations"
+	intelligentTieringPutFailed    = "cannot put Bucket intelligent tiering configuration"
+	intelligentTieringDeleteFailed = "cannot delete Bucket intelligent tiering configuration"
+)
+
+// IntelligentTieringConfigurationClient is the client for API methods and reconciling the IntelligentTieringConfigurations
+type IntelligentTieringConfigurationClient struct {
+	client s3.BucketClientV1
+}
+
+// NewIntelligentTieringConfigurationClient creates the client for Intelligent-Tiering Configurations
+func NewIntelligentTieringConfigurationClient(client s3.BucketClientV1) *IntelligentTieringConfigurationClient {
+	return &IntelligentTieringConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *IntelligentTieringConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.list(ctx, meta.GetExternalName(bucket))
+	if err != nil {
+		return NeedsUpdate, awsclient.Wrap(err, intelligentTieringListFailed)
+	}
+	local := bucket.Spec.ForProvider.IntelligentTieringConfigurations
+	switch {
+	case len(local) == 0 && len(external) == 0:
+		return Updated, nil
+	case len(local) == 0 && len(external) != 0:
+		return NeedsDeletion, nil
+	}
+	sortByID := cmpopts.SortSlices(func(a, b v1beta1.IntelligentTieringConfiguration) bool { return a.ID < b.ID })
+	if cmp.Equal(local, GenerateLocalIntelligentTieringConfigurations(external), sortByID, cmpopts.EquateEmpty()) {
+		return Updated, nil
+	}
+	return NeedsUpdate, nil
+}
+
+// CreateOrUpdate puts every desired configuration and removes the external
+// configurations that are no longer desired.
+func (in *IntelligentTieringConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	name := meta.GetExternalName(bucket)
+	external, err := in.list(ctx, name)
+	if err != nil {
+		return awsclient.Wrap(err, intelligentTieringListFailed)
+	}
+	desired := map[string]bool{}
+	for i := range bucket.Spec.ForProvider.IntelligentTieringConfigurations {
+		config := bucket.Spec.ForProvider.IntelligentTieringConfigurations[i]
+		desired[config.ID] = true
+		if _, err := in.client.PutBucketIntelligentTieringConfigurationWithContext(ctx, GeneratePutBucketIntelligentTieringConfigurationInput(name, config)); err != nil {
+			return awsclient.Wrap(err, intelligentTieringPutFailed)
+		}
+	}
+	for _, c := range external {
+		if desired[awsclient.StringValue(c.Id)] {
+			continue
+		}
+		if err := in.delete(ctx, name, c.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete creates the request to delete the resource on AWS or set it to the default value.
+func (in *IntelligentTieringConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	name := meta.GetExternalName(bucket)
+	external, err := in.list(ctx, name)
+	if err != nil {
+		return awsclient.Wrap(err, intelligentTieringListFailed)
+	}
+	for _, c := range external {
+		if err := in.delete(ctx, name, c.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LateInitialize is responsible for initializing the resource based on the external value
+func (in *IntelligentTieringConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, meta.GetExternalName(bucket))
+	if err != nil {
+		return awsclient.Wrap(err, intelligentTieringListFailed)
+	}
+	if len(external) == 0 {
+		return nil
+	}
+	fp := &bucket.Spec.ForProvider
+	if fp.IntelligentTieringConfigurations == nil {
+		// only run late init if the user has not specified any configuration
+		fp.IntelligentTieringConfigurations = GenerateLocalIntelligentTieringConfigurations(external)
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *IntelligentTieringConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.IntelligentTieringConfigurations) != 0
+}
+
+func (in *IntelligentTieringConfigurationClient) list(ctx context.Context, name string) ([]*awss3v1.IntelligentTieringConfiguration, error) {
+	var configs []*awss3v1.IntelligentTieringConfiguration
+	input := &awss3v1.ListBucketIntelligentTieringConfigurationsInput{Bucket: awsclient.String(name)}
+	for {
+		out, err := in.client.ListBucketIntelligentTieringConfigurationsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, out.IntelligentTieringConfigurationList...)
+		if !awsclient.BoolValue(out.IsTruncated) || out.NextContinuationToken == nil {
+			return configs, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+func (in *IntelligentTieringConfigurationClient) delete(ctx context.Context, name string, id *string) error {
+	_, err := in.client.DeleteBucketIntelligentTieringConfigurationWithContext(ctx,
+		&awss3v1.DeleteBucketIntelligentTieringConfigurationInput{
+			Bucket: awsclient.String(name),
+			Id:     id,
+		},
+	)
+	return awsclient.Wrap(err, intelligentTieringDeleteFailed)
+}
+
+// GeneratePutBucketIntelligentTieringConfigurationInput creates the input for the PutBucketIntelligentTieringConfiguration request for the S3 Client
+func GeneratePutBucketIntelligentTieringConfigurationInput(name string, config v1beta1.IntelligentTieringConfiguration) *awss3v1.PutBucketIntelligentTieringConfigurationInput {
+	external := &awss3v1.IntelligentTieringConfiguration{
+		Id:       awsclient.String(config.ID),
+		Status:   awsclient.String(config.Status),
+		Tierings: make([]*awss3v1.Tiering, len(config.Tierings)),
+	}
+	for i, t := range config.Tierings {
+		external.Tierings[i] = &awss3v1.Tiering{
+			AccessTier: awsclient.String(t.AccessTier),
+			Days:       awsclient.Int64(int(t.Days)),
+		}
+	}
+	external.Filter = generateIntelligentTieringFilter(config.Filter)
+	return &awss3v1.PutBucketIntelligentTieringConfigurationInput{
+		Bucket:                          awsclient.String(name),
+		Id:                              awsclient.String(config.ID),
+		IntelligentTieringConfiguration: external,
+	}
+}
+
+// generateIntelligentTieringFilter maps the local filter to the external one.
+// S3 requires an And operator whenever more than one predicate is supplied.
+func generateIntelligentTieringFilter(filter *v1beta1.IntelligentTieringFilter) *awss3v1.IntelligentTieringFilter {
+	if filter == nil {
+		return nil
+	}
+	tags := make([]*awss3v1.Tag, len(filter.Tags))
+	for i, t := range filter.Tags {
+		tags[i] = &awss3v1.Tag{Key: awsclient.String(t.Key), Value: awsclient.String(t.Value)}
+	}
+	predicates := len(tags)
+	if filter.Prefix != nil {
+		predicates++
+	}
+	switch {
+	case predicates > 1:
+		return &awss3v1.IntelligentTieringFilter{And: &awss3v1.IntelligentTieringAndOperator{Prefix: filter.Prefix, Tags: tags}}
+	case len(tags) == 1:
+		return &awss3v1.IntelligentTieringFilter{Tag: tags[0]}
+	default:
+		return &awss3v1.IntelligentTieringFilter{Prefix: filter.Prefix}
+	}
+}
+
+// GenerateLocalIntelligentTieringConfigurations creates the local
+// representation of the supplied external configurations, sorted by ID.
+func GenerateLocalIntelligentTieringConfigurations(configs []*awss3v1.IntelligentTieringConfiguration) []v1beta1.IntelligentTieringConfiguration {
+	if len(configs) == 0 {
+		return nil
+	}
+	out := make([]v1beta1.IntelligentTieringConfiguration, 0, len(configs))
+	for _, c := range configs {
+		if c == nil {
+			continue
+		}
+		local := v1beta1.IntelligentTieringConfiguration{
+			ID:       awsclient.StringValue(c.Id),
+			Status:   awsclient.StringValue(c.Status),
+			Filter:   generateLocalIntelligentTieringFilter(c.Filter),
+			Tierings: make([]v1beta1.Tiering, 0, len(c.Tierings)),
+		}
+		for _, t := range c.Tierings {
+			if t == nil {
+				continue
+			}
+			local.Tierings = append(local.Tierings, v1beta1.Tiering{
+				AccessTier: awsclient.StringValue(t.AccessTier),
+				Days:       awsclient.Int64Value(t.Days),
+			})
+		}
+		out = append(out, local)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+func generateLocalIntelligentTieringFilter(filter *awss3v1.IntelligentTieringFilter) *v1beta1.IntelligentTieringFilter {
+	if filter == nil {
+		return nil
+	}
+	out := &v1beta1.IntelligentTieringFilter{Prefix: filter.Prefix}
+	tags := []*awss3v1.Tag{filter.Tag}
+	if filter.And != nil {
+		out.Prefix = filter.And.Prefix
+		tags = filter.And.Tags
+	}
+	for _, t := range tags {
+		if t == nil {
+			continue
+		}
+		out.Tags = append(out.Tags, v1beta1.Tag{Key: awsclient.StringValue(t.Key), Value: awsclient.StringValue(t.Value)})
+	}
+	if out.Prefix == nil && len(out.Tags) == 0 {
+		return nil
+	}
+	return out
+}
@@ -0,0 +1,346 @@
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+)
+
+var (
+	tieringID     = "archive"
+	tieringPrefix = "logs/"
+)
+
+func localTiering() v1beta1.IntelligentTieringConfiguration {
+	return v1beta1.IntelligentTieringConfiguration{
+		ID:       tieringID,
+		Status:   s3.IntelligentTieringStatusEnabled,
+		Filter:   &v1beta1.IntelligentTieringFilter{Prefix: &tieringPrefix, Tags: []v1beta1.Tag{{Key: "k", Value: "v"}}},
+		Tierings: []v1beta1.Tiering{{AccessTier: s3.IntelligentTieringAccessTierArchiveAccess, Days: 90}},
+	}
+}
+
+func externalTiering(id string) *s3.IntelligentTieringConfiguration {
+	return &s3.IntelligentTieringConfiguration{
+		Id:     awsclient.String(id),
+		Status: awsclient.String(s3.IntelligentTieringStatusEnabled),
+		Filter: &s3.IntelligentTieringFilter{And: &s3.IntelligentTieringAndOperator{
+			Prefix: &tieringPrefix,
+			Tags:   []*s3.Tag{{Key: awsclient.String("k"), Value: awsclient.String("v")}},
+		}},
+		Tierings: []*s3.Tiering{{AccessTier: awsclient.String(s3.IntelligentTieringAccessTierArchiveAccess), Days: awsclient.Int64(90)}},
+	}
+}
+
+func listTierings(configs ...*s3.IntelligentTieringConfiguration) func(*s3.ListBucketIntelligentTieringConfigurationsInput) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+	return func(*s3.ListBucketIntelligentTieringConfigurationsInput) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+		return &s3.ListBucketIntelligentTieringConfigurationsOutput{IntelligentTieringConfigurationList: configs}, nil
+	}
+}
+
+func TestIntelligentTieringConfigurationClient_Observe(t *testing.T) {
+	type args struct {
+		cl *IntelligentTieringConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClientV1{
+					MockListBucketIntelligentTieringConfigurations: func(*s3.ListBucketIntelligentTieringConfigurationsInput) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, intelligentTieringListFailed),
+			},
+		},
+		"NotFoundNotNeeded": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClientV1{
+					MockListBucketIntelligentTieringConfigurations: listTierings(),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NeedsDeletion": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClientV1{
+					MockListBucketIntelligentTieringConfigurations: listTierings(externalTiering(tieringID)),
+				}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							IntelligentTieringConfigurations: []v1beta1.IntelligentTieringConfiguration{localTiering()},
+						},
+					},
+				},
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClientV1{
+					MockListBucketIntelligentTieringConfigurations: listTierings(externalTiering("other")),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Updated": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							IntelligentTieringConfigurations: []v1beta1.IntelligentTieringConfiguration{localTiering()},
+						},
+					},
+				},
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClientV1{
+					MockListBucketIntelligentTieringConfigurations: listTierings(externalTiering(tieringID)),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntelligentTieringConfigurationClient_CreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl *IntelligentTieringConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err     error
+		deleted []string
+	}
+
+	var deleted []string
+	deleteFn := func(input *s3.DeleteBucketIntelligentTieringConfigurationInput) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+		deleted = append(deleted, awsclient.StringValue(input.Id))
+		return &s3.DeleteBucketIntelligentTieringConfigurationOutput{}, nil
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PutError": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							IntelligentTieringConfigurations: []v1beta1.IntelligentTieringConfiguration{localTiering()},
+						},
+					},
+				},
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClientV1{
+					MockListBucketIntelligentTieringConfigurations: listTierings(),
+					MockPutBucketIntelligentTieringConfiguration: func(*s3.PutBucketIntelligentTieringConfigurationInput) (*s3.PutBucketIntelligentTieringConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, intelligentTieringPutFailed),
+			},
+		},
+		"DeletesUndesired": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							IntelligentTieringConfigurations: []v1beta1.IntelligentTieringConfiguration{localTiering()},
+						},
+					},
+				},
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClientV1{
+					MockListBucketIntelligentTieringConfigurations: listTierings(externalTiering(tieringID), externalTiering("other")),
+					MockPutBucketIntelligentTieringConfiguration: func(*s3.PutBucketIntelligentTieringConfigurationInput) (*s3.PutBucketIntelligentTieringConfigurationOutput, error) {
+						return &s3.PutBucketIntelligentTieringConfigurationOutput{}, nil
+					},
+					MockDeleteBucketIntelligentTieringConfiguration: deleteFn,
+				}),
+			},
+			want: want{
+				deleted: []string{"other"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted = nil
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntelligentTieringConfigurationClient_Delete(t *testing.T) {
+	type args struct {
+		cl *IntelligentTieringConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClientV1{
+					MockListBucketIntelligentTieringConfigurations: listTierings(externalTiering(tieringID)),
+					MockDeleteBucketIntelligentTieringConfiguration: func(*s3.DeleteBucketIntelligentTieringConfigurationInput) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, intelligentTieringDeleteFailed),
+			},
+		},
+		"NothingToDelete": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClientV1{
+					MockListBucketIntelligentTieringConfigurations: listTierings(),
+				}),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntelligentTieringConfigurationClient_LateInitialize(t *testing.T) {
+	type args struct {
+		cl *IntelligentTieringConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClientV1{
+					MockListBucketIntelligentTieringConfigurations: func(*s3.ListBucketIntelligentTieringConfigurationsInput) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, intelligentTieringListFailed),
+				cr:  &v1beta1.Bucket{},
+			},
+		},
+		"Success": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClientV1{
+					MockListBucketIntelligentTieringConfigurations: listTierings(externalTiering(tieringID)),
+				}),
+			},
+			want: want{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							IntelligentTieringConfigurations: []v1beta1.IntelligentTieringConfiguration{localTiering()},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePutBucketIntelligentTieringConfigurationInput(t *testing.T) {
+	got := GeneratePutBucketIntelligentTieringConfigurationInput("bucket", localTiering())
+	want := &s3.PutBucketIntelligentTieringConfigurationInput{
+		Bucket:                          awsclient.String("bucket"),
+		Id:                              awsclient.String(tieringID),
+		IntelligentTieringConfiguration: externalTiering(tieringID),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
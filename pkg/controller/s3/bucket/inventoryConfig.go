@@ -0,0 +1,241 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"sort"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	inventoryListFailed   = "cannot list Bucket inventory configurations"
+	inventoryPutFailed    = "cannot put Bucket inventory configuration"
+	inventoryDeleteFailed = "cannot delete Bucket inventory configuration"
+)
+
+// InventoryConfigurationClient is the client for API methods and reconciling the InventoryConfigurations
+type InventoryConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewInventoryConfigurationClient creates the client for Inventory Configurations
+func NewInventoryConfigurationClient(client s3.BucketClient) *InventoryConfigurationClient {
+	return &InventoryConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *InventoryConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.list(ctx, meta.GetExternalName(bucket))
+	if err != nil {
+		return NeedsUpdate, awsclient.Wrap(err, inventoryListFailed)
+	}
+	local := bucket.Spec.ForProvider.InventoryConfigurations
+	switch {
+	case len(local) == 0 && len(external) == 0:
+		return Updated, nil
+	case len(local) == 0 && len(external) != 0:
+		return NeedsDeletion, nil
+	}
+	sortByID := cmpopts.SortSlices(func(a, b v1beta1.InventoryConfiguration) bool { return a.ID < b.ID })
+	sortFields := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	if cmp.Equal(local, GenerateLocalInventoryConfigurations(external), sortByID, sortFields, cmpopts.EquateEmpty()) {
+		return Updated, nil
+	}
+	return NeedsUpdate, nil
+}
+
+// CreateOrUpdate puts every desired configuration and removes the external
+// configurations that are no longer desired.
+func (in *InventoryConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	name := meta.GetExternalName(bucket)
+	external, err := in.list(ctx, name)
+	if err != nil {
+		return awsclient.Wrap(err, inventoryListFailed)
+	}
+	desired := map[string]bool{}
+	for _, config := range bucket.Spec.ForProvider.InventoryConfigurations {
+		desired[config.ID] = true
+		if _, err := in.client.PutBucketInventoryConfigurationRequest(GeneratePutBucketInventoryConfigurationInput(name, config)).Send(ctx); err != nil {
+			return awsclient.Wrap(err, inventoryPutFailed)
+		}
+	}
+	for _, c := range external {
+		if desired[awsclient.StringValue(c.Id)] {
+			continue
+		}
+		if err := in.delete(ctx, name, c.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete creates the request to delete the resource on AWS or set it to the default value.
+func (in *InventoryConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	name := meta.GetExternalName(bucket)
+	external, err := in.list(ctx, name)
+	if err != nil {
+		return awsclient.Wrap(err, inventoryListFailed)
+	}
+	for _, c := range external {
+		if err := in.delete(ctx, name, c.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LateInitialize is responsible for initializing the resource based on the external value
+func (in *InventoryConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, meta.GetExternalName(bucket))
+	if err != nil {
+		return awsclient.Wrap(err, inventoryListFailed)
+	}
+	if len(external) == 0 {
+		return nil
+	}
+	fp := &bucket.Spec.ForProvider
+	if fp.InventoryConfigurations == nil {
+		// only run late init if the user has not specified any configuration
+		fp.InventoryConfigurations = GenerateLocalInventoryConfigurations(external)
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *InventoryConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.InventoryConfigurations) != 0
+}
+
+func (in *InventoryConfigurationClient) list(ctx context.Context, name string) ([]awss3.InventoryConfiguration, error) {
+	var configs []awss3.InventoryConfiguration
+	input := &awss3.ListBucketInventoryConfigurationsInput{Bucket: awsclient.String(name)}
+	for {
+		out, err := in.client.ListBucketInventoryConfigurationsRequest(input).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, out.InventoryConfigurationList...)
+		if !awsclient.BoolValue(out.IsTruncated) || out.NextContinuationToken == nil {
+			return configs, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+func (in *InventoryConfigurationClient) delete(ctx context.Context, name string, id *string) error {
+	_, err := in.client.DeleteBucketInventoryConfigurationRequest(
+		&awss3.DeleteBucketInventoryConfigurationInput{
+			Bucket: awsclient.String(name),
+			Id:     id,
+		},
+	).Send(ctx)
+	return awsclient.Wrap(err, inventoryDeleteFailed)
+}
+
+// GeneratePutBucketInventoryConfigurationInput creates the input for the PutBucketInventoryConfiguration request for the S3 Client
+func GeneratePutBucketInventoryConfigurationInput(name string, config v1beta1.InventoryConfiguration) *awss3.PutBucketInventoryConfigurationInput {
+	local := config.Destination.S3BucketDestination
+	destination := &awss3.InventoryS3BucketDestination{
+		AccountId: local.AccountID,
+		Bucket:    awsclient.String(local.Bucket),
+		Format:    awss3.InventoryFormat(local.Format),
+		Prefix:    local.Prefix,
+	}
+	if local.Encryption != nil {
+		destination.Encryption = &awss3.InventoryEncryption{}
+		if local.Encryption.SSEKMS != nil {
+			destination.Encryption.SSEKMS = &awss3.SSEKMS{KeyId: awsclient.String(local.Encryption.SSEKMS.KeyID)}
+		}
+		if local.Encryption.SSES3 != nil {
+			destination.Encryption.SSES3 = &awss3.SSES3{}
+		}
+	}
+	external := &awss3.InventoryConfiguration{
+		Destination:            &awss3.InventoryDestination{S3BucketDestination: destination},
+		Id:                     awsclient.String(config.ID),
+		IncludedObjectVersions: awss3.InventoryIncludedObjectVersions(config.IncludedObjectVersions),
+		IsEnabled:              awsclient.Bool(config.IsEnabled),
+		Schedule:               &awss3.InventorySchedule{Frequency: awss3.InventoryFrequency(config.Schedule.Frequency)},
+	}
+	if config.Filter != nil {
+		external.Filter = &awss3.InventoryFilter{Prefix: awsclient.String(config.Filter.Prefix)}
+	}
+	for _, f := range config.OptionalFields {
+		external.OptionalFields = append(external.OptionalFields, awss3.InventoryOptionalField(f))
+	}
+	return &awss3.PutBucketInventoryConfigurationInput{
+		Bucket:                 awsclient.String(name),
+		Id:                     awsclient.String(config.ID),
+		InventoryConfiguration: external,
+	}
+}
+
+// GenerateLocalInventoryConfigurations creates the local representation of
+// the supplied external configurations, sorted by ID.
+func GenerateLocalInventoryConfigurations(configs []awss3.InventoryConfiguration) []v1beta1.InventoryConfiguration {
+	if len(configs) == 0 {
+		return nil
+	}
+	out := make([]v1beta1.InventoryConfiguration, len(configs))
+	for i, c := range configs {
+		out[i] = v1beta1.InventoryConfiguration{
+			ID:                     awsclient.StringValue(c.Id),
+			IncludedObjectVersions: string(c.IncludedObjectVersions),
+			IsEnabled:              awsclient.BoolValue(c.IsEnabled),
+		}
+		if c.Destination != nil && c.Destination.S3BucketDestination != nil {
+			d := c.Destination.S3BucketDestination
+			out[i].Destination.S3BucketDestination = v1beta1.InventoryS3BucketDestination{
+				AccountID: d.AccountId,
+				Bucket:    awsclient.StringValue(d.Bucket),
+				Format:    string(d.Format),
+				Prefix:    d.Prefix,
+			}
+			if d.Encryption != nil {
+				e := &v1beta1.InventoryEncryption{}
+				if d.Encryption.SSEKMS != nil {
+					e.SSEKMS = &v1beta1.SSEKMS{KeyID: awsclient.StringValue(d.Encryption.SSEKMS.KeyId)}
+				}
+				if d.Encryption.SSES3 != nil {
+					e.SSES3 = &v1beta1.SSES3{}
+				}
+				out[i].Destination.S3BucketDestination.Encryption = e
+			}
+		}
+		if c.Filter != nil {
+			out[i].Filter = &v1beta1.InventoryFilter{Prefix: awsclient.StringValue(c.Filter.Prefix)}
+		}
+		if c.Schedule != nil {
+			out[i].Schedule.Frequency = string(c.Schedule.Frequency)
+		}
+		for _, f := range c.OptionalFields {
+			out[i].OptionalFields = append(out[i].OptionalFields, string(f))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
@@ -0,0 +1,376 @@
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+	s3Testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
+)
+
+var (
+	inventoryID     = "weekly"
+	inventoryPrefix = "reports/"
+	inventoryBucket = "arn:aws:s3:::inventory"
+)
+
+func localInventory() v1beta1.InventoryConfiguration {
+	return v1beta1.InventoryConfiguration{
+		ID: inventoryID,
+		Destination: v1beta1.InventoryDestination{S3BucketDestination: v1beta1.InventoryS3BucketDestination{
+			Bucket:     inventoryBucket,
+			Encryption: &v1beta1.InventoryEncryption{SSES3: &v1beta1.SSES3{}},
+			Format:     string(s3.InventoryFormatCsv),
+			Prefix:     &inventoryPrefix,
+		}},
+		Filter:                 &v1beta1.InventoryFilter{Prefix: "data/"},
+		IncludedObjectVersions: string(s3.InventoryIncludedObjectVersionsCurrent),
+		IsEnabled:              true,
+		OptionalFields:         []string{string(s3.InventoryOptionalFieldSize)},
+		Schedule:               v1beta1.InventorySchedule{Frequency: string(s3.InventoryFrequencyWeekly)},
+	}
+}
+
+func externalInventory(id string) s3.InventoryConfiguration {
+	return s3.InventoryConfiguration{
+		Destination: &s3.InventoryDestination{S3BucketDestination: &s3.InventoryS3BucketDestination{
+			Bucket:     awsclient.String(inventoryBucket),
+			Encryption: &s3.InventoryEncryption{SSES3: &s3.SSES3{}},
+			Format:     s3.InventoryFormatCsv,
+			Prefix:     &inventoryPrefix,
+		}},
+		Filter:                 &s3.InventoryFilter{Prefix: awsclient.String("data/")},
+		Id:                     awsclient.String(id),
+		IncludedObjectVersions: s3.InventoryIncludedObjectVersionsCurrent,
+		IsEnabled:              awsclient.Bool(true),
+		OptionalFields:         []s3.InventoryOptionalField{s3.InventoryOptionalFieldSize},
+		Schedule:               &s3.InventorySchedule{Frequency: s3.InventoryFrequencyWeekly},
+	}
+}
+
+func listInventoryConfigs(configs ...s3.InventoryConfiguration) func(*s3.ListBucketInventoryConfigurationsInput) s3.ListBucketInventoryConfigurationsRequest {
+	return func(*s3.ListBucketInventoryConfigurationsInput) s3.ListBucketInventoryConfigurationsRequest {
+		return s3.ListBucketInventoryConfigurationsRequest{
+			Request: s3Testing.CreateRequest(nil, &s3.ListBucketInventoryConfigurationsOutput{InventoryConfigurationList: configs}),
+		}
+	}
+}
+
+func TestInventoryConfigurationClient_Observe(t *testing.T) {
+	type args struct {
+		cl *InventoryConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurationsRequest: func(*s3.ListBucketInventoryConfigurationsInput) s3.ListBucketInventoryConfigurationsRequest {
+						return s3.ListBucketInventoryConfigurationsRequest{
+							Request: s3Testing.CreateRequest(errBoom, &s3.ListBucketInventoryConfigurationsOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, inventoryListFailed),
+			},
+		},
+		"NotFoundNotNeeded": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurationsRequest: listInventoryConfigs(),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NeedsDeletion": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurationsRequest: listInventoryConfigs(externalInventory(inventoryID)),
+				}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							InventoryConfigurations: []v1beta1.InventoryConfiguration{localInventory()},
+						},
+					},
+				},
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurationsRequest: listInventoryConfigs(externalInventory("other")),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Updated": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							InventoryConfigurations: []v1beta1.InventoryConfiguration{localInventory()},
+						},
+					},
+				},
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurationsRequest: listInventoryConfigs(externalInventory(inventoryID)),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInventoryConfigurationClient_CreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl *InventoryConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err     error
+		deleted []string
+	}
+
+	var deleted []string
+	deleteFn := func(input *s3.DeleteBucketInventoryConfigurationInput) s3.DeleteBucketInventoryConfigurationRequest {
+		deleted = append(deleted, awsclient.StringValue(input.Id))
+		return s3.DeleteBucketInventoryConfigurationRequest{
+			Request: s3Testing.CreateRequest(nil, &s3.DeleteBucketInventoryConfigurationOutput{}),
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PutError": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							InventoryConfigurations: []v1beta1.InventoryConfiguration{localInventory()},
+						},
+					},
+				},
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurationsRequest: listInventoryConfigs(),
+					MockPutBucketInventoryConfigurationRequest: func(*s3.PutBucketInventoryConfigurationInput) s3.PutBucketInventoryConfigurationRequest {
+						return s3.PutBucketInventoryConfigurationRequest{
+							Request: s3Testing.CreateRequest(errBoom, &s3.PutBucketInventoryConfigurationOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, inventoryPutFailed),
+			},
+		},
+		"DeletesUndesired": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							InventoryConfigurations: []v1beta1.InventoryConfiguration{localInventory()},
+						},
+					},
+				},
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurationsRequest: listInventoryConfigs(externalInventory(inventoryID), externalInventory("other")),
+					MockPutBucketInventoryConfigurationRequest: func(*s3.PutBucketInventoryConfigurationInput) s3.PutBucketInventoryConfigurationRequest {
+						return s3.PutBucketInventoryConfigurationRequest{
+							Request: s3Testing.CreateRequest(nil, &s3.PutBucketInventoryConfigurationOutput{}),
+						}
+					},
+					MockDeleteBucketInventoryConfigurationRequest: deleteFn,
+				}),
+			},
+			want: want{
+				deleted: []string{"other"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted = nil
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInventoryConfigurationClient_Delete(t *testing.T) {
+	type args struct {
+		cl *InventoryConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurationsRequest: listInventoryConfigs(externalInventory(inventoryID)),
+					MockDeleteBucketInventoryConfigurationRequest: func(*s3.DeleteBucketInventoryConfigurationInput) s3.DeleteBucketInventoryConfigurationRequest {
+						return s3.DeleteBucketInventoryConfigurationRequest{
+							Request: s3Testing.CreateRequest(errBoom, &s3.DeleteBucketInventoryConfigurationOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, inventoryDeleteFailed),
+			},
+		},
+		"NothingToDelete": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurationsRequest: listInventoryConfigs(),
+				}),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInventoryConfigurationClient_LateInitialize(t *testing.T) {
+	type args struct {
+		cl *InventoryConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurationsRequest: func(*s3.ListBucketInventoryConfigurationsInput) s3.ListBucketInventoryConfigurationsRequest {
+						return s3.ListBucketInventoryConfigurationsRequest{
+							Request: s3Testing.CreateRequest(errBoom, &s3.ListBucketInventoryConfigurationsOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, inventoryListFailed),
+				cr:  &v1beta1.Bucket{},
+			},
+		},
+		"Success": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurationsRequest: listInventoryConfigs(externalInventory(inventoryID)),
+				}),
+			},
+			want: want{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							InventoryConfigurations: []v1beta1.InventoryConfiguration{localInventory()},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePutBucketInventoryConfigurationInput(t *testing.T) {
+	external := externalInventory(inventoryID)
+	got := GeneratePutBucketInventoryConfigurationInput("bucket", localInventory())
+	want := &s3.PutBucketInventoryConfigurationInput{
+		Bucket:                 awsclient.String("bucket"),
+		Id:                     awsclient.String(inventoryID),
+		InventoryConfiguration: &external,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
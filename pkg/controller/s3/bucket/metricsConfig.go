@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"sort"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	metricsListFailed   = "cannot list Bucket metrics configurations"
+	metricsPutFailed    = "cannot put Bucket metrics configuration"
+	metricsDeleteFailed = "cannot delete Bucket metrics configuration"
+)
+
+// MetricsConfigurationClient is the client for API methods and reconciling the MetricsConfigurations
+type MetricsConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewMetricsConfigurationClient creates the client for Metrics Configurations
+func NewMetricsConfigurationClient(client s3.BucketClient) *MetricsConfigurationClient {
+	return &MetricsConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *MetricsConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.list(ctx, meta.GetExternalName(bucket))
+	if err != nil {
+		return NeedsUpdate, awsclient.Wrap(err, metricsListFailed)
+	}
+	local := bucket.Spec.ForProvider.MetricsConfigurations
+	switch {
+	case len(local) == 0 && len(external) == 0:
+		return Updated, nil
+	case len(local) == 0 && len(external) != 0:
+		return NeedsDeletion, nil
+	}
+	sortByID := cmpopts.SortSlices(func(a, b v1beta1.MetricsConfiguration) bool { return a.ID < b.ID })
+	sortTags := cmpopts.SortSlices(func(a, b v1beta1.Tag) bool { return a.Key < b.Key })
+	if cmp.Equal(local, GenerateLocalMetricsConfigurations(external), sortByID, sortTags, cmpopts.EquateEmpty()) {
+		return Updated, nil
+	}
+	return NeedsUpdate, nil
+}
+
+// CreateOrUpdate puts every desired configuration and removes the external
+// configurations that are no longer desired.
+func (in *MetricsConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	name := meta.GetExternalName(bucket)
+	external, err := in.list(ctx, name)
+	if err != nil {
+		return awsclient.Wrap(err, metricsListFailed)
+	}
+	desired := map[string]bool{}
+	for _, config := range bucket.Spec.ForProvider.MetricsConfigurations {
+		desired[config.ID] = true
+		if _, err := in.client.PutBucketMetricsConfigurationRequest(GeneratePutBucketMetricsConfigurationInput(name, config)).Send(ctx); err != nil {
+			return awsclient.Wrap(err, metricsPutFailed)
+		}
+	}
+	for _, c := range external {
+		if desired[awsclient.StringValue(c.Id)] {
+			continue
+		}
+		if err := in.delete(ctx, name, c.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete creates the request to delete the resource on AWS or set it to the default value.
+func (in *MetricsConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	name := meta.GetExternalName(bucket)
+	external, err := in.list(ctx, name)
+	if err != nil {
+		return awsclient.Wrap(err, metricsListFailed)
+	}
+	for _, c := range external {
+		if err := in.delete(ctx, name, c.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LateInitialize is responsible for initializing the resource based on the external value
+func (in *MetricsConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, meta.GetExternalName(bucket))
+	if err != nil {
+		return awsclient.Wrap(err, metricsListFailed)
+	}
+	if len(external) == 0 {
+		return nil
+	}
+	fp := &bucket.Spec.ForProvider
+	if fp.MetricsConfigurations == nil {
+		// only run late init if the user has not specified any configuration
+		fp.MetricsConfigurations = GenerateLocalMetricsConfigurations(external)
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *MetricsConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.MetricsConfigurations) != 0
+}
+
+func (in *MetricsConfigurationClient) list(ctx context.Context, name string) ([]awss3.MetricsConfiguration, error) {
+	var configs []awss3.MetricsConfiguration
+	input := &awss3.ListBucketMetricsConfigurationsInput{Bucket: awsclient.String(name)}
+	for {
+		out, err := in.client.ListBucketMetricsConfigurationsRequest(input).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, out.MetricsConfigurationList...)
+		if !awsclient.BoolValue(out.IsTruncated) || out.NextContinuationToken == nil {
+			return configs, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+func (in *MetricsConfigurationClient) delete(ctx context.Context, name string, id *string) error {
+	_, err := in.client.DeleteBucketMetricsConfigurationRequest(
+		&awss3.DeleteBucketMetricsConfigurationInput{
+			Bucket: awsclient.String(name),
+			Id:     id,
+		},
+	).Send(ctx)
+	return awsclient.Wrap(err, metricsDeleteFailed)
+}
+
+// GeneratePutBucketMetricsConfigurationInput creates the input for the PutBucketMetricsConfiguration request for the S3 Client
+func GeneratePutBucketMetricsConfigurationInput(name string, config v1beta1.MetricsConfiguration) *awss3.PutBucketMetricsConfigurationInput {
+	external := &awss3.MetricsConfiguration{Id: awsclient.String(config.ID)}
+	if f := config.Filter; f != nil {
+		external.Filter = &awss3.MetricsFilter{Prefix: f.Prefix}
+		if f.Tag != nil {
+			external.Filter.Tag = &awss3.Tag{Key: awsclient.String(f.Tag.Key), Value: awsclient.String(f.Tag.Value)}
+		}
+		if f.And != nil {
+			external.Filter.And = &awss3.MetricsAndOperator{Prefix: f.And.Prefix, Tags: s3.CopyTags(f.And.Tags)}
+		}
+	}
+	return &awss3.PutBucketMetricsConfigurationInput{
+		Bucket:               awsclient.String(name),
+		Id:                   awsclient.String(config.ID),
+		MetricsConfiguration: external,
+	}
+}
+
+// GenerateLocalMetricsConfigurations creates the local representation of
+// the supplied external configurations, sorted by ID.
+func GenerateLocalMetricsConfigurations(configs []awss3.MetricsConfiguration) []v1beta1.MetricsConfiguration {
+	if len(configs) == 0 {
+		return nil
+	}
+	out := make([]v1beta1.MetricsConfiguration, len(configs))
+	for i, c := range configs {
+		out[i] = v1beta1.MetricsConfiguration{ID: awsclient.StringValue(c.Id)}
+		if f := c.Filter; f != nil {
+			out[i].Filter = &v1beta1.MetricsFilter{Prefix: f.Prefix}
+			if f.Tag != nil {
+				out[i].Filter.Tag = &v1beta1.Tag{Key: awsclient.StringValue(f.Tag.Key), Value: awsclient.StringValue(f.Tag.Value)}
+			}
+			if f.And != nil {
+				out[i].Filter.And = &v1beta1.MetricsAndOperator{Prefix: f.And.Prefix, Tags: s3.CopyAWSTags(f.And.Tags)}
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
@@ -0,0 +1,352 @@
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+	s3Testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
+)
+
+var metricsID = "requests"
+
+func localMetrics() v1beta1.MetricsConfiguration {
+	return v1beta1.MetricsConfiguration{
+		ID:     metricsID,
+		Filter: &v1beta1.MetricsFilter{Tag: &v1beta1.Tag{Key: "k", Value: "v"}},
+	}
+}
+
+func externalMetrics(id string) s3.MetricsConfiguration {
+	return s3.MetricsConfiguration{
+		Id:     awsclient.String(id),
+		Filter: &s3.MetricsFilter{Tag: &s3.Tag{Key: awsclient.String("k"), Value: awsclient.String("v")}},
+	}
+}
+
+func listMetricsConfigs(configs ...s3.MetricsConfiguration) func(*s3.ListBucketMetricsConfigurationsInput) s3.ListBucketMetricsConfigurationsRequest {
+	return func(*s3.ListBucketMetricsConfigurationsInput) s3.ListBucketMetricsConfigurationsRequest {
+		return s3.ListBucketMetricsConfigurationsRequest{
+			Request: s3Testing.CreateRequest(nil, &s3.ListBucketMetricsConfigurationsOutput{MetricsConfigurationList: configs}),
+		}
+	}
+}
+
+func TestMetricsConfigurationClient_Observe(t *testing.T) {
+	type args struct {
+		cl *MetricsConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurationsRequest: func(*s3.ListBucketMetricsConfigurationsInput) s3.ListBucketMetricsConfigurationsRequest {
+						return s3.ListBucketMetricsConfigurationsRequest{
+							Request: s3Testing.CreateRequest(errBoom, &s3.ListBucketMetricsConfigurationsOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, metricsListFailed),
+			},
+		},
+		"NotFoundNotNeeded": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurationsRequest: listMetricsConfigs(),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NeedsDeletion": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurationsRequest: listMetricsConfigs(externalMetrics(metricsID)),
+				}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							MetricsConfigurations: []v1beta1.MetricsConfiguration{localMetrics()},
+						},
+					},
+				},
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurationsRequest: listMetricsConfigs(externalMetrics("other")),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Updated": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							MetricsConfigurations: []v1beta1.MetricsConfiguration{localMetrics()},
+						},
+					},
+				},
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurationsRequest: listMetricsConfigs(externalMetrics(metricsID)),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMetricsConfigurationClient_CreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl *MetricsConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err     error
+		deleted []string
+	}
+
+	var deleted []string
+	deleteFn := func(input *s3.DeleteBucketMetricsConfigurationInput) s3.DeleteBucketMetricsConfigurationRequest {
+		deleted = append(deleted, awsclient.StringValue(input.Id))
+		return s3.DeleteBucketMetricsConfigurationRequest{
+			Request: s3Testing.CreateRequest(nil, &s3.DeleteBucketMetricsConfigurationOutput{}),
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PutError": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							MetricsConfigurations: []v1beta1.MetricsConfiguration{localMetrics()},
+						},
+					},
+				},
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurationsRequest: listMetricsConfigs(),
+					MockPutBucketMetricsConfigurationRequest: func(*s3.PutBucketMetricsConfigurationInput) s3.PutBucketMetricsConfigurationRequest {
+						return s3.PutBucketMetricsConfigurationRequest{
+							Request: s3Testing.CreateRequest(errBoom, &s3.PutBucketMetricsConfigurationOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, metricsPutFailed),
+			},
+		},
+		"DeletesUndesired": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							MetricsConfigurations: []v1beta1.MetricsConfiguration{localMetrics()},
+						},
+					},
+				},
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurationsRequest: listMetricsConfigs(externalMetrics(metricsID), externalMetrics("other")),
+					MockPutBucketMetricsConfigurationRequest: func(*s3.PutBucketMetricsConfigurationInput) s3.PutBucketMetricsConfigurationRequest {
+						return s3.PutBucketMetricsConfigurationRequest{
+							Request: s3Testing.CreateRequest(nil, &s3.PutBucketMetricsConfigurationOutput{}),
+						}
+					},
+					MockDeleteBucketMetricsConfigurationRequest: deleteFn,
+				}),
+			},
+			want: want{
+				deleted: []string{"other"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted = nil
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMetricsConfigurationClient_Delete(t *testing.T) {
+	type args struct {
+		cl *MetricsConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurationsRequest: listMetricsConfigs(externalMetrics(metricsID)),
+					MockDeleteBucketMetricsConfigurationRequest: func(*s3.DeleteBucketMetricsConfigurationInput) s3.DeleteBucketMetricsConfigurationRequest {
+						return s3.DeleteBucketMetricsConfigurationRequest{
+							Request: s3Testing.CreateRequest(errBoom, &s3.DeleteBucketMetricsConfigurationOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, metricsDeleteFailed),
+			},
+		},
+		"NothingToDelete": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurationsRequest: listMetricsConfigs(),
+				}),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMetricsConfigurationClient_LateInitialize(t *testing.T) {
+	type args struct {
+		cl *MetricsConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurationsRequest: func(*s3.ListBucketMetricsConfigurationsInput) s3.ListBucketMetricsConfigurationsRequest {
+						return s3.ListBucketMetricsConfigurationsRequest{
+							Request: s3Testing.CreateRequest(errBoom, &s3.ListBucketMetricsConfigurationsOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, metricsListFailed),
+				cr:  &v1beta1.Bucket{},
+			},
+		},
+		"Success": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurationsRequest: listMetricsConfigs(externalMetrics(metricsID)),
+				}),
+			},
+			want: want{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							MetricsConfigurations: []v1beta1.MetricsConfiguration{localMetrics()},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePutBucketMetricsConfigurationInput(t *testing.T) {
+	external := externalMetrics(metricsID)
+	got := GeneratePutBucketMetricsConfigurationInput("bucket", localMetrics())
+	want := &s3.PutBucketMetricsConfigurationInput{
+		Bucket:               awsclient.String("bucket"),
+		Id:                   awsclient.String(metricsID),
+		MetricsConfiguration: &external,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	objectLockGetFailed = "cannot get Bucket Object Lock configuration"
+	objectLockPutFailed = "cannot put Bucket Object Lock configuration"
+)
+
+// ObjectLockConfigurationClient is the client for API methods and reconciling the ObjectLockConfiguration
+type ObjectLockConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewObjectLockConfigurationClient creates the client for Object Lock Configuration
+func NewObjectLockConfigurationClient(client s3.BucketClient) *ObjectLockConfigurationClient {
+	return &ObjectLockConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *ObjectLockConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	// NOTE: Object Lock cannot be disabled once it is enabled, so a bucket
+	// without an ObjectLockConfiguration is always up to date.
+	if bucket.Spec.ForProvider.ObjectLockConfiguration == nil {
+		return Updated, nil
+	}
+	external, err := in.client.GetObjectLockConfigurationRequest(&awss3.GetObjectLockConfigurationInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}).Send(ctx)
+	if resource.Ignore(s3.ObjectLockConfigurationNotFound, err) != nil {
+		return NeedsUpdate, awsclient.Wrap(err, objectLockGetFailed)
+	}
+	var config *awss3.ObjectLockConfiguration
+	if external != nil && external.GetObjectLockConfigurationOutput != nil {
+		config = external.ObjectLockConfiguration
+	}
+	if !cmp.Equal(bucket.Spec.ForProvider.ObjectLockConfiguration.Rule, GenerateLocalObjectLockRule(config)) {
+		return NeedsUpdate, nil
+	}
+	return Updated, nil
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS
+func (in *ObjectLockConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if bucket.Spec.ForProvider.ObjectLockConfiguration == nil {
+		return nil
+	}
+	input := GeneratePutObjectLockConfigurationInput(meta.GetExternalName(bucket), bucket.Spec.ForProvider.ObjectLockConfiguration)
+	_, err := in.client.PutObjectLockConfigurationRequest(input).Send(ctx)
+	return awsclient.Wrap(err, objectLockPutFailed)
+}
+
+// Delete does not do anything since Object Lock cannot be disabled.
+func (*ObjectLockConfigurationClient) Delete(_ context.Context, _ *v1beta1.Bucket) error {
+	return nil
+}
+
+// LateInitialize is responsible for initializing the resource based on the external value
+func (in *ObjectLockConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.client.GetObjectLockConfigurationRequest(&awss3.GetObjectLockConfigurationInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}).Send(ctx)
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(s3.ObjectLockConfigurationNotFound, err), objectLockGetFailed)
+	}
+
+	if external.GetObjectLockConfigurationOutput == nil || external.ObjectLockConfiguration == nil {
+		return nil
+	}
+
+	fp := &bucket.Spec.ForProvider
+	if fp.ObjectLockConfiguration == nil {
+		fp.ObjectLockConfiguration = &v1beta1.ObjectLockConfiguration{}
+	}
+	fp.ObjectLockConfiguration.ObjectLockEnabled = awsclient.LateInitializeStringPtr(
+		fp.ObjectLockConfiguration.ObjectLockEnabled,
+		awsclient.String(string(external.ObjectLockConfiguration.ObjectLockEnabled)))
+	if fp.ObjectLockConfiguration.Rule == nil {
+		fp.ObjectLockConfiguration.Rule = GenerateLocalObjectLockRule(external.ObjectLockConfiguration)
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *ObjectLockConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return bucket.Spec.ForProvider.ObjectLockConfiguration != nil
+}
+
+// GeneratePutObjectLockConfigurationInput creates the input for the PutObjectLockConfiguration request for the S3 Client
+func GeneratePutObjectLockConfigurationInput(name string, config *v1beta1.ObjectLockConfiguration) *awss3.PutObjectLockConfigurationInput {
+	input := &awss3.PutObjectLockConfigurationInput{
+		Bucket: awsclient.String(name),
+		ObjectLockConfiguration: &awss3.ObjectLockConfiguration{
+			ObjectLockEnabled: awss3.ObjectLockEnabledEnabled,
+		},
+	}
+	if config.Rule != nil {
+		input.ObjectLockConfiguration.Rule = &awss3.ObjectLockRule{
+			DefaultRetention: &awss3.DefaultRetention{
+				Mode:  awss3.ObjectLockRetentionMode(config.Rule.DefaultRetention.Mode),
+				Days:  config.Rule.DefaultRetention.Days,
+				Years: config.Rule.DefaultRetention.Years,
+			},
+		}
+	}
+	return input
+}
+
+// GenerateLocalObjectLockRule creates the local representation of the rule of
+// the supplied external Object Lock configuration.
+func GenerateLocalObjectLockRule(config *awss3.ObjectLockConfiguration) *v1beta1.ObjectLockRule {
+	if config == nil || config.Rule == nil || config.Rule.DefaultRetention == nil {
+		return nil
+	}
+	return &v1beta1.ObjectLockRule{
+		DefaultRetention: v1beta1.DefaultRetention{
+			Mode:  string(config.Rule.DefaultRetention.Mode),
+			Days:  config.Rule.DefaultRetention.Days,
+			Years: config.Rule.DefaultRetention.Years,
+		},
+	}
+}
@@ -0,0 +1,308 @@
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	clients3 "github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+	s3Testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
+)
+
+func TestObjectLockConfigurationClient_Observe(t *testing.T) {
+	type args struct {
+		cl *ObjectLockConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	rule := &v1beta1.ObjectLockRule{DefaultRetention: v1beta1.DefaultRetention{Mode: "GOVERNANCE", Days: awsclient.Int64(30)}}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotSpecified": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"Error": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							ObjectLockConfiguration: &v1beta1.ObjectLockConfiguration{Rule: rule},
+						},
+					},
+				},
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfigurationRequest: func(input *s3.GetObjectLockConfigurationInput) s3.GetObjectLockConfigurationRequest {
+						return s3.GetObjectLockConfigurationRequest{
+							Request: s3Testing.CreateRequest(errBoom, &s3.GetObjectLockConfigurationOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, objectLockGetFailed),
+			},
+		},
+		"NotFound": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							ObjectLockConfiguration: &v1beta1.ObjectLockConfiguration{Rule: rule},
+						},
+					},
+				},
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfigurationRequest: func(input *s3.GetObjectLockConfigurationInput) s3.GetObjectLockConfigurationRequest {
+						return s3.GetObjectLockConfigurationRequest{
+							Request: s3Testing.CreateRequest(awserr.New(clients3.ObjectLockNotFoundErrCode, "error", nil), &s3.GetObjectLockConfigurationOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							ObjectLockConfiguration: &v1beta1.ObjectLockConfiguration{Rule: rule},
+						},
+					},
+				},
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfigurationRequest: func(input *s3.GetObjectLockConfigurationInput) s3.GetObjectLockConfigurationRequest {
+						return s3.GetObjectLockConfigurationRequest{
+							Request: s3Testing.CreateRequest(nil, &s3.GetObjectLockConfigurationOutput{
+								ObjectLockConfiguration: &s3.ObjectLockConfiguration{
+									ObjectLockEnabled: s3.ObjectLockEnabledEnabled,
+									Rule: &s3.ObjectLockRule{DefaultRetention: &s3.DefaultRetention{
+										Mode: s3.ObjectLockRetentionModeCompliance,
+										Days: awsclient.Int64(30),
+									}},
+								},
+							}),
+						}
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Updated": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							ObjectLockConfiguration: &v1beta1.ObjectLockConfiguration{Rule: rule},
+						},
+					},
+				},
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfigurationRequest: func(input *s3.GetObjectLockConfigurationInput) s3.GetObjectLockConfigurationRequest {
+						return s3.GetObjectLockConfigurationRequest{
+							Request: s3Testing.CreateRequest(nil, &s3.GetObjectLockConfigurationOutput{
+								ObjectLockConfiguration: &s3.ObjectLockConfiguration{
+									ObjectLockEnabled: s3.ObjectLockEnabledEnabled,
+									Rule: &s3.ObjectLockRule{DefaultRetention: &s3.DefaultRetention{
+										Mode: s3.ObjectLockRetentionModeGovernance,
+										Days: awsclient.Int64(30),
+									}},
+								},
+							}),
+						}
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectLockConfigurationClient_CreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl *ObjectLockConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Skip": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+			},
+			want: want{},
+		},
+		"Error": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							ObjectLockConfiguration: &v1beta1.ObjectLockConfiguration{},
+						},
+					},
+				},
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockPutObjectLockConfigurationRequest: func(input *s3.PutObjectLockConfigurationInput) s3.PutObjectLockConfigurationRequest {
+						return s3.PutObjectLockConfigurationRequest{
+							Request: s3Testing.CreateRequest(errBoom, &s3.PutObjectLockConfigurationOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, objectLockPutFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectLockConfigurationClient_LateInitialize(t *testing.T) {
+	type args struct {
+		cl *ObjectLockConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfigurationRequest: func(input *s3.GetObjectLockConfigurationInput) s3.GetObjectLockConfigurationRequest {
+						return s3.GetObjectLockConfigurationRequest{
+							Request: s3Testing.CreateRequest(errBoom, &s3.GetObjectLockConfigurationOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, objectLockGetFailed),
+				cr:  &v1beta1.Bucket{},
+			},
+		},
+		"NotFoundSkip": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfigurationRequest: func(input *s3.GetObjectLockConfigurationInput) s3.GetObjectLockConfigurationRequest {
+						return s3.GetObjectLockConfigurationRequest{
+							Request: s3Testing.CreateRequest(awserr.New(clients3.ObjectLockNotFoundErrCode, "error", nil), &s3.GetObjectLockConfigurationOutput{}),
+						}
+					},
+				}),
+			},
+			want: want{
+				cr: &v1beta1.Bucket{},
+			},
+		},
+		"Success": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfigurationRequest: func(input *s3.GetObjectLockConfigurationInput) s3.GetObjectLockConfigurationRequest {
+						return s3.GetObjectLockConfigurationRequest{
+							Request: s3Testing.CreateRequest(nil, &s3.GetObjectLockConfigurationOutput{
+								ObjectLockConfiguration: &s3.ObjectLockConfiguration{
+									ObjectLockEnabled: s3.ObjectLockEnabledEnabled,
+									Rule: &s3.ObjectLockRule{DefaultRetention: &s3.DefaultRetention{
+										Mode:  s3.ObjectLockRetentionModeCompliance,
+										Years: awsclient.Int64(1),
+									}},
+								},
+							}),
+						}
+					},
+				}),
+			},
+			want: want{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							ObjectLockConfiguration: &v1beta1.ObjectLockConfiguration{
+								ObjectLockEnabled: awsclient.String("Enabled"),
+								Rule: &v1beta1.ObjectLockRule{DefaultRetention: v1beta1.DefaultRetention{
+									Mode:  "COMPLIANCE",
+									Years: awsclient.Int64(1),
+								}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	awss3v1 "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	ownershipGetFailed    = "cannot get Bucket ownership controls"
+	ownershipPutFailed    = "cannot put Bucket ownership controls"
+	ownershipDeleteFailed = "cannot delete Bucket ownership controls"
+)
+
+// OwnershipControlsClient is the client for API methods and reconciling the OwnershipControls
+type OwnershipControlsClient struct {
+	client s3.BucketClientV1
+}
+
+// NewOwnershipControlsClient creates the client for Ownership Controls
+func NewOwnershipControlsClient(client s3.BucketClientV1) *OwnershipControlsClient {
+	return &OwnershipControlsClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *OwnershipControlsClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	result, err := in.client.GetBucketOwnershipControlsWithContext(ctx, &awss3v1.GetBucketOwnershipControlsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	if resource.Ignore(s3.OwnershipControlsNotFound, err) != nil {
+		return NeedsUpdate, awsclient.Wrap(err, ownershipGetFailed)
+	}
+	var external *v1beta1.OwnershipControls
+	if result != nil {
+		external = GenerateLocalOwnershipControls(result.OwnershipControls)
+	}
+	local := bucket.Spec.ForProvider.OwnershipControls
+	switch {
+	case local == nil && external == nil:
+		return Updated, nil
+	case local == nil && external != nil:
+		return NeedsDeletion, nil
+	case cmp.Equal(local, external, cmpopts.EquateEmpty()):
+		return Updated, nil
+	default:
+		return NeedsUpdate, nil
+	}
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS
+func (in *OwnershipControlsClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if bucket.Spec.ForProvider.OwnershipControls == nil {
+		return nil
+	}
+	input := GeneratePutBucketOwnershipControlsInput(meta.GetExternalName(bucket), bucket.Spec.ForProvider.OwnershipControls)
+	_, err := in.client.PutBucketOwnershipControlsWithContext(ctx, input)
+	return awsclient.Wrap(err, ownershipPutFailed)
+}
+
+// Delete creates the request to delete the resource on AWS or set it to the default value.
+func (in *OwnershipControlsClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	_, err := in.client.DeleteBucketOwnershipControlsWithContext(ctx,
+		&awss3v1.DeleteBucketOwnershipControlsInput{
+			Bucket: awsclient.String(meta.GetExternalName(bucket)),
+		},
+	)
+	return awsclient.Wrap(err, ownershipDeleteFailed)
+}
+
+// LateInitialize is responsible for initializing the resource based on the external value
+func (in *OwnershipControlsClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	result, err := in.client.GetBucketOwnershipControlsWithContext(ctx, &awss3v1.GetBucketOwnershipControlsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(s3.OwnershipControlsNotFound, err), ownershipGetFailed)
+	}
+	external := GenerateLocalOwnershipControls(result.OwnershipControls)
+	if external == nil {
+		return nil
+	}
+	fp := &bucket.Spec.ForProvider
+	if fp.OwnershipControls == nil {
+		fp.OwnershipControls = &v1beta1.OwnershipControls{}
+	}
+	if fp.OwnershipControls.Rules == nil {
+		// only run late init if the user has not specified Rules
+		fp.OwnershipControls.Rules = external.Rules
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *OwnershipControlsClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return bucket.Spec.ForProvider.OwnershipControls != nil
+}
+
+// GeneratePutBucketOwnershipControlsInput creates the input for the PutBucketOwnershipControls request for the S3 Client
+func GeneratePutBucketOwnershipControlsInput(name string, config *v1beta1.OwnershipControls) *awss3v1.PutBucketOwnershipControlsInput {
+	input := &awss3v1.PutBucketOwnershipControlsInput{
+		Bucket:            awsclient.String(name),
+		OwnershipControls: &awss3v1.OwnershipControls{Rules: make([]*awss3v1.OwnershipControlsRule, len(config.Rules))},
+	}
+	for i, r := range config.Rules {
+		input.OwnershipControls.Rules[i] = &awss3v1.OwnershipControlsRule{ObjectOwnership: awsclient.String(r.ObjectOwnership)}
+	}
+	return input
+}
+
+// GenerateLocalOwnershipControls creates the local representation of the
+// supplied external ownership controls.
+func GenerateLocalOwnershipControls(config *awss3v1.OwnershipControls) *v1beta1.OwnershipControls {
+	if config == nil || len(config.Rules) == 0 {
+		return nil
+	}
+	out := &v1beta1.OwnershipControls{Rules: make([]v1beta1.OwnershipControlsRule, 0, len(config.Rules))}
+	for _, r := range config.Rules {
+		if r == nil {
+			continue
+		}
+		out.Rules = append(out.Rules, v1beta1.OwnershipControlsRule{ObjectOwnership: awsclient.StringValue(r.ObjectOwnership)})
+	}
+	return out
+}
@@ -0,0 +1,307 @@
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	clients3 "github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+)
+
+func TestOwnershipControlsClient_Observe(t *testing.T) {
+	type args struct {
+		cl *OwnershipControlsClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	preferred := &v1beta1.OwnershipControls{Rules: []v1beta1.OwnershipControlsRule{{ObjectOwnership: s3.ObjectOwnershipBucketOwnerPreferred}}}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewOwnershipControlsClient(fake.MockBucketClientV1{
+					MockGetBucketOwnershipControls: func(*s3.GetBucketOwnershipControlsInput) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, ownershipGetFailed),
+			},
+		},
+		"NotFoundNotNeeded": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewOwnershipControlsClient(fake.MockBucketClientV1{
+					MockGetBucketOwnershipControls: func(*s3.GetBucketOwnershipControlsInput) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return nil, awserr.New(clients3.OwnershipControlsNotFoundErrCode, "error", nil)
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NeedsDeletion": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewOwnershipControlsClient(fake.MockBucketClientV1{
+					MockGetBucketOwnershipControls: func(*s3.GetBucketOwnershipControlsInput) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return &s3.GetBucketOwnershipControlsOutput{OwnershipControls: &s3.OwnershipControls{
+							Rules: []*s3.OwnershipControlsRule{{ObjectOwnership: awsclient.String(s3.ObjectOwnershipObjectWriter)}},
+						}}, nil
+					},
+				}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{OwnershipControls: preferred},
+					},
+				},
+				cl: NewOwnershipControlsClient(fake.MockBucketClientV1{
+					MockGetBucketOwnershipControls: func(*s3.GetBucketOwnershipControlsInput) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return &s3.GetBucketOwnershipControlsOutput{OwnershipControls: &s3.OwnershipControls{
+							Rules: []*s3.OwnershipControlsRule{{ObjectOwnership: awsclient.String(s3.ObjectOwnershipObjectWriter)}},
+						}}, nil
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Updated": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{OwnershipControls: preferred},
+					},
+				},
+				cl: NewOwnershipControlsClient(fake.MockBucketClientV1{
+					MockGetBucketOwnershipControls: func(*s3.GetBucketOwnershipControlsInput) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return &s3.GetBucketOwnershipControlsOutput{OwnershipControls: &s3.OwnershipControls{
+							Rules: []*s3.OwnershipControlsRule{{ObjectOwnership: awsclient.String(s3.ObjectOwnershipBucketOwnerPreferred)}},
+						}}, nil
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOwnershipControlsClient_CreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl *OwnershipControlsClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Skip": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+			},
+			want: want{},
+		},
+		"Error": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							OwnershipControls: &v1beta1.OwnershipControls{},
+						},
+					},
+				},
+				cl: NewOwnershipControlsClient(fake.MockBucketClientV1{
+					MockPutBucketOwnershipControls: func(*s3.PutBucketOwnershipControlsInput) (*s3.PutBucketOwnershipControlsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, ownershipPutFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOwnershipControlsClient_Delete(t *testing.T) {
+	type args struct {
+		cl *OwnershipControlsClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewOwnershipControlsClient(fake.MockBucketClientV1{
+					MockDeleteBucketOwnershipControls: func(*s3.DeleteBucketOwnershipControlsInput) (*s3.DeleteBucketOwnershipControlsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, ownershipDeleteFailed),
+			},
+		},
+		"Success": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewOwnershipControlsClient(fake.MockBucketClientV1{
+					MockDeleteBucketOwnershipControls: func(*s3.DeleteBucketOwnershipControlsInput) (*s3.DeleteBucketOwnershipControlsOutput, error) {
+						return &s3.DeleteBucketOwnershipControlsOutput{}, nil
+					},
+				}),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOwnershipControlsClient_LateInitialize(t *testing.T) {
+	type args struct {
+		cl *OwnershipControlsClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewOwnershipControlsClient(fake.MockBucketClientV1{
+					MockGetBucketOwnershipControls: func(*s3.GetBucketOwnershipControlsInput) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, ownershipGetFailed),
+				cr:  &v1beta1.Bucket{},
+			},
+		},
+		"NotFoundSkip": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewOwnershipControlsClient(fake.MockBucketClientV1{
+					MockGetBucketOwnershipControls: func(*s3.GetBucketOwnershipControlsInput) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return nil, awserr.New(clients3.OwnershipControlsNotFoundErrCode, "error", nil)
+					},
+				}),
+			},
+			want: want{
+				cr: &v1beta1.Bucket{},
+			},
+		},
+		"Success": {
+			args: args{
+				cr: &v1beta1.Bucket{},
+				cl: NewOwnershipControlsClient(fake.MockBucketClientV1{
+					MockGetBucketOwnershipControls: func(*s3.GetBucketOwnershipControlsInput) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return &s3.GetBucketOwnershipControlsOutput{OwnershipControls: &s3.OwnershipControls{
+							Rules: []*s3.OwnershipControlsRule{{ObjectOwnership: awsclient.String(s3.ObjectOwnershipBucketOwnerPreferred)}},
+						}}, nil
+					},
+				}),
+			},
+			want: want{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							OwnershipControls: &v1beta1.OwnershipControls{Rules: []v1beta1.OwnershipControlsRule{{ObjectOwnership: s3.ObjectOwnershipBucketOwnerPreferred}}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -32,8 +32,8 @@ type SubresourceClient interface {
 	SubresourceExists(bucket *v1beta1.Bucket) bool
 }
 
-// NewSubresourceClients creates the array of all clients for a given BucketProvider
-func NewSubresourceClients(client s3.BucketClient) []SubresourceClient {
+// NewSubresourceClients creates the array of all clients for a given BucketProvider.
+func NewSubresourceClients(client s3.BucketClient, clientV1 s3.BucketClientV1) []SubresourceClient {
 	return []SubresourceClient{
 		// Note: Moved VersioningClient up, since ReplicationConfiguration may be blocked
 		// by an invalid VersioningConfig, see https://github.com/crossplane/provider-aws/issues/553
@@ -49,6 +49,57 @@ func NewSubresourceClients(client s3.BucketClient) []SubresourceClient {
 		NewTaggingConfigurationClient(client),
 		NewWebsiteConfigurationClient(client),
 		NewPublicAccessBlockClient(client),
+		NewObjectLockConfigurationClient(client),
+		NewInventoryConfigurationClient(client),
+		NewAnalyticsConfigurationClient(client),
+		NewMetricsConfigurationClient(client),
+		NewOwnershipControlsClient(clientV1),
+		NewIntelligentTieringConfigurationClient(clientV1),
+	}
+}
+
+// SubresourceField returns the JSON path of the BucketParameters field that
+// the supplied SubresourceClient manages.
+func SubresourceField(c SubresourceClient) string { // nolint:gocyclo
+	switch c.(type) {
+	case *VersioningConfigurationClient:
+		return "versioningConfiguration"
+	case *AccelerateConfigurationClient:
+		return "accelerateConfiguration"
+	case *CORSConfigurationClient:
+		return "corsConfiguration"
+	case *LifecycleConfigurationClient:
+		return "lifecycleConfiguration"
+	case *LoggingConfigurationClient:
+		return "loggingConfiguration"
+	case *NotificationConfigurationClient:
+		return "notificationConfiguration"
+	case *ReplicationConfigurationClient:
+		return "replicationConfiguration"
+	case *RequestPaymentConfigurationClient:
+		return "paymentConfiguration"
+	case *SSEConfigurationClient:
+		return "serverSideEncryptionConfiguration"
+	case *TaggingConfigurationClient:
+		return "tagging"
+	case *WebsiteConfigurationClient:
+		return "websiteConfiguration"
+	case *PublicAccessBlockClient:
+		return "publicAccessBlockConfiguration"
+	case *ObjectLockConfigurationClient:
+		return "objectLockConfiguration"
+	case *InventoryConfigurationClient:
+		return "inventoryConfigurations"
+	case *AnalyticsConfigurationClient:
+		return "analyticsConfigurations"
+	case *MetricsConfigurationClient:
+		return "metricsConfigurations"
+	case *OwnershipControlsClient:
+		return "ownershipControls"
+	case *IntelligentTieringConfigurationClient:
+		return "intelligentTieringConfigurations"
+	default:
+		return ""
 	}
 }
 
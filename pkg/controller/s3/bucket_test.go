@@ -186,6 +186,7 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				cr: s3Testing.Bucket(
+					s3Testing.WithConditions(awsclient.Drifted([]string{"paymentConfiguration"})),
 					s3Testing.WithArn(fmt.Sprintf("arn:aws:s3:::%s", s3Testing.BucketName)),
 				),
 				result: managed.ExternalObservation{
@@ -230,6 +231,7 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				cr: s3Testing.Bucket(
+					s3Testing.WithConditions(awsclient.Drifted([]string{"serverSideEncryptionConfiguration"})),
 					s3Testing.WithArn(fmt.Sprintf("arn:aws:s3:::%s", s3Testing.BucketName)),
 					s3Testing.WithSSEConfig(&v1beta1.ServerSideEncryptionConfiguration{
 						Rules: []v1beta1.ServerSideEncryptionRule{
@@ -252,7 +254,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{s3client: tc.s3, subresourceClients: bucket.NewSubresourceClients(tc.s3), kube: tc.kube}
+			e := &external{s3client: tc.s3, subresourceClients: bucket.NewSubresourceClients(tc.s3, s3Testing.ClientV1()), kube: tc.kube}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -475,7 +477,7 @@ func TestCreate(t *testing.T) {
 	for name, tc := range cases {
 		noop := logging.NewNopLogger()
 		t.Run(name, func(t *testing.T) {
-			e := &external{s3client: tc.s3, kube: tc.kube, logger: noop, subresourceClients: bucket.NewSubresourceClients(tc.s3)}
+			e := &external{s3client: tc.s3, kube: tc.kube, logger: noop, subresourceClients: bucket.NewSubresourceClients(tc.s3, s3Testing.ClientV1())}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -705,7 +707,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{s3client: tc.s3, subresourceClients: bucket.NewSubresourceClients(tc.s3)}
+			e := &external{s3client: tc.s3, subresourceClients: bucket.NewSubresourceClients(tc.s3, s3Testing.ClientV1())}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -772,6 +774,83 @@ func TestDelete(t *testing.T) {
 				err: errBoom,
 			},
 		},
+		"ForceDestroyNotEmpty": {
+			args: args{
+				s3: &fake.MockBucketClient{
+					MockListMultipartUploadsRequest: func(input *awss3.ListMultipartUploadsInput) awss3.ListMultipartUploadsRequest {
+						return awss3.ListMultipartUploadsRequest{
+							Request: s3Testing.CreateRequest(nil, &awss3.ListMultipartUploadsOutput{
+								Uploads: []awss3.MultipartUpload{{Key: aws.String("upload"), UploadId: aws.String("1")}},
+							}),
+						}
+					},
+					MockAbortMultipartUploadRequest: func(input *awss3.AbortMultipartUploadInput) awss3.AbortMultipartUploadRequest {
+						return awss3.AbortMultipartUploadRequest{
+							Request: s3Testing.CreateRequest(nil, &awss3.AbortMultipartUploadOutput{}),
+						}
+					},
+					MockListObjectVersionsRequest: func(input *awss3.ListObjectVersionsInput) awss3.ListObjectVersionsRequest {
+						return awss3.ListObjectVersionsRequest{
+							Request: s3Testing.CreateRequest(nil, &awss3.ListObjectVersionsOutput{
+								Versions:      []awss3.ObjectVersion{{Key: aws.String("object"), VersionId: aws.String("1")}},
+								DeleteMarkers: []awss3.DeleteMarkerEntry{{Key: aws.String("object"), VersionId: aws.String("2")}},
+							}),
+						}
+					},
+					MockDeleteObjectsRequest: func(input *awss3.DeleteObjectsInput) awss3.DeleteObjectsRequest {
+						return awss3.DeleteObjectsRequest{
+							Request: s3Testing.CreateRequest(nil, &awss3.DeleteObjectsOutput{}),
+						}
+					},
+				},
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true)),
+			},
+			want: want{
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true),
+					s3Testing.WithConditions(xpv1.Deleting().WithMessage(fmt.Sprintf(msgFmtEmptying, 2)))),
+			},
+		},
+		"ForceDestroyEmpty": {
+			args: args{
+				s3: &fake.MockBucketClient{
+					MockListMultipartUploadsRequest: func(input *awss3.ListMultipartUploadsInput) awss3.ListMultipartUploadsRequest {
+						return awss3.ListMultipartUploadsRequest{
+							Request: s3Testing.CreateRequest(nil, &awss3.ListMultipartUploadsOutput{}),
+						}
+					},
+					MockListObjectVersionsRequest: func(input *awss3.ListObjectVersionsInput) awss3.ListObjectVersionsRequest {
+						return awss3.ListObjectVersionsRequest{
+							Request: s3Testing.CreateRequest(nil, &awss3.ListObjectVersionsOutput{}),
+						}
+					},
+					MockDeleteBucketRequest: func(input *awss3.DeleteBucketInput) awss3.DeleteBucketRequest {
+						return awss3.DeleteBucketRequest{
+							Request: s3Testing.CreateRequest(nil, &awss3.DeleteBucketOutput{}),
+						}
+					},
+				},
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true)),
+			},
+			want: want{
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true), s3Testing.WithConditions(xpv1.Deleting())),
+			},
+		},
+		"ForceDestroyError": {
+			args: args{
+				s3: &fake.MockBucketClient{
+					MockListMultipartUploadsRequest: func(input *awss3.ListMultipartUploadsInput) awss3.ListMultipartUploadsRequest {
+						return awss3.ListMultipartUploadsRequest{
+							Request: s3Testing.CreateRequest(errBoom, &awss3.ListMultipartUploadsOutput{}),
+						}
+					},
+				},
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true)),
+			},
+			want: want{
+				cr:  s3Testing.Bucket(s3Testing.WithForceDestroy(true), s3Testing.WithConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errors.Wrap(errBoom, "cannot list multipart uploads"), errEmpty),
+			},
+		},
 		"ResourceDoesNotExist": {
 			args: args{
 				s3: &fake.MockBucketClient{
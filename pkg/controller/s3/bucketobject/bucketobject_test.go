@@ -0,0 +1,394 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucketobject
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+	s3Testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	bucketName     = "test.s3.crossplane.com"
+	content        = "hello"
+	// echo -n hello | md5sum
+	contentMD5 = "5d41402abc4b2a76b9719d911017c592"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	s3   s3.BucketObjectClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type bucketObjectModifier func(*v1alpha3.BucketObject)
+
+func withConditions(c ...xpv1.Condition) bucketObjectModifier {
+	return func(r *v1alpha3.BucketObject) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withContentSecretRef() bucketObjectModifier {
+	return func(r *v1alpha3.BucketObject) {
+		r.Spec.ForProvider.Content = nil
+		r.Spec.ForProvider.ContentSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: "content", Namespace: "default"},
+			Key:             "index.html",
+		}
+	}
+}
+
+func withObservation(o v1alpha3.BucketObjectObservation) bucketObjectModifier {
+	return func(r *v1alpha3.BucketObject) { r.Status.AtProvider = o }
+}
+
+func bucketObject(m ...bucketObjectModifier) *v1alpha3.BucketObject {
+	cr := &v1alpha3.BucketObject{
+		Spec: v1alpha3.BucketObjectSpec{
+			ForProvider: v1alpha3.BucketObjectParameters{
+				BucketName: &bucketName,
+				Key:        "index.html",
+				Content:    &content,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func head(etag string, err error) func(*awss3.HeadObjectInput) awss3.HeadObjectRequest {
+	return func(*awss3.HeadObjectInput) awss3.HeadObjectRequest {
+		return awss3.HeadObjectRequest{
+			Request: s3Testing.CreateRequest(err, &awss3.HeadObjectOutput{ETag: aws.String(`"` + etag + `"`)}),
+		}
+	}
+}
+
+func noTags(*awss3.GetObjectTaggingInput) awss3.GetObjectTaggingRequest {
+	return awss3.GetObjectTaggingRequest{
+		Request: s3Testing.CreateRequest(nil, &awss3.GetObjectTaggingOutput{}),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NotFound": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockHeadObjectRequest: head("", awserr.New(s3.ObjectNotFoundErrCode, "", nil)),
+				},
+				cr: bucketObject(),
+			},
+			want: want{
+				cr: bucketObject(),
+			},
+		},
+		"HeadError": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockHeadObjectRequest: head("", errBoom),
+				},
+				cr: bucketObject(),
+			},
+			want: want{
+				cr:  bucketObject(),
+				err: awsclient.Wrap(errBoom, errHead),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockHeadObjectRequest:       head(contentMD5, nil),
+					MockGetObjectTaggingRequest: noTags,
+				},
+				cr: bucketObject(),
+			},
+			want: want{
+				cr: bucketObject(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ContentChanged": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockHeadObjectRequest:       head("0123", nil),
+					MockGetObjectTaggingRequest: noTags,
+				},
+				cr: bucketObject(),
+			},
+			want: want{
+				cr: bucketObject(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ContentFromSecret": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockHeadObjectRequest:       head(contentMD5, nil),
+					MockGetObjectTaggingRequest: noTags,
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.Secret).Data = map[string][]byte{"index.html": []byte(content)}
+						return nil
+					},
+				},
+				cr: bucketObject(withContentSecretRef()),
+			},
+			want: want{
+				cr: bucketObject(withContentSecretRef(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SecretError": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockHeadObjectRequest:       head(contentMD5, nil),
+					MockGetObjectTaggingRequest: noTags,
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: bucketObject(withContentSecretRef()),
+			},
+			want: want{
+				cr:  bucketObject(withContentSecretRef()),
+				err: errors.Wrap(errBoom, errGetSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockPutObjectRequest: func(*awss3.PutObjectInput) awss3.PutObjectRequest {
+						return awss3.PutObjectRequest{
+							Request: s3Testing.CreateRequest(nil, &awss3.PutObjectOutput{ETag: aws.String(`"` + contentMD5 + `"`), VersionId: aws.String("v1")}),
+						}
+					},
+				},
+				cr: bucketObject(),
+			},
+			want: want{
+				cr: bucketObject(withConditions(xpv1.Creating()), withObservation(v1alpha3.BucketObjectObservation{
+					ETag:       contentMD5,
+					ContentMD5: contentMD5,
+					VersionID:  "v1",
+				})),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockPutObjectRequest: func(*awss3.PutObjectInput) awss3.PutObjectRequest {
+						return awss3.PutObjectRequest{
+							Request: s3Testing.CreateRequest(errBoom, &awss3.PutObjectOutput{}),
+						}
+					},
+				},
+				cr: bucketObject(),
+			},
+			want: want{
+				cr:  bucketObject(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockDeleteObjectRequest: func(*awss3.DeleteObjectInput) awss3.DeleteObjectRequest {
+						return awss3.DeleteObjectRequest{
+							Request: s3Testing.CreateRequest(nil, &awss3.DeleteObjectOutput{}),
+						}
+					},
+				},
+				cr: bucketObject(),
+			},
+			want: want{
+				cr: bucketObject(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockDeleteObjectRequest: func(*awss3.DeleteObjectInput) awss3.DeleteObjectRequest {
+						return awss3.DeleteObjectRequest{
+							Request: s3Testing.CreateRequest(awserr.New(awss3.ErrCodeNoSuchBucket, "", nil), &awss3.DeleteObjectOutput{}),
+						}
+					},
+				},
+				cr: bucketObject(),
+			},
+			want: want{
+				cr: bucketObject(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockBucketObjectClient{
+					MockDeleteObjectRequest: func(*awss3.DeleteObjectInput) awss3.DeleteObjectRequest {
+						return awss3.DeleteObjectRequest{
+							Request: s3Testing.CreateRequest(errBoom, &awss3.DeleteObjectOutput{}),
+						}
+					},
+				},
+				cr: bucketObject(),
+			},
+			want: want{
+				cr:  bucketObject(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
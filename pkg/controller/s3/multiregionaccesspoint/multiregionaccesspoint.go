@@ -0,0 +1,296 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiregionaccesspoint
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a MultiRegionAccessPoint resource"
+	errCreateSession    = "cannot create a new session"
+	errGetAccountID     = "failed to determine the account ID of the Multi-Region access point"
+	errGet              = "failed to get the Multi-Region access point"
+	errDescribeCreate   = "failed to describe the request that creates the Multi-Region access point"
+	errCreateFailed     = "failed to create the Multi-Region access point"
+	errGetPolicy        = "failed to get the Multi-Region access point policy"
+	errCreate           = "failed to create the Multi-Region access point"
+	errPutPolicy        = "failed to put the Multi-Region access point policy"
+	errDelete           = "failed to delete the Multi-Region access point"
+	errFormatPolicy     = "failed to format the Multi-Region access point policy"
+)
+
+// SetupMultiRegionAccessPoint adds a controller that reconciles
+// MultiRegionAccessPoints.
+func SetupMultiRegionAccessPoint(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha3.MultiRegionAccessPointGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha3.MultiRegionAccessPoint{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.MultiRegionAccessPointGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.NewManagementPolicyConnecter(&connector{kube: mgr.GetClient(),
+				newClientFn: s3.NewMultiRegionAccessPointClient, newSTSClientFn: s3.NewCallerIdentityClient})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube           client.Client
+	newClientFn    func(sess *session.Session) s3.MultiRegionAccessPointClient
+	newSTSClientFn func(sess *session.Session) s3.CallerIdentityClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha3.MultiRegionAccessPoint); !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, s3.MultiRegionAccessPointControlRegion)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), sts: c.newSTSClientFn(sess)}, nil
+}
+
+type external struct {
+	client s3.MultiRegionAccessPointClient
+	sts    s3.CallerIdentityClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mg.(*v1alpha3.MultiRegionAccessPoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The account that owns the buckets is required by every S3 Control
+	// call, so we default it to the account we are authenticated to.
+	lateInitialized := false
+	if cr.Spec.ForProvider.AccountID == nil {
+		id, err := e.sts.GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{})
+		if err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errGetAccountID)
+		}
+		cr.Spec.ForProvider.AccountID = id.Account
+		lateInitialized = true
+	}
+
+	name := meta.GetExternalName(cr)
+	resp, err := e.client.GetMultiRegionAccessPointWithContext(ctx, &s3control.GetMultiRegionAccessPointInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      aws.String(name),
+	})
+	if s3.IsMultiRegionAccessPointNotFound(err) && cr.Status.AtProvider.RequestTokenARN != "" {
+		return e.observeCreate(ctx, cr, lateInitialized)
+	}
+	if err != nil {
+		return managed.ExternalObservation{ResourceLateInitialized: lateInitialized},
+			awsclient.Wrap(resource.Ignore(s3.IsMultiRegionAccessPointNotFound, err), errGet)
+	}
+
+	token := cr.Status.AtProvider.RequestTokenARN
+	cr.Status.AtProvider = s3.GenerateMultiRegionAccessPointObservation(cr.Spec.ForProvider, *resp.AccessPoint)
+	cr.Status.AtProvider.RequestTokenARN = token
+
+	switch cr.Status.AtProvider.Status {
+	case s3control.MultiRegionAccessPointStatusReady:
+		cr.SetConditions(xpv1.Available())
+	case s3control.MultiRegionAccessPointStatusCreating:
+		cr.SetConditions(xpv1.Creating())
+	case s3control.MultiRegionAccessPointStatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	// The policy of a Multi-Region access point cannot be changed until it
+	// has been created in all of its regions.
+	upToDate := true
+	if cr.Status.AtProvider.Status == s3control.MultiRegionAccessPointStatusReady {
+		upToDate, err = e.isPolicyUpToDate(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: lateInitialized,
+		ConnectionDetails:       getConnectionDetails(cr),
+	}, nil
+}
+
+// observeCreate reports the Multi-Region access point as existing while the
+// asynchronous request that creates it is in progress, since it cannot be
+// found until the request completes.
+func (e *external) observeCreate(ctx context.Context, cr *v1alpha3.MultiRegionAccessPoint, lateInitialized bool) (managed.ExternalObservation, error) {
+	resp, err := e.client.DescribeMultiRegionAccessPointOperationWithContext(ctx, &s3control.DescribeMultiRegionAccessPointOperationInput{
+		AccountId:       cr.Spec.ForProvider.AccountID,
+		RequestTokenARN: aws.String(cr.Status.AtProvider.RequestTokenARN),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeCreate)
+	}
+
+	op := resp.AsyncOperation
+	if op == nil {
+		op = &s3control.AsyncOperation{}
+	}
+	switch aws.StringValue(op.RequestStatus) {
+	case s3.MultiRegionAccessPointRequestFailed:
+		// Forget the failed request so that the next reconcile creates the
+		// Multi-Region access point again.
+		cr.Status.AtProvider.RequestTokenARN = ""
+		if op.ResponseDetails != nil && op.ResponseDetails.ErrorDetails != nil {
+			d := op.ResponseDetails.ErrorDetails
+			return managed.ExternalObservation{}, errors.Errorf("%s: %s: %s", errCreateFailed, aws.StringValue(d.Code), aws.StringValue(d.Message))
+		}
+		return managed.ExternalObservation{}, errors.New(errCreateFailed)
+	case s3.MultiRegionAccessPointRequestSucceeded:
+		// The Multi-Region access point was created but no longer exists.
+		cr.Status.AtProvider.RequestTokenARN = ""
+		return managed.ExternalObservation{ResourceLateInitialized: lateInitialized}, nil
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+func (e *external) isPolicyUpToDate(ctx context.Context, cr *v1alpha3.MultiRegionAccessPoint) (bool, error) {
+	desired, err := s3.FormatMultiRegionAccessPointPolicy(cr.Spec.ForProvider)
+	if err != nil {
+		return false, errors.Wrap(err, errFormatPolicy)
+	}
+	// There is no API to remove the policy of a Multi-Region access point,
+	// so a policy that is not specified is left untouched.
+	if desired == nil {
+		return true, nil
+	}
+	resp, err := e.client.GetMultiRegionAccessPointPolicyWithContext(ctx, &s3control.GetMultiRegionAccessPointPolicyInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return false, awsclient.Wrap(err, errGetPolicy)
+	}
+	return s3.IsAccessPointPolicyUpToDate(desired, s3.CurrentMultiRegionAccessPointPolicy(resp.Policy)), nil
+}
+
+// Create starts the asynchronous creation of the Multi-Region access point.
+// Its policy is put by Update once it has been created.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.MultiRegionAccessPoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	resp, err := e.client.CreateMultiRegionAccessPointWithContext(ctx, s3.GenerateCreateMultiRegionAccessPointInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	cr.Status.AtProvider.RequestTokenARN = aws.StringValue(resp.RequestTokenARN)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update replaces the policy of the Multi-Region access point. The other
+// parameters of a Multi-Region access point cannot be changed after it is
+// created.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.MultiRegionAccessPoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	desired, err := s3.FormatMultiRegionAccessPointPolicy(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errFormatPolicy)
+	}
+	if desired == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	_, err = e.client.PutMultiRegionAccessPointPolicyWithContext(ctx, &s3control.PutMultiRegionAccessPointPolicyInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Details: &s3control.PutMultiRegionAccessPointPolicyInput_{
+			Name:   aws.String(meta.GetExternalName(cr)),
+			Policy: desired,
+		},
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errPutPolicy)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.MultiRegionAccessPoint)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	// Deletion is asynchronous, so we only request it once.
+	if cr.Status.AtProvider.Status == s3control.MultiRegionAccessPointStatusDeleting {
+		return nil
+	}
+
+	_, err := e.client.DeleteMultiRegionAccessPointWithContext(ctx, &s3control.DeleteMultiRegionAccessPointInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Details: &s3control.DeleteMultiRegionAccessPointInput_{
+			Name: aws.String(meta.GetExternalName(cr)),
+		},
+	})
+	return awsclient.Wrap(resource.Ignore(s3.IsMultiRegionAccessPointNotFound, err), errDelete)
+}
+
+func getConnectionDetails(cr *v1alpha3.MultiRegionAccessPoint) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		s3.ConnectionKeyAccessPointARN:   []byte(cr.Status.AtProvider.ARN),
+		s3.ConnectionKeyAccessPointAlias: []byte(cr.Status.AtProvider.Alias),
+		s3.ConnectionKeyAccessPointName:  []byte(meta.GetExternalName(cr)),
+	}
+}
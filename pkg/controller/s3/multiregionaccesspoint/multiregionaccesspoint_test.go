@@ -0,0 +1,627 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiregionaccesspoint
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	accountID      = "123456789012"
+	mrapName       = "data-lake"
+	mrapAlias      = "mfzwi23gnjvgw.mrap"
+	mrapARN        = "arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap"
+	requestToken   = "arn:aws:s3:us-west-2:123456789012:async-request/mrap/create/1a2b3c"
+	rawPolicy      = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+	created        = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	s3  s3.MultiRegionAccessPointClient
+	sts s3.CallerIdentityClient
+	cr  resource.Managed
+}
+
+type multiRegionAccessPointModifier func(*v1alpha3.MultiRegionAccessPoint)
+
+func withConditions(c ...xpv1.Condition) multiRegionAccessPointModifier {
+	return func(r *v1alpha3.MultiRegionAccessPoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withAccountID(id *string) multiRegionAccessPointModifier {
+	return func(r *v1alpha3.MultiRegionAccessPoint) { r.Spec.ForProvider.AccountID = id }
+}
+
+func withRawPolicy(p *string) multiRegionAccessPointModifier {
+	return func(r *v1alpha3.MultiRegionAccessPoint) { r.Spec.ForProvider.RawPolicy = p }
+}
+
+func withObservation(o v1alpha3.MultiRegionAccessPointObservation) multiRegionAccessPointModifier {
+	return func(r *v1alpha3.MultiRegionAccessPoint) { r.Status.AtProvider = o }
+}
+
+func withRequestToken(token string) multiRegionAccessPointModifier {
+	return func(r *v1alpha3.MultiRegionAccessPoint) { r.Status.AtProvider.RequestTokenARN = token }
+}
+
+func multiRegionAccessPoint(m ...multiRegionAccessPointModifier) *v1alpha3.MultiRegionAccessPoint {
+	cr := &v1alpha3.MultiRegionAccessPoint{
+		Spec: v1alpha3.MultiRegionAccessPointSpec{
+			ForProvider: v1alpha3.MultiRegionAccessPointParameters{
+				AccountID: &accountID,
+				Regions: []v1alpha3.MultiRegionAccessPointRegion{
+					{BucketName: aws.String("data-lake-us-east-1")},
+					{BucketName: aws.String("data-lake-eu-west-1")},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, mrapName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func connectionDetails() managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		s3.ConnectionKeyAccessPointARN:   []byte(mrapARN),
+		s3.ConnectionKeyAccessPointAlias: []byte(mrapAlias),
+		s3.ConnectionKeyAccessPointName:  []byte(mrapName),
+	}
+}
+
+func getMultiRegionAccessPoint(status string, err error) func(*s3control.GetMultiRegionAccessPointInput) (*s3control.GetMultiRegionAccessPointOutput, error) {
+	return func(*s3control.GetMultiRegionAccessPointInput) (*s3control.GetMultiRegionAccessPointOutput, error) {
+		if err != nil {
+			return nil, err
+		}
+		return &s3control.GetMultiRegionAccessPointOutput{
+			AccessPoint: &s3control.MultiRegionAccessPointReport{
+				Alias:     aws.String(mrapAlias),
+				CreatedAt: &created,
+				Name:      aws.String(mrapName),
+				Status:    aws.String(status),
+			},
+		}, nil
+	}
+}
+
+func describeOperation(status string, details *s3control.AsyncResponseDetails) func(*s3control.DescribeMultiRegionAccessPointOperationInput) (*s3control.DescribeMultiRegionAccessPointOperationOutput, error) {
+	return func(*s3control.DescribeMultiRegionAccessPointOperationInput) (*s3control.DescribeMultiRegionAccessPointOperationOutput, error) {
+		return &s3control.DescribeMultiRegionAccessPointOperationOutput{
+			AsyncOperation: &s3control.AsyncOperation{
+				Operation:       aws.String(s3control.AsyncOperationNameCreateMultiRegionAccessPoint),
+				RequestStatus:   aws.String(status),
+				RequestTokenARN: aws.String(requestToken),
+				ResponseDetails: details,
+			},
+		}, nil
+	}
+}
+
+func getPolicy(policy *string, err error) func(*s3control.GetMultiRegionAccessPointPolicyInput) (*s3control.GetMultiRegionAccessPointPolicyOutput, error) {
+	return func(*s3control.GetMultiRegionAccessPointPolicyInput) (*s3control.GetMultiRegionAccessPointPolicyOutput, error) {
+		return &s3control.GetMultiRegionAccessPointPolicyOutput{
+			Policy: &s3control.MultiRegionAccessPointPolicyDocument{
+				Established: &s3control.EstablishedMultiRegionAccessPointPolicy{Policy: policy},
+			},
+		}, err
+	}
+}
+
+func observation(status string) v1alpha3.MultiRegionAccessPointObservation {
+	t := metav1.NewTime(created)
+	return v1alpha3.MultiRegionAccessPointObservation{
+		ARN:       mrapARN,
+		Alias:     mrapAlias,
+		Status:    status,
+		CreatedAt: &t,
+	}
+}
+
+func TestObserve(t *testing.T) {
+	notFound := awserr.New(s3.MultiRegionAccessPointNotFoundErrCode, "", nil)
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"LateInitAccountID": {
+			args: args{
+				sts: &fake.MockCallerIdentityClient{
+					MockGetCallerIdentity: func(*sts.GetCallerIdentityInput) (*sts.GetCallerIdentityOutput, error) {
+						return &sts.GetCallerIdentityOutput{Account: &accountID}, nil
+					},
+				},
+				s3: &fake.MockMultiRegionAccessPointClient{
+					MockGetMultiRegionAccessPoint: getMultiRegionAccessPoint("", notFound),
+				},
+				cr: multiRegionAccessPoint(withAccountID(nil)),
+			},
+			want: want{
+				cr:     multiRegionAccessPoint(),
+				result: managed.ExternalObservation{ResourceLateInitialized: true},
+			},
+		},
+		"AccountIDError": {
+			args: args{
+				sts: &fake.MockCallerIdentityClient{
+					MockGetCallerIdentity: func(*sts.GetCallerIdentityInput) (*sts.GetCallerIdentityOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: multiRegionAccessPoint(withAccountID(nil)),
+			},
+			want: want{
+				cr:  multiRegionAccessPoint(withAccountID(nil)),
+				err: awsclient.Wrap(errBoom, errGetAccountID),
+			},
+		},
+		"GetError": {
+			args: args{
+				s3: &fake.MockMultiRegionAccessPointClient{
+					MockGetMultiRegionAccessPoint: getMultiRegionAccessPoint("", errBoom),
+				},
+				cr: multiRegionAccessPoint(),
+			},
+			want: want{
+				cr:  multiRegionAccessPoint(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"CreateInProgress": {
+			args: args{
+				s3: &fake.MockMultiRegionAccessPointClient{
+					MockGetMultiRegionAccessPoint:               getMultiRegionAccessPoint("", notFound),
+					MockDescribeMultiRegionAccessPointOperation: describeOperation("IN_PROGRESS", nil),
+				},
+				cr: multiRegionAccessPoint(withRequestToken(requestToken)),
+			},
+			want: want{
+				cr: multiRegionAccessPoint(withRequestToken(requestToken), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				s3: &fake.MockMultiRegionAccessPointClient{
+					MockGetMultiRegionAccessPoint: getMultiRegionAccessPoint("", notFound),
+					MockDescribeMultiRegionAccessPointOperation: describeOperation(s3.MultiRegionAccessPointRequestFailed, &s3control.AsyncResponseDetails{
+						ErrorDetails: &s3control.AsyncErrorDetails{Code: aws.String("NoSuchBucket"), Message: aws.String("bucket not found")},
+					}),
+				},
+				cr: multiRegionAccessPoint(withRequestToken(requestToken)),
+			},
+			want: want{
+				cr:  multiRegionAccessPoint(),
+				err: errors.New(errCreateFailed + ": NoSuchBucket: bucket not found"),
+			},
+		},
+		"DescribeCreateError": {
+			args: args{
+				s3: &fake.MockMultiRegionAccessPointClient{
+					MockGetMultiRegionAccessPoint: getMultiRegionAccessPoint("", notFound),
+					MockDescribeMultiRegionAccessPointOperation: func(*s3control.DescribeMultiRegionAccessPointOperationInput) (*s3control.DescribeMultiRegionAccessPointOperationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: multiRegionAccessPoint(withRequestToken(requestToken)),
+			},
+			want: want{
+				cr:  multiRegionAccessPoint(withRequestToken(requestToken)),
+				err: awsclient.Wrap(errBoom, errDescribeCreate),
+			},
+		},
+		"Creating": {
+			args: args{
+				s3: &fake.MockMultiRegionAccessPointClient{
+					MockGetMultiRegionAccessPoint: getMultiRegionAccessPoint(s3control.MultiRegionAccessPointStatusCreating, nil),
+				},
+				cr: multiRegionAccessPoint(withRawPolicy(&rawPolicy), withRequestToken(requestToken)),
+			},
+			want: want{
+				cr: multiRegionAccessPoint(withRawPolicy(&rawPolicy), withConditions(xpv1.Creating()),
+					withObservation(observation(s3control.MultiRegionAccessPointStatusCreating)), withRequestToken(requestToken)),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"Deleting": {
+			args: args{
+				s3: &fake.MockMultiRegionAccessPointClient{
+					MockGetMultiRegionAccessPoint: getMultiRegionAccessPoint(s3control.MultiRegionAccessPointStatusDeleting, nil),
+				},
+				cr: multiRegionAccessPoint(),
+			},
+			want: want{
+				cr: multiRegionAccessPoint(withConditions(xpv1.Deleting()),
+					withObservation(observation(s3control.MultiRegionAccessPointStatusDeleting))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"PartiallyCreated": {
+			args: args{
+				s3: &fake.MockMultiRegionAccessPointClient{
+					MockGetMultiRegionAccessPoint: getMultiRegionAccessPoint(s3control.MultiRegionAccessPointStatusPartiallyCreated, nil),
+				},
+				cr: multiRegionAccessPoint(),
+			},
+			want: want{
+				cr: multiRegionAccessPoint(withConditions(xpv1.Unavailable()),
+					withObservation(observation(s3control.MultiRegionAccessPointStatusPartiallyCreated))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"ReadyWithoutPolicy": {
+			args: args{
+				s3: &fake.MockMultiRegionAccessPointClient{
+					MockGetMultiRegionAccessPoint: getMultiRegionAccessPoint(s3control.MultiRegionAccessPointStatusReady, nil),
+				},
+				cr: multiRegionAccessPoint(),
+			},
+			want: want{
+				cr: multiRegionAccessPoint(withConditions(xpv1.Available()),
+					withObservation(observation(s3control.MultiRegionAccessPointStatusReady))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"PolicyChanged": {
+			args: args{
+				s3: &fake.MockMultiRegionAccessPointClient{
+					MockGetMultiRegionAccessPoint:       getMultiRegionAccessPoint(s3control.MultiRegionAccessPointStatusReady, nil),
+					MockGetMultiRegionAccessPointPolicy: getPolicy(aws.String(`{"Version":"2012-10-17"}`), nil),
+				},
+				cr: multiRegionAccessPoint(withRawPolicy(&rawPolicy)),
+			},
+			want: want{
+				cr: multiRegionAccessPoint(withRawPolicy(&rawPolicy), withConditions(xpv1.Available()),
+					withObservation(observation(s3control.MultiRegionAccessPointStatusReady))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"PolicyUpToDate": {
+			args: args{
+				s3: &fake.MockMultiRegionAccessPointClient{
+					MockGetMultiRegionAccessPoint:       getMultiRegionAccessPoint(s3control.MultiRegionAccessPointStatusReady, nil),
+					MockGetMultiRegionAccessPointPolicy: getPolicy(&rawPolicy, nil),
+				},
+				cr: multiRegionAccessPoint(withRawPolicy(&rawPolicy)),
+			},
+			want: want{
+				cr: multiRegionAccessPoint(withRawPolicy(&rawPolicy), withConditions(xpv1.Available()),
+					withObservation(observation(s3control.MultiRegionAccessPointStatusReady))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(),
+				},
+			},
+		},
+		"GetPolicyError": {
+			args: args{
+				s3: &fake.MockMultiRegionAccessPointClient{
+					MockGetMultiRegionAccessPoint:       getMultiRegionAccessPoint(s3control.MultiRegionAccessPointStatusReady, nil),
+					MockGetMultiRegionAccessPointPolicy: getPolicy(nil, errBoom),
+				},
+				cr: multiRegionAccessPoint(withRawPolicy(&rawPolicy)),
+			},
+			want: want{
+				cr: multiRegionAccessPoint(withRawPolicy(&rawPolicy), withConditions(xpv1.Available()),
+					withObservation(observation(s3control.MultiRegionAccessPointStatusReady))),
+				err: awsclient.Wrap(errBoom, errGetPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, sts: tc.sts}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			args: args{
+				s3: &fake.MockMultiRegionAccessPointClient{
+					MockCreateMultiRegionAccessPoint: func(*s3control.CreateMultiRegionAccessPointInput) (*s3control.CreateMultiRegionAccessPointOutput, error) {
+						return &s3control.CreateMultiRegionAccessPointOutput{RequestTokenARN: aws.String(requestToken)}, nil
+					},
+				},
+				cr: multiRegionAccessPoint(),
+			},
+			want: want{
+				cr: multiRegionAccessPoint(withConditions(xpv1.Creating()), withRequestToken(requestToken)),
+			},
+		},
+		"CreateError": {
+			args: args{
+				s3: &fake.MockMultiRegionAccessPointClient{
+					MockCreateMultiRegionAccessPoint: func(*s3control.CreateMultiRegionAccessPointInput) (*s3control.CreateMultiRegionAccessPointOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: multiRegionAccessPoint(),
+			},
+			want: want{
+				cr:  multiRegionAccessPoint(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, sts: tc.sts}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"PutPolicy": {
+			args: args{
+				s3: &fake.MockMultiRegionAccessPointClient{
+					MockPutMultiRegionAccessPointPolicy: func(i *s3control.PutMultiRegionAccessPointPolicyInput) (*s3control.PutMultiRegionAccessPointPolicyOutput, error) {
+						if diff := cmp.Diff(rawPolicy, aws.StringValue(i.Details.Policy)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &s3control.PutMultiRegionAccessPointPolicyOutput{}, nil
+					},
+				},
+				cr: multiRegionAccessPoint(withRawPolicy(&rawPolicy)),
+			},
+			want: want{},
+		},
+		"NoPolicy": {
+			args: args{
+				s3: &fake.MockMultiRegionAccessPointClient{},
+				cr: multiRegionAccessPoint(),
+			},
+			want: want{},
+		},
+		"PutPolicyError": {
+			args: args{
+				s3: &fake.MockMultiRegionAccessPointClient{
+					MockPutMultiRegionAccessPointPolicy: func(*s3control.PutMultiRegionAccessPointPolicyInput) (*s3control.PutMultiRegionAccessPointPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: multiRegionAccessPoint(withRawPolicy(&rawPolicy)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errPutPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, sts: tc.sts}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			args: args{
+				s3: &fake.MockMultiRegionAccessPointClient{
+					MockDeleteMultiRegionAccessPoint: func(*s3control.DeleteMultiRegionAccessPointInput) (*s3control.DeleteMultiRegionAccessPointOutput, error) {
+						return &s3control.DeleteMultiRegionAccessPointOutput{}, nil
+					},
+				},
+				cr: multiRegionAccessPoint(),
+			},
+			want: want{
+				cr: multiRegionAccessPoint(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				s3: &fake.MockMultiRegionAccessPointClient{},
+				cr: multiRegionAccessPoint(withObservation(observation(s3control.MultiRegionAccessPointStatusDeleting))),
+			},
+			want: want{
+				cr: multiRegionAccessPoint(withConditions(xpv1.Deleting()),
+					withObservation(observation(s3control.MultiRegionAccessPointStatusDeleting))),
+			},
+		},
+		"NotFound": {
+			args: args{
+				s3: &fake.MockMultiRegionAccessPointClient{
+					MockDeleteMultiRegionAccessPoint: func(*s3control.DeleteMultiRegionAccessPointInput) (*s3control.DeleteMultiRegionAccessPointOutput, error) {
+						return nil, awserr.New(s3.MultiRegionAccessPointNotFoundErrCode, "", nil)
+					},
+				},
+				cr: multiRegionAccessPoint(),
+			},
+			want: want{
+				cr: multiRegionAccessPoint(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				s3: &fake.MockMultiRegionAccessPointClient{
+					MockDeleteMultiRegionAccessPoint: func(*s3control.DeleteMultiRegionAccessPointInput) (*s3control.DeleteMultiRegionAccessPointOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: multiRegionAccessPoint(),
+			},
+			want: want{
+				cr:  multiRegionAccessPoint(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, sts: tc.sts}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package testing
 import (
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	awserrv1 "github.com/aws/aws-sdk-go/aws/awserr"
+	awss3v1 "github.com/aws/aws-sdk-go/service/s3"
 
 	"github.com/crossplane/provider-aws/pkg/clients/s3"
 	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
@@ -97,6 +99,26 @@ func Client(m ...ClientModifier) *fake.MockBucketClient {
 				Request: CreateRequest(awserr.New(s3.PublicAccessBlockNotFoundErrCode, "error", nil), &awss3.DeletePublicAccessBlockOutput{}),
 			}
 		},
+		MockGetObjectLockConfigurationRequest: func(input *awss3.GetObjectLockConfigurationInput) awss3.GetObjectLockConfigurationRequest {
+			return awss3.GetObjectLockConfigurationRequest{
+				Request: CreateRequest(awserr.New(s3.ObjectLockNotFoundErrCode, "", nil), &awss3.GetObjectLockConfigurationOutput{}),
+			}
+		},
+		MockListBucketInventoryConfigurationsRequest: func(input *awss3.ListBucketInventoryConfigurationsInput) awss3.ListBucketInventoryConfigurationsRequest {
+			return awss3.ListBucketInventoryConfigurationsRequest{
+				Request: CreateRequest(nil, &awss3.ListBucketInventoryConfigurationsOutput{}),
+			}
+		},
+		MockListBucketAnalyticsConfigurationsRequest: func(input *awss3.ListBucketAnalyticsConfigurationsInput) awss3.ListBucketAnalyticsConfigurationsRequest {
+			return awss3.ListBucketAnalyticsConfigurationsRequest{
+				Request: CreateRequest(nil, &awss3.ListBucketAnalyticsConfigurationsOutput{}),
+			}
+		},
+		MockListBucketMetricsConfigurationsRequest: func(input *awss3.ListBucketMetricsConfigurationsInput) awss3.ListBucketMetricsConfigurationsRequest {
+			return awss3.ListBucketMetricsConfigurationsRequest{
+				Request: CreateRequest(nil, &awss3.ListBucketMetricsConfigurationsOutput{}),
+			}
+		},
 	}
 	for _, v := range m {
 		v(client)
@@ -104,6 +126,19 @@ func Client(m ...ClientModifier) *fake.MockBucketClient {
 	return client
 }
 
+// ClientV1 creates a MockBucketClientV1 that reports that none of the
+// subresources that are only available through the V1 SDK exist.
+func ClientV1() *fake.MockBucketClientV1 {
+	return &fake.MockBucketClientV1{
+		MockGetBucketOwnershipControls: func(*awss3v1.GetBucketOwnershipControlsInput) (*awss3v1.GetBucketOwnershipControlsOutput, error) {
+			return nil, awserrv1.New(s3.OwnershipControlsNotFoundErrCode, "", nil)
+		},
+		MockListBucketIntelligentTieringConfigurations: func(*awss3v1.ListBucketIntelligentTieringConfigurationsInput) (*awss3v1.ListBucketIntelligentTieringConfigurationsOutput, error) {
+			return &awss3v1.ListBucketIntelligentTieringConfigurationsOutput{}, nil
+		},
+	}
+}
+
 // ClientModifier is a function which modifies the S3 Client for testing
 type ClientModifier func(client *fake.MockBucketClient)
 
@@ -111,6 +111,11 @@ func WithNotificationConfig(s *v1beta1.NotificationConfiguration) BucketModifier
 	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.NotificationConfiguration = s }
 }
 
+// WithForceDestroy sets ForceDestroy for an S3 Bucket
+func WithForceDestroy(b bool) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.ForceDestroy = &b }
+}
+
 // Bucket creates a v1beta1 Bucket for use in testing
 func Bucket(m ...BucketModifier) *v1beta1.Bucket {
 	cr := &v1beta1.Bucket{